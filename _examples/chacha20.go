@@ -0,0 +1,39 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/chacha20"
+)
+
+func main() {
+	// The key of chacha20 must be 32 bytes.
+	// Use chacha20.XNonce() to generate a random nonce for xchacha20-poly1305.
+	key := []byte("12345678876543211234567887654321")
+	nonce := chacha20.XNonce()
+
+	data := []byte("你好，世界")
+	fmt.Printf("data: %s\n", data)
+
+	// Use xchacha20-poly1305 to encrypt data with encoding base64.
+	encrypt, err := chacha20.EncryptXPoly1305(data, key, nonce, chacha20.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("encrypt: %s\n", encrypt)
+
+	// Decrypt data in the same way.
+	decrypt, err := chacha20.DecryptXPoly1305(encrypt, key, nonce, chacha20.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("decrypt: %s\n", decrypt)
+	fmt.Printf("decrypt is right: %+v\n", bytes.Equal(decrypt, data))
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/FishGoddess/cryptox/chacha20"
+)
+
+var (
+	chacha20BenchKey    = []byte("12345678876543211234567887654321")
+	chacha20BenchNonce  = []byte("123456abcdef")
+	chacha20BenchXNonce = []byte("123456abcdef123456abcdef")
+	chacha20BenchMsg    = make([]byte, 128)
+)
+
+// go test -v -bench=^BenchmarkChaCha20_Encrypt$ -benchtime=1s chacha20_test.go
+func BenchmarkChaCha20_Encrypt(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := chacha20.Encrypt(chacha20BenchMsg, chacha20BenchKey, chacha20BenchNonce)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkChaCha20_EncryptPoly1305$ -benchtime=1s chacha20_test.go
+func BenchmarkChaCha20_EncryptPoly1305(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := chacha20.EncryptPoly1305(chacha20BenchMsg, chacha20BenchKey, chacha20BenchNonce)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkChaCha20_EncryptXPoly1305$ -benchtime=1s chacha20_test.go
+func BenchmarkChaCha20_EncryptXPoly1305(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := chacha20.EncryptXPoly1305(chacha20BenchMsg, chacha20BenchKey, chacha20BenchXNonce)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkChaCha20_Decrypt$ -benchtime=1s chacha20_test.go
+func BenchmarkChaCha20_Decrypt(b *testing.B) {
+	encrypt, err := chacha20.Encrypt(chacha20BenchMsg, chacha20BenchKey, chacha20BenchNonce)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := chacha20.Decrypt(encrypt, chacha20BenchKey, chacha20BenchNonce)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkChaCha20_DecryptPoly1305$ -benchtime=1s chacha20_test.go
+func BenchmarkChaCha20_DecryptPoly1305(b *testing.B) {
+	encrypt, err := chacha20.EncryptPoly1305(chacha20BenchMsg, chacha20BenchKey, chacha20BenchNonce)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := chacha20.DecryptPoly1305(encrypt, chacha20BenchKey, chacha20BenchNonce)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkChaCha20_DecryptXPoly1305$ -benchtime=1s chacha20_test.go
+func BenchmarkChaCha20_DecryptXPoly1305(b *testing.B) {
+	encrypt, err := chacha20.EncryptXPoly1305(chacha20BenchMsg, chacha20BenchKey, chacha20BenchXNonce)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := chacha20.DecryptXPoly1305(encrypt, chacha20BenchKey, chacha20BenchXNonce)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
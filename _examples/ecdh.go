@@ -0,0 +1,73 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto"
+	cryptoecdh "crypto/ecdh"
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/aes"
+	"github.com/FishGoddess/cryptox/ecdh"
+)
+
+func main() {
+	// Alice and bob generate their own keys and send the public key to each other.
+	// Check ecdh.KeyOption for more information about generating, storing and loading keys.
+	alicePrivateKey, alicePublicKey, err := ecdh.GenerateKeys(cryptoecdh.P256())
+	if err != nil {
+		panic(err)
+	}
+
+	bobPrivateKey, bobPublicKey, err := ecdh.GenerateKeys(cryptoecdh.P256())
+	if err != nil {
+		panic(err)
+	}
+
+	// Public keys can be sent in compressed points which are almost half of the uncompressed ones.
+	fmt.Printf("alice public key: %x\n", alicePublicKey.BytesCompressed())
+
+	bobPublicKey, err = ecdh.NewPublicKey(cryptoecdh.P256(), bobPublicKey.BytesCompressed())
+	if err != nil {
+		panic(err)
+	}
+
+	// Use x9.63 kdf to derive a 32 bytes aes key from the shared secret.
+	// Also, you can use ecdh.WithHKDF or ecdh.WithConcatKDF which is used by jwe.
+	opts := []ecdh.Option{
+		ecdh.WithX963KDF(crypto.SHA256, 32, []byte("aes-gcm key")),
+	}
+
+	aliceKey, err := alicePrivateKey.SharedSecret(bobPublicKey, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	bobKey, err := bobPrivateKey.SharedSecret(alicePublicKey, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	data := []byte("你好，世界")
+	fmt.Printf("data: %s\n", data)
+
+	// Alice encrypts data with her key.
+	nonce := aes.Nonce()
+
+	encrypt, err := aes.EncryptGCM(data, aliceKey, nonce, aes.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("encrypt: %s\n", encrypt)
+
+	// Bob decrypts data with his key which is the same as alice's.
+	decrypt, err := aes.DecryptGCM(encrypt, bobKey, nonce, aes.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("decrypt: %s\n", decrypt)
+}
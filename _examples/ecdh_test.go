@@ -0,0 +1,72 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto"
+	cryptoecdh "crypto/ecdh"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/ecdh"
+)
+
+// go test -v -bench=^BenchmarkECDH_GenerateKeys$ -benchtime=1s ecdh_test.go
+func BenchmarkECDH_GenerateKeys(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := ecdh.GenerateKeys(cryptoecdh.P256())
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkECDH_SharedSecret$ -benchtime=1s ecdh_test.go
+func BenchmarkECDH_SharedSecret(b *testing.B) {
+	privateKey, _, err := ecdh.GenerateKeys(cryptoecdh.P256())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	_, publicKey, err := ecdh.GenerateKeys(cryptoecdh.P256())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := privateKey.SharedSecret(publicKey)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkECDH_SharedSecretHKDF$ -benchtime=1s ecdh_test.go
+func BenchmarkECDH_SharedSecretHKDF(b *testing.B) {
+	privateKey, _, err := ecdh.GenerateKeys(cryptoecdh.P256())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	_, publicKey, err := ecdh.GenerateKeys(cryptoecdh.P256())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := privateKey.SharedSecret(publicKey, ecdh.WithHKDF(crypto.SHA256, 32))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/elliptic"
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/ecdsa"
+)
+
+func main() {
+	// Generate a private key and a public key of p-256.
+	// Check ecdsa.KeyOption for more information about generating, storing and loading keys.
+	privateKey, publicKey, err := ecdsa.GenerateKeys(elliptic.P256())
+	if err != nil {
+		panic(err)
+	}
+
+	data := []byte("戴上头箍，爱不了你；不戴头箍，救不了你。")
+	fmt.Printf("data: %s\n", data)
+
+	// Use the private key to sign data which is hashed in sha256 by default.
+	sign, err := privateKey.Sign(data, ecdsa.WithHex())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("sign: %s\n", sign)
+
+	// Use the public key to verify the sign.
+	err = publicKey.Verify(data, sign, ecdsa.WithHex())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("verify: %s\n", data)
+
+	// Use p1363 format which is r||s in fixed width like jws.
+	sign, err = privateKey.Sign(data, ecdsa.WithHex(), ecdsa.WithP1363())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("sign p1363: %s\n", sign)
+}
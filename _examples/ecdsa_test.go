@@ -0,0 +1,70 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/elliptic"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/ecdsa"
+)
+
+var (
+	ecdsaBenchData = []byte("你好，世界")
+)
+
+// go test -v -bench=^BenchmarkECDSA_Sign$ -benchtime=1s ecdsa_test.go
+func BenchmarkECDSA_Sign(b *testing.B) {
+	privateKey, _, err := ecdsa.GenerateKeys(elliptic.P256())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = privateKey.Sign(ecdsaBenchData)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkECDSA_Verify$ -benchtime=1s ecdsa_test.go
+func BenchmarkECDSA_Verify(b *testing.B) {
+	privateKey, publicKey, err := ecdsa.GenerateKeys(elliptic.P256())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	sign, err := privateKey.Sign(ecdsaBenchData)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err = publicKey.Verify(ecdsaBenchData, sign)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkECDSA_GenerateKeys$ -benchtime=1s ecdsa_test.go
+func BenchmarkECDSA_GenerateKeys(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := ecdsa.GenerateKeys(elliptic.P256())
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
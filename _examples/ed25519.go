@@ -5,7 +5,9 @@
 package main
 
 import (
+	"crypto"
 	"fmt"
+	"os"
 
 	"github.com/FishGoddess/cryptox/ed25519"
 )
@@ -37,4 +39,32 @@ func main() {
 	}
 
 	fmt.Printf("verify: %s\n", data)
+
+	// Use ed25519ph and ed25519ctx with crypto hash and context.
+	// The data will be hashed in sha512 internally so you can pass the data directly.
+	opts := []ed25519.Option{ed25519.WithHex(), ed25519.WithCryptoHash(crypto.SHA512), ed25519.WithContext("cryptox")}
+	sign = privateKey.Sign(data, opts...)
+	fmt.Printf("sign ph: %s\n", sign)
+
+	err = publicKey.Verify(data, sign, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("verify ph: %s\n", data)
+
+	// Use ed25519ph to sign a large file in stream.
+	file, err := os.Open("ed25519.go")
+	if err != nil {
+		panic(err)
+	}
+
+	defer file.Close()
+
+	sign, err = privateKey.SignReader(file, ed25519.WithHex())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("sign file: %s\n", sign)
 }
@@ -53,3 +53,38 @@ func BenchmarkED25519_Verify(b *testing.B) {
 		}
 	}
 }
+
+// go test -v -bench=^BenchmarkED25519_BatchVerify$ -benchtime=1s ed25519_test.go
+func BenchmarkED25519_BatchVerify(b *testing.B) {
+	privateKey, err := ed25519.LoadPrivateKey("ed25519.key")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	publicKey, err := ed25519.LoadPublicKey("ed25519.pub")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	sign := privateKey.Sign(ed25519BenchData)
+
+	// Verify 64 signs in one batch.
+	verifier := ed25519.NewBatchVerifier()
+	for i := 0; i < 64; i++ {
+		verifier.Add(publicKey, ed25519BenchData, sign)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		failed, err := verifier.Verify()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if len(failed) > 0 {
+			b.Fatalf("failed %+v", failed)
+		}
+	}
+}
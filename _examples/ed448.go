@@ -0,0 +1,47 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/ed448"
+)
+
+func main() {
+	// Generate a private key and a public key.
+	// Check ed448.KeyOption for more information about generating, storing and loading keys.
+	privateKey, publicKey, err := ed448.GenerateKeys()
+	if err != nil {
+		panic(err)
+	}
+
+	data := []byte("戴上头箍，爱不了你；不戴头箍，救不了你。")
+	fmt.Printf("data: %s\n", data)
+
+	// Use the private key to sign data with context.
+	sign, err := privateKey.Sign(data, ed448.WithHex(), ed448.WithContext("cryptox"))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("sign: %s\n", sign)
+
+	// Use the public key to verify the sign with the same context.
+	err = publicKey.Verify(data, sign, ed448.WithHex(), ed448.WithContext("cryptox"))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("verify: %s\n", data)
+
+	// Use ed448ph which hashes data in shake256 first.
+	sign, err = privateKey.Sign(data, ed448.WithHex(), ed448.WithPreHash())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("sign ph: %s\n", sign)
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/FishGoddess/cryptox/ed448"
+)
+
+var (
+	ed448BenchData = []byte("你好，世界")
+)
+
+// go test -v -bench=^BenchmarkED448_Sign$ -benchtime=1s ed448_test.go
+func BenchmarkED448_Sign(b *testing.B) {
+	privateKey, _, err := ed448.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = privateKey.Sign(ed448BenchData)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkED448_Verify$ -benchtime=1s ed448_test.go
+func BenchmarkED448_Verify(b *testing.B) {
+	privateKey, publicKey, err := ed448.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	sign, err := privateKey.Sign(ed448BenchData)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err = publicKey.Verify(ed448BenchData, sign)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkED448_GenerateKeys$ -benchtime=1s ed448_test.go
+func BenchmarkED448_GenerateKeys(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := ed448.GenerateKeys()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/FishGoddess/cryptox/hash"
@@ -56,6 +57,22 @@ func main() {
 	fmt.Printf("sha512 hex: %s\n", sha512Hex)
 	fmt.Printf("sha512 base64: %s\n", sha512Base64)
 
+	sha3256Hex := hash.SHA3_256(data, hash.WithHex())
+	sha3512Hex := hash.SHA3_512(data, hash.WithHex())
+	fmt.Printf("sha3-256 hex: %s\n", sha3256Hex)
+	fmt.Printf("sha3-512 hex: %s\n", sha3512Hex)
+
+	shake128Hex := hash.SHAKE128(data, hash.WithHex(), hash.WithOutputLength(16))
+	shake256Hex := hash.SHAKE256(data, hash.WithHex(), hash.WithOutputLength(16))
+	fmt.Printf("shake128 hex: %s\n", shake128Hex)
+	fmt.Printf("shake256 hex: %s\n", shake256Hex)
+
+	keccak256Hex := hash.Keccak256(data, hash.WithHex())
+	fmt.Printf("keccak256 hex: %s\n", keccak256Hex)
+
+	sm3Hex := hash.SM3(data, hash.WithHex())
+	fmt.Printf("sm3 hex: %s\n", sm3Hex)
+
 	crc32 := hash.CRC32IEEE(data)
 	fmt.Printf("crc32 ieee: %d\n", crc32)
 
@@ -90,4 +107,26 @@ func main() {
 	fmt.Printf("fnv128a: %s\n", fnv128a)
 	fmt.Printf("fnv128a hex: %s\n", fnv128aHex)
 	fmt.Printf("fnv128a base64: %s\n", fnv128aBase64)
+
+	// Use reader functions to hash an io.Reader without reading it fully into memory.
+	sha256Hex, err := hash.SHA256Reader(bytes.NewReader(data), hash.WithHex())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("sha256 reader hex: %s\n", sha256Hex)
+
+	// Use multi functions to hash data in a single pass, and use hash.File or hash.MultiFile to hash a file.
+	progress := func(read int64, total int64) {
+		fmt.Printf("progress: %d/%d\n", read, total)
+	}
+
+	sums, err := hash.MultiReader(bytes.NewReader(data), []hash.Algorithm{hash.AlgorithmMD5, hash.AlgorithmSHA256, hash.AlgorithmCRC32}, hash.WithHex(), hash.WithProgress(progress))
+	if err != nil {
+		panic(err)
+	}
+
+	for algorithm, sum := range sums {
+		fmt.Printf("multi %s hex: %s\n", algorithm, sum)
+	}
 }
@@ -163,3 +163,104 @@ func BenchmarkHash_Fnv128a(b *testing.B) {
 		hash.Fnv128a(hashBenchData)
 	}
 }
+
+// go test -v -bench=^BenchmarkHash_SHA3_256$ -benchtime=1s hash_test.go
+func BenchmarkHash_SHA3_256(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hash.SHA3_256(hashBenchData)
+	}
+}
+
+// go test -v -bench=^BenchmarkHash_BLAKE2b$ -benchtime=1s hash_test.go
+func BenchmarkHash_BLAKE2b(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hash.BLAKE2b(hashBenchData)
+	}
+}
+
+// go test -v -bench=^BenchmarkHash_BLAKE2s$ -benchtime=1s hash_test.go
+func BenchmarkHash_BLAKE2s(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hash.BLAKE2s(hashBenchData)
+	}
+}
+
+// go test -v -bench=^BenchmarkHash_BLAKE3$ -benchtime=1s hash_test.go
+func BenchmarkHash_BLAKE3(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hash.BLAKE3(hashBenchData)
+	}
+}
+
+// go test -v -bench=^BenchmarkHash_BLAKE3Keyed$ -benchtime=1s hash_test.go
+func BenchmarkHash_BLAKE3Keyed(b *testing.B) {
+	key := []byte("12345678876543211234567887654321")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hash.BLAKE3(hashBenchData, hash.WithKey(key))
+	}
+}
+
+// go test -v -bench=^BenchmarkHash_BLAKE3_1MB$ -benchtime=1s hash_test.go
+func BenchmarkHash_BLAKE3_1MB(b *testing.B) {
+	data := make([]byte, 1024*1024)
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hash.BLAKE3(data)
+	}
+}
+
+// go test -v -bench=^BenchmarkHash_SHA256_1MB$ -benchtime=1s hash_test.go
+func BenchmarkHash_SHA256_1MB(b *testing.B) {
+	data := make([]byte, 1024*1024)
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hash.SHA256(data)
+	}
+}
+
+// go test -v -bench=^BenchmarkHash_SM3$ -benchtime=1s hash_test.go
+func BenchmarkHash_SM3(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hash.SM3(hashBenchData)
+	}
+}
+
+// go test -v -bench=^BenchmarkHash_SM3_1MB$ -benchtime=1s hash_test.go
+func BenchmarkHash_SM3_1MB(b *testing.B) {
+	data := make([]byte, 1024*1024)
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		hash.SM3(data)
+	}
+}
@@ -58,4 +58,15 @@ func main() {
 	fmt.Printf("sha512: %s\n", sha512)
 	fmt.Printf("sha512 hex: %s\n", sha512Hex)
 	fmt.Printf("sha512 base64: %s\n", sha512Base64)
+
+	sha3256Hex := hmac.SHA3_256(data, key, hmac.WithHex())
+	fmt.Printf("sha3-256 hex: %s\n", sha3256Hex)
+
+	sm3Hex := hmac.SM3(data, key, hmac.WithHex())
+	fmt.Printf("sm3 hex: %s\n", sm3Hex)
+
+	kmac128Hex := hmac.KMAC128(data, key, hmac.WithHex(), hmac.WithCustomization([]byte("example")))
+	kmac256Hex := hmac.KMAC256(data, key, hmac.WithHex(), hmac.WithOutputLength(32))
+	fmt.Printf("kmac128 hex: %s\n", kmac128Hex)
+	fmt.Printf("kmac256 hex: %s\n", kmac256Hex)
 }
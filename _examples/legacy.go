@@ -0,0 +1,50 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/aes"
+	"github.com/FishGoddess/cryptox/legacy"
+)
+
+func main() {
+	// The legacy data was encrypted by blowfish-cbc with pkcs7 padding in an old system.
+	// Only decryption is provided by legacy because the ciphers are insecure, so migrate the data to aes-gcm as soon as possible.
+	legacyKey := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+	legacyIV := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}
+	legacyData := []byte("3cf57bde0ab8e1f41e2d0d045813b045")
+
+	decrypt, err := legacy.DecryptBlowfishCBC(legacyData, legacyKey, legacyIV, legacy.WithHex(), legacy.WithPKCS7())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("decrypt: %s\n", decrypt)
+
+	// Migrate the legacy data to aes-gcm in one step, and the plain data is cleared after migrating.
+	key := []byte("12345678876543211234567887654321")
+	nonce := aes.Nonce()
+
+	decryptFunc := func(data []byte) ([]byte, error) {
+		return legacy.DecryptBlowfishCBC(data, legacyKey, legacyIV, legacy.WithHex(), legacy.WithPKCS7())
+	}
+
+	migrate, err := legacy.MigrateGCM(legacyData, decryptFunc, key, nonce, aes.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("migrate: %s\n", migrate)
+
+	// Decrypt the migrated data by aes-gcm.
+	decrypt, err = aes.DecryptGCM(migrate, key, nonce, aes.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("decrypt: %s\n", decrypt)
+}
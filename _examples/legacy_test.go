@@ -0,0 +1,69 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/FishGoddess/cryptox/legacy"
+)
+
+var (
+	legacyBenchKey = []byte("1234567887654321")
+	legacyBenchIV  = []byte("87654321")
+	legacyBenchMsg = make([]byte, 128)
+)
+
+// go test -v -bench=^BenchmarkLegacy_DecryptBlowfishCBC$ -benchtime=1s legacy_test.go
+func BenchmarkLegacy_DecryptBlowfishCBC(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := legacy.DecryptBlowfishCBC(legacyBenchMsg, legacyBenchKey, legacyBenchIV)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkLegacy_DecryptCAST5CBC$ -benchtime=1s legacy_test.go
+func BenchmarkLegacy_DecryptCAST5CBC(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := legacy.DecryptCAST5CBC(legacyBenchMsg, legacyBenchKey, legacyBenchIV)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkLegacy_DecryptRC2CBC$ -benchtime=1s legacy_test.go
+func BenchmarkLegacy_DecryptRC2CBC(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := legacy.DecryptRC2CBC(legacyBenchMsg, legacyBenchKey, legacyBenchIV)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkLegacy_DecryptRC4$ -benchtime=1s legacy_test.go
+func BenchmarkLegacy_DecryptRC4(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := legacy.DecryptRC4(legacyBenchMsg, legacyBenchKey)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
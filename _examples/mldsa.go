@@ -0,0 +1,53 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/mldsa"
+)
+
+func main() {
+	// Generate the ml-dsa-65 keys.
+	// Check mldsa.KeyOption for more information about generating, storing and loading keys.
+	privateKey, publicKey, err := mldsa.GenerateKeys(mldsa.MLDSA65)
+	if err != nil {
+		panic(err)
+	}
+
+	data := []byte("戴上头箍，爱不了你；不戴头箍，救不了你。")
+	fmt.Printf("data: %s\n", data)
+
+	// Use the private key to sign data in hedged variant.
+	// The context separates signatures for different purposes so a firmware signature can't be used as others.
+	sign, err := privateKey.Sign(data, mldsa.WithBase64(), mldsa.WithContext("firmware"))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("sign size: %d\n", len(sign))
+
+	// Use the public key to verify the sign with the same context.
+	err = publicKey.Verify(data, sign, mldsa.WithBase64(), mldsa.WithContext("firmware"))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("verify: %s\n", data)
+
+	// Use the deterministic variant if the same data should always have the same signature.
+	sign, err = privateKey.Sign(data, mldsa.WithBase64(), mldsa.WithDeterministic())
+	if err != nil {
+		panic(err)
+	}
+
+	err = publicKey.Verify(data, sign, mldsa.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("verify deterministic: %s\n", data)
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/FishGoddess/cryptox/mldsa"
+)
+
+var (
+	mldsaBenchData = []byte("你好，世界")
+)
+
+// go test -v -bench=^BenchmarkMLDSA_Sign$ -benchtime=1s mldsa_test.go
+func BenchmarkMLDSA_Sign(b *testing.B) {
+	privateKey, _, err := mldsa.GenerateKeys(mldsa.MLDSA65)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = privateKey.Sign(mldsaBenchData)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkMLDSA_Verify$ -benchtime=1s mldsa_test.go
+func BenchmarkMLDSA_Verify(b *testing.B) {
+	privateKey, publicKey, err := mldsa.GenerateKeys(mldsa.MLDSA65)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	sign, err := privateKey.Sign(mldsaBenchData)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err = publicKey.Verify(mldsaBenchData, sign)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkMLDSA_GenerateKeys$ -benchtime=1s mldsa_test.go
+func BenchmarkMLDSA_GenerateKeys(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := mldsa.GenerateKeys(mldsa.MLDSA65)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
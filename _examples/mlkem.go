@@ -0,0 +1,58 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/aes"
+	"github.com/FishGoddess/cryptox/mlkem"
+)
+
+func main() {
+	// Bob generates ml-kem-768 + x25519 hybrid keys and sends the public key to alice.
+	// Also, you can use mlkem.GenerateKeys(mlkem.MLKEM1024) for ml-kem only.
+	// Check mlkem.KeyOption for more information about generating, storing and loading keys.
+	bobPrivateKey, bobPublicKey, err := mlkem.GenerateHybridKeys()
+	if err != nil {
+		panic(err)
+	}
+
+	// Alice encapsulates a 32 bytes aes key with bob's public key in x-wing.
+	// The ciphertext should be sent to bob so the key can be decapsulated.
+	// Use mlkem.WithHKDF and mlkem.WithHKDFInfo if you want to derive a key of another size.
+	aliceKey, ciphertext, err := bobPublicKey.Encapsulate()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("ciphertext size: %d\n", len(ciphertext))
+
+	data := []byte("你好，世界")
+	fmt.Printf("data: %s\n", data)
+
+	// Alice encrypts data with the key.
+	nonce := aes.Nonce()
+
+	encrypt, err := aes.EncryptGCM(data, aliceKey, nonce, aes.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("encrypt: %s\n", encrypt)
+
+	// Bob decapsulates the key from the ciphertext and decrypts data.
+	bobKey, err := bobPrivateKey.Decapsulate(ciphertext)
+	if err != nil {
+		panic(err)
+	}
+
+	decrypt, err := aes.DecryptGCM(encrypt, bobKey, nonce, aes.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("decrypt: %s\n", decrypt)
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/FishGoddess/cryptox/mlkem"
+)
+
+// go test -v -bench=^BenchmarkMLKEM_Encapsulate$ -benchtime=1s mlkem_test.go
+func BenchmarkMLKEM_Encapsulate(b *testing.B) {
+	_, publicKey, err := mlkem.GenerateKeys(mlkem.MLKEM768)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err = publicKey.Encapsulate()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkMLKEM_Decapsulate$ -benchtime=1s mlkem_test.go
+func BenchmarkMLKEM_Decapsulate(b *testing.B) {
+	privateKey, publicKey, err := mlkem.GenerateKeys(mlkem.MLKEM768)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	_, ciphertext, err := publicKey.Encapsulate()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = privateKey.Decapsulate(ciphertext)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkMLKEM_HybridEncapsulate$ -benchtime=1s mlkem_test.go
+func BenchmarkMLKEM_HybridEncapsulate(b *testing.B) {
+	_, publicKey, err := mlkem.GenerateHybridKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err = publicKey.Encapsulate()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkMLKEM_HybridDecapsulate$ -benchtime=1s mlkem_test.go
+func BenchmarkMLKEM_HybridDecapsulate(b *testing.B) {
+	privateKey, publicKey, err := mlkem.GenerateHybridKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	_, ciphertext, err := publicKey.Encapsulate()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = privateKey.Decapsulate(ciphertext)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkMLKEM_GenerateKeys$ -benchtime=1s mlkem_test.go
+func BenchmarkMLKEM_GenerateKeys(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := mlkem.GenerateKeys(mlkem.MLKEM768)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -5,9 +5,9 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 
-	"github.com/FishGoddess/cryptox/hash"
 	"github.com/FishGoddess/cryptox/rsa"
 )
 
@@ -28,6 +28,8 @@ func main() {
 	fmt.Printf("data: %s\n", data)
 
 	// Use the public key to encrypt data using base64 encoding.
+	// The oaep hash is sha256 by default, and use rsa.WithOAEPHash and rsa.WithOAEPMGFHash to change it.
+	// Use rsa.NewEncrypter and rsa.NewDecrypter to bind the options to the keys, which are goroutine-safe and reusable.
 	label := []byte("你好，世界")
 
 	encrypt, err := publicKey.EncryptOAEP(data, label, rsa.WithBase64())
@@ -45,10 +47,23 @@ func main() {
 
 	fmt.Printf("decrypt: %s\n", decrypt)
 
-	// Use the private key to sign data.
-	digest := hash.SHA256(data)
+	// Use segmented encryption if data is longer than the key, which is known as 分段加密.
+	// The data is split into blocks of the max size of the key and padding, and the encrypted blocks are concatenated.
+	encrypt, err = publicKey.EncryptPKCS1v15Segmented(bytes.Repeat(data, 10), rsa.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	decrypt, err = privateKey.DecryptPKCS1v15Segmented(encrypt, rsa.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("decrypt segmented: %s\n", decrypt)
 
-	sign, err := privateKey.SignPSS(digest, rsa.WithHex())
+	// Use the private key to sign data, and the data is hashed in sha256 internally.
+	// Use rsa.WithCryptoHash to change the hash, or use privateKey.SignPSS to sign the digest directly.
+	sign, err := privateKey.SignPSSMessage(data, rsa.WithHex())
 	if err != nil {
 		panic(err)
 	}
@@ -56,7 +71,7 @@ func main() {
 	fmt.Printf("sign: %s\n", sign)
 
 	// Use the public key to verify the sign.
-	err = publicKey.VerifyPSS(digest, sign, rsa.WithHex())
+	err = publicKey.VerifyPSSMessage(data, sign, rsa.WithHex())
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,59 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/hash"
+	"github.com/FishGoddess/cryptox/secp256k1"
+)
+
+func main() {
+	// Generate a private key and a public key.
+	// Check secp256k1.KeyOption for more information about generating, storing and loading keys.
+	privateKey, publicKey, err := secp256k1.GenerateKeys()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("address: %s\n", publicKey.Address())
+
+	data := []byte("戴上头箍，爱不了你；不戴头箍，救不了你。")
+	fmt.Printf("data: %s\n", data)
+
+	// Sign the digest of data and the signature is r||s||v which is the same as ethereum.
+	digest := hash.Keccak256(data)
+
+	sign, err := privateKey.SignRecoverable(digest, secp256k1.WithHex())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("sign: %s\n", sign)
+
+	// Recover the public key from the digest and the signature.
+	recoverKey, err := secp256k1.RecoverPublicKey(digest, sign, secp256k1.WithHex())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("recover address: %s\n", recoverKey.Address())
+
+	// Use schnorr signature in bip-340 which is used by bitcoin taproot.
+	sign, err = privateKey.SignSchnorr(data, secp256k1.WithHex())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("sign schnorr: %s\n", sign)
+
+	err = publicKey.VerifySchnorr(data, sign, secp256k1.WithHex())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("verify schnorr: %s\n", data)
+}
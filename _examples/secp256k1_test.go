@@ -0,0 +1,122 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/FishGoddess/cryptox/hash"
+	"github.com/FishGoddess/cryptox/secp256k1"
+)
+
+var (
+	secp256k1BenchData   = []byte("你好，世界")
+	secp256k1BenchDigest = hash.Keccak256(secp256k1BenchData)
+)
+
+// go test -v -bench=^BenchmarkSecp256k1_Sign$ -benchtime=1s secp256k1_test.go
+func BenchmarkSecp256k1_Sign(b *testing.B) {
+	privateKey, _, err := secp256k1.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = privateKey.Sign(secp256k1BenchDigest)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSecp256k1_Verify$ -benchtime=1s secp256k1_test.go
+func BenchmarkSecp256k1_Verify(b *testing.B) {
+	privateKey, publicKey, err := secp256k1.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	sign, err := privateKey.Sign(secp256k1BenchDigest)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err = publicKey.Verify(secp256k1BenchDigest, sign)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSecp256k1_RecoverPublicKey$ -benchtime=1s secp256k1_test.go
+func BenchmarkSecp256k1_RecoverPublicKey(b *testing.B) {
+	privateKey, _, err := secp256k1.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	sign, err := privateKey.SignRecoverable(secp256k1BenchDigest)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = secp256k1.RecoverPublicKey(secp256k1BenchDigest, sign)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSecp256k1_SignSchnorr$ -benchtime=1s secp256k1_test.go
+func BenchmarkSecp256k1_SignSchnorr(b *testing.B) {
+	privateKey, _, err := secp256k1.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = privateKey.SignSchnorr(secp256k1BenchData)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSecp256k1_VerifySchnorr$ -benchtime=1s secp256k1_test.go
+func BenchmarkSecp256k1_VerifySchnorr(b *testing.B) {
+	privateKey, publicKey, err := secp256k1.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	sign, err := privateKey.SignSchnorr(secp256k1BenchData)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err = publicKey.VerifySchnorr(secp256k1BenchData, sign)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/sm2"
+)
+
+func main() {
+	// Load the private key and the public key from file.
+	// Check sm2.KeyOption for more information about file encoding.
+	privateKey, err := sm2.LoadPrivateKey("sm2.key")
+	if err != nil {
+		panic(err)
+	}
+
+	publicKey, err := sm2.LoadPublicKey("sm2.pub")
+	if err != nil {
+		panic(err)
+	}
+
+	data := []byte("戴上头箍，爱不了你；不戴头箍，救不了你。")
+	fmt.Printf("data: %s\n", data)
+
+	// Use the private key to sign data with the user id.
+	// The default user id is 1234567812345678.
+	id := []byte("ALICE123@YAHOO.COM")
+
+	sign, err := privateKey.Sign(data, sm2.WithHex(), sm2.WithID(id))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("sign: %s\n", sign)
+
+	// Use the public key to verify the sign with the same user id.
+	err = publicKey.Verify(data, sign, sm2.WithHex(), sm2.WithID(id))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("verify: %s\n", data)
+
+	// Use the public key to encrypt data in c1c3c2 order.
+	// Use sm2.WithC1C2C3() if you need the old order.
+	encrypt, err := publicKey.Encrypt(data, sm2.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("encrypt: %s\n", encrypt)
+
+	// Use the private key to decrypt data.
+	decrypt, err := privateKey.Decrypt(encrypt, sm2.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("decrypt: %s\n", decrypt)
+
+	// Exchange a shared key between a and b.
+	privateKeyB, publicKeyB, err := sm2.GenerateKeys()
+	if err != nil {
+		panic(err)
+	}
+
+	exchangeA, err := sm2.NewKeyExchange(privateKey, publicKeyB, true)
+	if err != nil {
+		panic(err)
+	}
+
+	exchangeB, err := sm2.NewKeyExchange(privateKeyB, publicKey, false)
+	if err != nil {
+		panic(err)
+	}
+
+	keyB, err := exchangeB.SharedKey(exchangeA.EphemeralKey(), 16)
+	if err != nil {
+		panic(err)
+	}
+
+	keyA, err := exchangeA.SharedKey(exchangeB.EphemeralKey(), 16)
+	if err != nil {
+		panic(err)
+	}
+
+	// The confirmations are optional which prove both sides have the same key.
+	if err = exchangeA.VerifyConfirmation(exchangeB.Confirmation()); err != nil {
+		panic(err)
+	}
+
+	if err = exchangeB.VerifyConfirmation(exchangeA.Confirmation()); err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("shared key: %x, %+v\n", keyA, bytes.Equal(keyA, keyB))
+}
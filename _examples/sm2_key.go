@@ -0,0 +1,39 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/FishGoddess/cryptox/sm2"
+)
+
+func main() {
+	// Generate a key which is compatible with openssl.
+	privateKey, publicKey, err := sm2.GenerateKeys()
+	if err != nil {
+		panic(err)
+	}
+
+	// Store the private key and the public key to file in pkcs8 and pkix.
+	err = sm2.StorePrivateKey("sm2.key", privateKey)
+	if err != nil {
+		panic(err)
+	}
+
+	err = sm2.StorePublicKey("sm2.pub", publicKey)
+	if err != nil {
+		panic(err)
+	}
+
+	// Load the private key and the public key from file.
+	privateKey, err = sm2.LoadPrivateKey("sm2.key")
+	if err != nil {
+		panic(err)
+	}
+
+	publicKey, err = sm2.LoadPublicKey("sm2.pub")
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/FishGoddess/cryptox/sm2"
+)
+
+var (
+	sm2BenchData = []byte("你好，世界")
+)
+
+// go test -v -bench=^BenchmarkSM2_GenerateKeys$ -benchtime=1s sm2_test.go
+func BenchmarkSM2_GenerateKeys(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := sm2.GenerateKeys()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM2_Sign$ -benchtime=1s sm2_test.go
+func BenchmarkSM2_Sign(b *testing.B) {
+	privateKey, _, err := sm2.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = privateKey.Sign(sm2BenchData)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM2_Verify$ -benchtime=1s sm2_test.go
+func BenchmarkSM2_Verify(b *testing.B) {
+	privateKey, publicKey, err := sm2.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	sign, err := privateKey.Sign(sm2BenchData)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		err = publicKey.Verify(sm2BenchData, sign)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM2_Encrypt$ -benchtime=1s sm2_test.go
+func BenchmarkSM2_Encrypt(b *testing.B) {
+	_, publicKey, err := sm2.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = publicKey.Encrypt(sm2BenchData)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM2_Decrypt$ -benchtime=1s sm2_test.go
+func BenchmarkSM2_Decrypt(b *testing.B) {
+	privateKey, publicKey, err := sm2.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	encrypt, err := publicKey.Encrypt(sm2BenchData)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err = privateKey.Decrypt(encrypt)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
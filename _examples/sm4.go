@@ -0,0 +1,39 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/sm4"
+)
+
+func main() {
+	// As you know, key is necessary in sm4 and it must be 16 bytes.
+	// However, not all modes need iv, such as ecb.
+	key := []byte("1234567887654321")
+	nonce := []byte("123456abcdef")
+
+	data := []byte("你好，世界")
+	fmt.Printf("data: %s\n", data)
+
+	// Use ccm mode to encrypt data with no padding and encoding base64.
+	encrypt, err := sm4.EncryptCCM(data, key, nonce, sm4.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("encrypt: %s\n", encrypt)
+
+	// Decrypt data in the same way.
+	decrypt, err := sm4.DecryptCCM(encrypt, key, nonce, sm4.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("decrypt: %s\n", decrypt)
+	fmt.Printf("decrypt is right: %+v\n", bytes.Equal(decrypt, data))
+}
@@ -0,0 +1,235 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/FishGoddess/cryptox/sm4"
+)
+
+var (
+	sm4BenchKey   = []byte("1234567887654321")
+	sm4BenchIV    = []byte("8765432112345678")
+	sm4BenchNonce = []byte("123456abcdef")
+	sm4BenchMsg   = make([]byte, 128)
+)
+
+// go test -v -bench=^BenchmarkSM4_EncryptECB$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_EncryptECB(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.EncryptECB(sm4BenchMsg, sm4BenchKey, sm4.WithPKCS7())
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_EncryptCBC$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_EncryptCBC(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.EncryptCBC(sm4BenchMsg, sm4BenchKey, sm4BenchIV, sm4.WithPKCS7())
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_EncryptCFB$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_EncryptCFB(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.EncryptCFB(sm4BenchMsg, sm4BenchKey, sm4BenchIV)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_EncryptOFB$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_EncryptOFB(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.EncryptOFB(sm4BenchMsg, sm4BenchKey, sm4BenchIV)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_EncryptCTR$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_EncryptCTR(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.EncryptCTR(sm4BenchMsg, sm4BenchKey, sm4BenchIV)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_EncryptGCM$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_EncryptGCM(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.EncryptGCM(sm4BenchMsg, sm4BenchKey, sm4BenchNonce)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_EncryptCCM$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_EncryptCCM(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.EncryptCCM(sm4BenchMsg, sm4BenchKey, sm4BenchNonce)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_DecryptECB$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_DecryptECB(b *testing.B) {
+	encrypt, err := sm4.EncryptECB(sm4BenchMsg, sm4BenchKey, sm4.WithPKCS7())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.DecryptECB(encrypt, sm4BenchKey, sm4.WithPKCS7())
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_DecryptCBC$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_DecryptCBC(b *testing.B) {
+	encrypt, err := sm4.EncryptCBC(sm4BenchMsg, sm4BenchKey, sm4BenchIV, sm4.WithPKCS7())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.DecryptCBC(encrypt, sm4BenchKey, sm4BenchIV, sm4.WithPKCS7())
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_DecryptCFB$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_DecryptCFB(b *testing.B) {
+	encrypt, err := sm4.EncryptCFB(sm4BenchMsg, sm4BenchKey, sm4BenchIV)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.DecryptCFB(encrypt, sm4BenchKey, sm4BenchIV)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_DecryptOFB$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_DecryptOFB(b *testing.B) {
+	encrypt, err := sm4.EncryptOFB(sm4BenchMsg, sm4BenchKey, sm4BenchIV)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.DecryptOFB(encrypt, sm4BenchKey, sm4BenchIV)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_DecryptCTR$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_DecryptCTR(b *testing.B) {
+	encrypt, err := sm4.EncryptCTR(sm4BenchMsg, sm4BenchKey, sm4BenchIV)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.DecryptCTR(encrypt, sm4BenchKey, sm4BenchIV)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_DecryptGCM$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_DecryptGCM(b *testing.B) {
+	encrypt, err := sm4.EncryptGCM(sm4BenchMsg, sm4BenchKey, sm4BenchNonce)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.DecryptGCM(encrypt, sm4BenchKey, sm4BenchNonce)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkSM4_DecryptCCM$ -benchtime=1s sm4_test.go
+func BenchmarkSM4_DecryptCCM(b *testing.B) {
+	encrypt, err := sm4.EncryptCCM(sm4BenchMsg, sm4BenchKey, sm4BenchNonce)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := sm4.DecryptCCM(encrypt, sm4BenchKey, sm4BenchNonce)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/x25519"
+)
+
+// go test -v -bench=^BenchmarkX25519_GenerateKeys$ -benchtime=1s x25519_test.go
+func BenchmarkX25519_GenerateKeys(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := x25519.GenerateKeys()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkX25519_SharedSecret$ -benchtime=1s x25519_test.go
+func BenchmarkX25519_SharedSecret(b *testing.B) {
+	privateKey, _, err := x25519.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	_, publicKey, err := x25519.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := privateKey.SharedSecret(publicKey)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// go test -v -bench=^BenchmarkX25519_SharedSecretHKDF$ -benchtime=1s x25519_test.go
+func BenchmarkX25519_SharedSecretHKDF(b *testing.B) {
+	privateKey, _, err := x25519.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	_, publicKey, err := x25519.GenerateKeys()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := privateKey.SharedSecret(publicKey, x25519.WithHKDF(crypto.SHA256, 32))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
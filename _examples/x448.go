@@ -0,0 +1,64 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto"
+	"fmt"
+
+	"github.com/FishGoddess/cryptox/aes"
+	"github.com/FishGoddess/cryptox/x448"
+)
+
+func main() {
+	// Alice and bob generate their own keys and send the public key to each other.
+	// Check x448.KeyOption for more information about generating, storing and loading keys.
+	alicePrivateKey, alicePublicKey, err := x448.GenerateKeys()
+	if err != nil {
+		panic(err)
+	}
+
+	bobPrivateKey, bobPublicKey, err := x448.GenerateKeys()
+	if err != nil {
+		panic(err)
+	}
+
+	// Use hkdf to derive a 32 bytes aes key from the shared secret.
+	opts := []x448.Option{
+		x448.WithHKDF(crypto.SHA256, 32),
+		x448.WithHKDFInfo("aes-gcm key"),
+	}
+
+	aliceKey, err := alicePrivateKey.SharedSecret(bobPublicKey, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	bobKey, err := bobPrivateKey.SharedSecret(alicePublicKey, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	data := []byte("你好，世界")
+	fmt.Printf("data: %s\n", data)
+
+	// Alice encrypts data with her key.
+	nonce := aes.Nonce()
+
+	encrypt, err := aes.EncryptGCM(data, aliceKey, nonce, aes.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("encrypt: %s\n", encrypt)
+
+	// Bob decrypts data with his key which is the same as alice's.
+	decrypt, err := aes.DecryptGCM(encrypt, bobKey, nonce, aes.WithBase64())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("decrypt: %s\n", decrypt)
+}
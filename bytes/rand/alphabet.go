@@ -0,0 +1,70 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// AlphabetLower is the alphabet of lower letters.
+	AlphabetLower = "abcdefghijklmnopqrstuvwxyz"
+
+	// AlphabetUpper is the alphabet of upper letters.
+	AlphabetUpper = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	// AlphabetDigits is the alphabet of digits.
+	AlphabetDigits = "0123456789"
+
+	// AlphabetSymbols is the alphabet of symbols which are easy to type.
+	AlphabetSymbols = "!@#$%^&*()-_=+[]{};:,.<>/?~"
+
+	// AlphabetAmbiguous is the alphabet of characters which are easy to confuse.
+	AlphabetAmbiguous = "Il1O0o"
+)
+
+// StringFrom returns a string including n characters chosen from alphabet uniformly.
+// It always reads from the reader in config and ignores weak.
+func StringFrom(alphabet string, n int, opts ...Option) (string, error) {
+	conf := newConfig().Apply(opts...)
+
+	if n < 0 {
+		return "", fmt.Errorf("cryptox/rand: n %d < 0", n)
+	}
+
+	runes := []rune(alphabet)
+	if len(runes) <= 0 {
+		return "", errors.New("cryptox/rand: alphabet is empty")
+	}
+
+	result := make([]rune, n)
+	for i := range result {
+		index, err := uint64N(conf.reader, uint64(len(runes)))
+		if err != nil {
+			return "", err
+		}
+
+		result[i] = runes[index]
+	}
+
+	return string(result), nil
+}
+
+// removeChars removes all characters in chars from alphabet.
+func removeChars(alphabet string, chars string) string {
+	if chars == "" {
+		return alphabet
+	}
+
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(chars, r) {
+			return -1
+		}
+
+		return r
+	}, alphabet)
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// go test -v -cover -run=^TestStringFrom$
+func TestStringFrom(t *testing.T) {
+	alphabets := []string{AlphabetLower, AlphabetDigits, "你好世界", "a"}
+
+	for _, alphabet := range alphabets {
+		for i := 0; i <= 64; i++ {
+			str, err := StringFrom(alphabet, i)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if utf8.RuneCountInString(str) != i {
+				t.Fatalf("rune count of %q != %d", str, i)
+			}
+
+			for _, r := range str {
+				if !strings.ContainsRune(alphabet, r) {
+					t.Fatalf("r %q not in alphabet %q", r, alphabet)
+				}
+			}
+		}
+	}
+
+	if _, err := StringFrom("", 8); err == nil {
+		t.Fatal("string from an empty alphabet should fail")
+	}
+
+	if _, err := StringFrom(AlphabetLower, -1); err == nil {
+		t.Fatal("string from with n -1 should fail")
+	}
+}
+
+// go test -v -cover -run=^TestRemoveChars$
+func TestRemoveChars(t *testing.T) {
+	got := removeChars(AlphabetDigits+AlphabetUpper, AlphabetAmbiguous)
+	want := "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+	if got != want {
+		t.Fatalf("got %q != want %q", got, want)
+	}
+
+	got = removeChars(AlphabetDigits, "")
+	if got != AlphabetDigits {
+		t.Fatalf("got %q != want %q", got, AlphabetDigits)
+	}
+}
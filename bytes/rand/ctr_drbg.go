@@ -0,0 +1,231 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"sync"
+)
+
+// CTRDRBG is a ctr drbg using aes and derivation function in nist sp 800-90a which can be used as an io.Reader.
+// It's safe to use it in concurrency.
+// It's not always used by the standard library as a random reader, see WithDRBGSeed for the cryptocustomrand setting.
+type CTRDRBG struct {
+	conf          *DRBGConfig
+	block         cipher.Block
+	value         []byte
+	reseedCounter uint64
+	lock          sync.Mutex
+}
+
+// NewCTRDRBG returns a new ctr drbg instantiated with entropy input from entropy source.
+// The default key size is 32 which means aes-256 and the default entropy source is crypto/rand.Reader.
+func NewCTRDRBG(opts ...DRBGOption) (*CTRDRBG, error) {
+	conf := newDRBGConfig().Apply(opts...)
+
+	block, err := aes.NewCipher(make([]byte, conf.keySize))
+	if err != nil {
+		return nil, err
+	}
+
+	material, err := seedMaterial(conf, conf.keySize)
+	if err != nil {
+		return nil, err
+	}
+
+	drbg := &CTRDRBG{
+		conf:  conf,
+		block: block,
+		value: make([]byte, aes.BlockSize),
+	}
+
+	if err = drbg.update(drbg.derive(material)); err != nil {
+		return nil, err
+	}
+
+	drbg.reseedCounter = 1
+	return drbg, nil
+}
+
+func (cd *CTRDRBG) seedLen() int {
+	return cd.conf.keySize + aes.BlockSize
+}
+
+// increment increments the value as a big endian counter.
+func (cd *CTRDRBG) increment() {
+	for i := len(cd.value) - 1; i >= 0; i-- {
+		cd.value[i]++
+
+		if cd.value[i] != 0 {
+			break
+		}
+	}
+}
+
+// update updates the key and value with provided data of seed length.
+func (cd *CTRDRBG) update(provided []byte) error {
+	seedLen := cd.seedLen()
+	temp := make([]byte, 0, seedLen+aes.BlockSize)
+	out := make([]byte, aes.BlockSize)
+
+	for len(temp) < seedLen {
+		cd.increment()
+		cd.block.Encrypt(out, cd.value)
+		temp = append(temp, out...)
+	}
+
+	temp = temp[:seedLen]
+	for i := range temp {
+		temp[i] ^= provided[i]
+	}
+
+	block, err := aes.NewCipher(temp[:cd.conf.keySize])
+	if err != nil {
+		return err
+	}
+
+	cd.block = block
+	copy(cd.value, temp[cd.conf.keySize:])
+	return nil
+}
+
+// bcc is the bcc function in nist sp 800-90a.
+func bcc(block cipher.Block, data []byte) []byte {
+	chaining := make([]byte, aes.BlockSize)
+
+	for start := 0; start < len(data); start += aes.BlockSize {
+		for i := range chaining {
+			chaining[i] ^= data[start+i]
+		}
+
+		block.Encrypt(chaining, chaining)
+	}
+
+	return chaining
+}
+
+// derive is the block cipher derivation function in nist sp 800-90a.
+// It returns seed length bytes derived from input.
+func (cd *CTRDRBG) derive(input []byte) []byte {
+	keySize := cd.conf.keySize
+	seedLen := cd.seedLen()
+
+	// S = L || N || input || 0x80 || 0x00...
+	s := make([]byte, aes.BlockSize, aes.BlockSize+8+len(input)+aes.BlockSize)
+	s = binary.BigEndian.AppendUint32(s, uint32(len(input)))
+	s = binary.BigEndian.AppendUint32(s, uint32(seedLen))
+	s = append(s, input...)
+	s = append(s, 0x80)
+
+	for len(s)%aes.BlockSize != 0 {
+		s = append(s, 0x00)
+	}
+
+	key := make([]byte, keySize)
+	for i := range key {
+		key[i] = byte(i)
+	}
+
+	// The key is valid so the error can be ignored.
+	block, _ := aes.NewCipher(key)
+
+	temp := make([]byte, 0, seedLen+aes.BlockSize)
+	for i := uint32(0); len(temp) < seedLen; i++ {
+		binary.BigEndian.PutUint32(s, i)
+		temp = append(temp, bcc(block, s)...)
+	}
+
+	block, _ = aes.NewCipher(temp[:keySize])
+	x := temp[keySize:seedLen]
+
+	derived := make([]byte, 0, seedLen+aes.BlockSize)
+	for len(derived) < seedLen {
+		block.Encrypt(x, x)
+		derived = append(derived, x...)
+	}
+
+	return derived[:seedLen]
+}
+
+func (cd *CTRDRBG) reseed(additional []byte) error {
+	entropy, err := readEntropy(cd.conf.entropy, cd.conf.keySize)
+	if err != nil {
+		return err
+	}
+
+	material := append(entropy, additional...)
+	if err = cd.update(cd.derive(material)); err != nil {
+		return err
+	}
+
+	cd.reseedCounter = 1
+	return nil
+}
+
+// Reseed reseeds the drbg with entropy input from entropy source and additional input.
+func (cd *CTRDRBG) Reseed(additional []byte) error {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+
+	return cd.reseed(additional)
+}
+
+func (cd *CTRDRBG) generate(data []byte, additional []byte) error {
+	if len(data) > maxRequestSize {
+		return fmt.Errorf("cryptox/rand: ctr drbg request size %d > max %d", len(data), maxRequestSize)
+	}
+
+	if cd.reseedCounter > cd.conf.reseedInterval {
+		if err := cd.reseed(additional); err != nil {
+			return fmt.Errorf("%w: %w", errReseedRequired, err)
+		}
+
+		additional = nil
+	}
+
+	if len(additional) > 0 {
+		additional = cd.derive(additional)
+
+		if err := cd.update(additional); err != nil {
+			return err
+		}
+	} else {
+		additional = make([]byte, cd.seedLen())
+	}
+
+	out := make([]byte, aes.BlockSize)
+	for n := 0; n < len(data); {
+		cd.increment()
+		cd.block.Encrypt(out, cd.value)
+		n += copy(data[n:], out)
+	}
+
+	if err := cd.update(additional); err != nil {
+		return err
+	}
+
+	cd.reseedCounter++
+	return nil
+}
+
+// Generate generates len(data) bytes with additional input to data.
+// The length of data should be less than 64KB.
+func (cd *CTRDRBG) Generate(data []byte, additional []byte) error {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+
+	return cd.generate(data, additional)
+}
+
+// Read reads len(data) bytes in random to data.
+func (cd *CTRDRBG) Read(data []byte) (n int, err error) {
+	cd.lock.Lock()
+	defer cd.lock.Unlock()
+
+	return readAll(cd.generate, data)
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"slices"
+	"testing"
+)
+
+// go test -v -cover -run=^TestCTRDRBG$
+func TestCTRDRBG(t *testing.T) {
+	// From nist cavp drbgvectors_no_reseed and drbgvectors_pr_false.
+	testCases := []drbgTestCase{
+		{
+			KeySize:      16,
+			Entropy:      "890eb067acf7382eff80b0c73bc872c6",
+			Nonce:        "aad471ef3ef1d203",
+			ReturnedBits: "a5514ed7095f64f3d0d3a5760394ab42062f373a25072a6ea6bcfd8489e94af6cf18659fea22ed1ca0a9e33f718b115ee536b12809c31b72b08ddd8be1910fa3",
+		},
+		{
+			KeySize:      32,
+			Entropy:      "36401940fa8b1fba91a1661f211d78a0b9389a74e5bccfece8d766af1a6d3b14",
+			Nonce:        "496f25b0f1301b4f501be30380a137eb",
+			ReturnedBits: "5862eb38bd558dd978a696e6df164782ddd887e7e9a6c9f3f1fbafb78941b535a64912dfd224c6dc7454e5250b3d97165e16260c2faf1cc7735cb75fb4f07e1d",
+		},
+		{
+			KeySize:      32,
+			Entropy:      "8148d65d86513ce7d38923ec2f26b9e7c677dcc8997e325b7372619e753ed944",
+			Nonce:        "41c71a24d17d974190982bb7515ce7f5",
+			Additional1:  "55b446046c2d14bdd0cdba4b71873fd4762650695a11507949462da8d964ab6a",
+			Additional2:  "91468f1a097d99ee339462ca916cb4a10f63d53850a4f17f598eac490299b02e",
+			ReturnedBits: "54603d1a506132bbfa05b153a04f22a1d516cc46323cef15111af221f030f38d6841d4670518b4914a4631af682e7421dffaac986a38e94d92bfa758e2eb101f",
+		},
+		{
+			KeySize:         32,
+			Entropy:         "87b56e964eba227154724bb9484b812d3e2c0c43b3d17f6098d9526e16e6d0ef",
+			Nonce:           "9bea6a7ff2358df142e6c23e2157fb83",
+			Personalization: "9860b432edd58d1ccbfeecbce99ffaee7d935a614860d4e965bd67041403096b",
+			Additional1:     "99a5cc87924e8ea65a596f81fd17d63f5b4542fe6e8e1511b5d35c835dfadb0b",
+			Additional2:     "9a8dec54734a34582a2332f3452e82313524c3e0dfb485faeac6ca5fc0ff504d",
+			ReturnedBits:    "dbc6a2330b19b5cddd8cd6392ec1fb508678c805e87d1aca07ac265007632503044a00610c79d98375afa7ab4cca1a90989cbfe7c674af5d823ced11c47e9af6",
+		},
+		{
+			KeySize:         32,
+			Entropy:         "5bb14bec3a2e435acab8b891f075107df387902cb2cd996021b1a1245d4ea2b5",
+			Nonce:           "12ac7f444e247f770d2f4d0a65fdab4e",
+			Personalization: "2e957d53cba5a6b9b8a2ce4369bb885c0931788015b9fe5ac3c01a7ec5eacd70",
+			EntropyReseed:   "19f30c84f6dbf1caf68cbec3d4bb90e5e8f5716eae8c1bbadaba99a2a2bd4eb2",
+			ReturnedBits:    "b7dd8ac2c5eaa97c779fe46cc793b9b1e7b940c318d3b531744b42856f298264e45f9a0aca5da93e7f34f0ebc0ed0ea32c009e3e03cf01320c9a839807575405",
+		},
+	}
+
+	newDRBG := func(opts ...DRBGOption) (testDRBG, error) {
+		return NewCTRDRBG(opts...)
+	}
+
+	testDRBGVectors(t, newDRBG, testCases)
+}
+
+// go test -v -cover -run=^TestCTRDRBGRead$
+func TestCTRDRBGRead(t *testing.T) {
+	seed := []byte("123456788765432112345678876543211234567887654321")
+
+	drbg1, err := NewCTRDRBG(WithDRBGSeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	drbg2, err := NewCTRDRBG(WithDRBGSeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data1 := make([]byte, 3*maxRequestSize+1)
+	if _, err = drbg1.Read(data1); err != nil {
+		t.Fatal(err)
+	}
+
+	data2 := make([]byte, len(data1))
+	if _, err = drbg2.Read(data2); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(data1, data2) {
+		t.Fatal("data1 != data2")
+	}
+
+	drbg, err := NewCTRDRBG(WithDRBGKeySize(16), WithDRBGReseedInterval(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10; i++ {
+		if _, err = drbg.Read(data1); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err = NewCTRDRBG(WithDRBGKeySize(10)); err == nil {
+		t.Fatal("new ctr drbg with key size 10 should fail")
+	}
+}
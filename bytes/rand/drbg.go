@@ -0,0 +1,85 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// defaultReseedInterval is the default number of generating between two reseeding.
+	defaultReseedInterval = 1 << 16
+
+	// maxReseedInterval is the max reseed interval of hmac drbg and ctr drbg in sp 800-90a.
+	maxReseedInterval = 1 << 48
+
+	// maxRequestSize is the max bytes of one generating of hmac drbg and ctr drbg in sp 800-90a.
+	maxRequestSize = (1 << 19) / 8
+)
+
+var (
+	errReseedRequired = errors.New("cryptox/rand: drbg reseed required")
+)
+
+// readEntropy reads entropy input of n bytes from the entropy source.
+func readEntropy(entropy io.Reader, n int) ([]byte, error) {
+	if entropy == nil {
+		return nil, errors.New("cryptox/rand: drbg entropy source is nil")
+	}
+
+	data := make([]byte, n)
+	if _, err := io.ReadFull(entropy, data); err != nil {
+		return nil, fmt.Errorf("cryptox/rand: drbg read entropy failed: %w", err)
+	}
+
+	return data, nil
+}
+
+// seedMaterial returns entropy input || nonce || personalization string for instantiating.
+// The strength is the security strength in bytes and the nonce will be read from entropy source if it's not set.
+func seedMaterial(conf *DRBGConfig, strength int) ([]byte, error) {
+	if conf.reseedInterval < 1 || conf.reseedInterval > maxReseedInterval {
+		return nil, fmt.Errorf("cryptox/rand: drbg reseed interval %d not in [1, %d]", conf.reseedInterval, uint64(maxReseedInterval))
+	}
+
+	entropy, err := readEntropy(conf.entropy, strength)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := conf.nonce
+	if len(nonce) == 0 {
+		nonce, err = readEntropy(conf.entropy, strength/2)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	material := make([]byte, 0, len(entropy)+len(nonce)+len(conf.personalization))
+	material = append(material, entropy...)
+	material = append(material, nonce...)
+	material = append(material, conf.personalization...)
+	return material, nil
+}
+
+// generateFunc generates len(data) bytes which is less than maxRequestSize.
+type generateFunc func(data []byte, additional []byte) error
+
+// readAll fills data by generating several times so it can read more than maxRequestSize bytes.
+func readAll(generate generateFunc, data []byte) (n int, err error) {
+	for n < len(data) {
+		end := min(n+maxRequestSize, len(data))
+
+		if err = generate(data[n:end], nil); err != nil {
+			return n, err
+		}
+
+		n = end
+	}
+
+	return n, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"slices"
+	"testing"
+)
+
+// go test -v -cover -run=^TestSeedMaterial$
+func TestSeedMaterial(t *testing.T) {
+	seed := []byte("123456788765432112345678")
+	conf := newDRBGConfig().Apply(WithDRBGSeed(seed), WithDRBGPersonalization([]byte("abc")))
+
+	material, err := seedMaterial(conf, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []byte("123456788765432112345678abc")
+	if !slices.Equal(material, want) {
+		t.Fatalf("got %s != want %s", material, want)
+	}
+
+	conf = newDRBGConfig().Apply(WithDRBGSeed(seed), WithDRBGNonce([]byte("nonce")))
+
+	material, err = seedMaterial(conf, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want = []byte("1234567887654321nonce")
+	if !slices.Equal(material, want) {
+		t.Fatalf("got %s != want %s", material, want)
+	}
+
+	conf = newDRBGConfig().Apply(WithDRBGSeed(seed[:8]))
+	if _, err = seedMaterial(conf, 16); err == nil {
+		t.Fatal("seed material with a short seed should fail")
+	}
+
+	conf = newDRBGConfig().Apply(WithDRBGEntropy(nil))
+	if _, err = seedMaterial(conf, 16); err == nil {
+		t.Fatal("seed material with a nil entropy should fail")
+	}
+
+	conf = newDRBGConfig().Apply(WithDRBGReseedInterval(0))
+	if _, err = seedMaterial(conf, 16); err == nil {
+		t.Fatal("seed material with reseed interval 0 should fail")
+	}
+}
+
+// go test -v -cover -run=^TestReadAll$
+func TestReadAll(t *testing.T) {
+	calls := 0
+	generate := func(data []byte, additional []byte) error {
+		if len(data) > maxRequestSize {
+			t.Fatalf("len(data) %d > maxRequestSize %d", len(data), maxRequestSize)
+		}
+
+		for i := range data {
+			data[i] = byte(calls)
+		}
+
+		calls++
+		return nil
+	}
+
+	data := make([]byte, 2*maxRequestSize+1)
+
+	n, err := readAll(generate, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != len(data) {
+		t.Fatalf("n %d != len(data) %d", n, len(data))
+	}
+
+	if calls != 3 {
+		t.Fatalf("calls %d != 3", calls)
+	}
+
+	if data[len(data)-1] != 2 {
+		t.Fatalf("data[len(data)-1] %d != 2", data[len(data)-1])
+	}
+}
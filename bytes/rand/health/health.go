@@ -0,0 +1,173 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package health
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+)
+
+var (
+	// ErrRepetitionCount is returned if a byte repeats too many times in a row.
+	ErrRepetitionCount = errors.New("cryptox/health: repetition count test failed")
+
+	// ErrAdaptiveProportion is returned if a byte occurs too many times in a window.
+	ErrAdaptiveProportion = errors.New("cryptox/health: adaptive proportion test failed")
+)
+
+// repetitionCountCutoff returns the cutoff of repetition count test in SP 800-90B 4.4.1.
+func repetitionCountCutoff(minEntropy float64, alpha float64) int {
+	return 1 + int(math.Ceil(-math.Log2(alpha)/minEntropy))
+}
+
+// adaptiveProportionCutoff returns the cutoff of adaptive proportion test in SP 800-90B 4.4.2.
+// The cutoff is 1 + critbinom(w, 2^-h, 1 - alpha) which is the smallest k that binomial cdf(k) >= 1 - alpha.
+func adaptiveProportionCutoff(minEntropy float64, alpha float64, windowSize int) int {
+	p := math.Exp2(-minEntropy)
+	n := float64(windowSize)
+	lgammaN, _ := math.Lgamma(n + 1)
+
+	cdf := 0.0
+	for k := 0; k <= windowSize; k++ {
+		lgammaK, _ := math.Lgamma(float64(k) + 1)
+		lgammaNK, _ := math.Lgamma(n - float64(k) + 1)
+
+		// Use log to avoid overflow of the binomial coefficient.
+		logPMF := lgammaN - lgammaK - lgammaNK + float64(k)*math.Log(p) + (n-float64(k))*math.Log1p(-p)
+		cdf += math.Exp(logPMF)
+
+		if cdf >= 1-alpha {
+			return 1 + k
+		}
+	}
+
+	return 1 + windowSize
+}
+
+// Reader wraps an io.Reader and runs SP 800-90B continuous health tests on each byte read from it.
+// It fails closed, which means it discards the data and returns the error forever once a test fails.
+type Reader struct {
+	reader io.Reader
+
+	repetitionCutoff int
+	repetitionValue  byte
+	repetitionCount  int
+
+	proportionCutoff int
+	proportionWindow int
+	proportionValue  byte
+	proportionCount  int
+	proportionIndex  int
+
+	err  error
+	lock sync.Mutex
+}
+
+// NewReader returns a reader running health tests on the bytes read from reader.
+func NewReader(reader io.Reader, opts ...Option) (*Reader, error) {
+	conf := newConfig().Apply(opts...)
+
+	if conf.minEntropy <= 0 || conf.minEntropy > 8 {
+		return nil, fmt.Errorf("cryptox/health: min entropy %f not in (0, 8]", conf.minEntropy)
+	}
+
+	if conf.alpha <= 0 || conf.alpha >= 1 {
+		return nil, fmt.Errorf("cryptox/health: alpha %g not in (0, 1)", conf.alpha)
+	}
+
+	if conf.windowSize < 2 {
+		return nil, fmt.Errorf("cryptox/health: window size %d < 2", conf.windowSize)
+	}
+
+	r := &Reader{
+		reader:           reader,
+		repetitionCutoff: repetitionCountCutoff(conf.minEntropy, conf.alpha),
+		proportionCutoff: adaptiveProportionCutoff(conf.minEntropy, conf.alpha, conf.windowSize),
+		proportionWindow: conf.windowSize,
+	}
+
+	return r, nil
+}
+
+func (r *Reader) repetitionCountTest(value byte) error {
+	if r.repetitionCount > 0 && value == r.repetitionValue {
+		r.repetitionCount++
+
+		if r.repetitionCount >= r.repetitionCutoff {
+			return ErrRepetitionCount
+		}
+
+		return nil
+	}
+
+	r.repetitionValue = value
+	r.repetitionCount = 1
+	return nil
+}
+
+func (r *Reader) adaptiveProportionTest(value byte) error {
+	if r.proportionIndex == 0 {
+		r.proportionValue = value
+		r.proportionCount = 1
+		r.proportionIndex = 1
+		return nil
+	}
+
+	if value == r.proportionValue {
+		r.proportionCount++
+
+		if r.proportionCount >= r.proportionCutoff {
+			return ErrAdaptiveProportion
+		}
+	}
+
+	r.proportionIndex++
+	if r.proportionIndex >= r.proportionWindow {
+		r.proportionIndex = 0
+	}
+
+	return nil
+}
+
+// Read reads data from the wrapped reader and runs health tests on it.
+// It clears data and returns an error if any test failed.
+func (r *Reader) Read(data []byte) (n int, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	n, err = r.reader.Read(data)
+
+	for _, value := range data[:n] {
+		if r.err = r.repetitionCountTest(value); r.err != nil {
+			break
+		}
+
+		if r.err = r.adaptiveProportionTest(value); r.err != nil {
+			break
+		}
+	}
+
+	if r.err != nil {
+		clear(data[:n])
+		return 0, r.err
+	}
+
+	return n, err
+}
+
+// Err returns the error of health tests, and it's nil if all tests passed.
+func (r *Reader) Err() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.err
+}
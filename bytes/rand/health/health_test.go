@@ -0,0 +1,156 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package health
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+type testPatternReader struct {
+	pattern []byte
+	index   int
+}
+
+func (tpr *testPatternReader) Read(data []byte) (int, error) {
+	for i := range data {
+		data[i] = tpr.pattern[tpr.index%len(tpr.pattern)]
+		tpr.index++
+	}
+
+	return len(data), nil
+}
+
+// go test -v -cover -run=^TestRepetitionCountCutoff$
+func TestRepetitionCountCutoff(t *testing.T) {
+	alpha := 1.0 / (1 << 20)
+
+	testCases := map[float64]int{0.5: 41, 1: 21, 2: 11, 4: 6, 8: 4}
+	for minEntropy, expect := range testCases {
+		got := repetitionCountCutoff(minEntropy, alpha)
+		if got != expect {
+			t.Fatalf("min entropy %f: got %d != expect %d", minEntropy, got, expect)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestAdaptiveProportionCutoff$
+func TestAdaptiveProportionCutoff(t *testing.T) {
+	alpha := 1.0 / (1 << 20)
+
+	// The cutoffs come from SP 800-90B 4.4.2 table 2.
+	testCases := map[float64]int{0.5: 410, 1: 311, 2: 177, 4: 62, 8: 13}
+	for minEntropy, expect := range testCases {
+		got := adaptiveProportionCutoff(minEntropy, alpha, 512)
+		if got != expect {
+			t.Fatalf("min entropy %f: got %d != expect %d", minEntropy, got, expect)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestNewReader$
+func TestNewReader(t *testing.T) {
+	invalids := [][]Option{
+		{WithMinEntropy(0)},
+		{WithMinEntropy(9)},
+		{WithAlpha(0)},
+		{WithAlpha(1)},
+		{WithWindowSize(1)},
+	}
+
+	for _, opts := range invalids {
+		if _, err := NewReader(rand.Reader, opts...); err == nil {
+			t.Fatalf("new reader with %d options should fail", len(opts))
+		}
+	}
+}
+
+// go test -v -cover -run=^TestReader$
+func TestReader(t *testing.T) {
+	reader, err := NewReader(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := make([]byte, 1<<20)
+	if _, err = io.ReadFull(reader, data); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = reader.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The health tests don't change the error of the wrapped reader.
+	reader, err = NewReader(bytes.NewReader(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = reader.Read(data); err != io.EOF {
+		t.Fatalf("err %+v != io.EOF", err)
+	}
+}
+
+// go test -v -cover -run=^TestReaderRepetitionCount$
+func TestReaderRepetitionCount(t *testing.T) {
+	reader, err := NewReader(&testPatternReader{pattern: []byte{1, 2, 3, 3, 3, 3, 3, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := make([]byte, 16)
+
+	n, err := reader.Read(data)
+	if !errors.Is(err, ErrRepetitionCount) {
+		t.Fatalf("err %+v isn't ErrRepetitionCount", err)
+	}
+
+	if n != 0 || !bytes.Equal(data, make([]byte, len(data))) {
+		t.Fatalf("n %d != 0 or data %+v isn't cleared", n, data)
+	}
+
+	// The reader fails closed.
+	if _, err = reader.Read(data); !errors.Is(err, ErrRepetitionCount) {
+		t.Fatalf("err %+v isn't ErrRepetitionCount", err)
+	}
+
+	if err = reader.Err(); !errors.Is(err, ErrRepetitionCount) {
+		t.Fatalf("err %+v isn't ErrRepetitionCount", err)
+	}
+}
+
+// go test -v -cover -run=^TestReaderAdaptiveProportion$
+func TestReaderAdaptiveProportion(t *testing.T) {
+	// Zero occurs half of the time but never repeats.
+	reader, err := NewReader(&testPatternReader{pattern: []byte{0, 1, 0, 2, 0, 3, 0, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := make([]byte, 64)
+	for i := 0; i < 16; i++ {
+		if _, err = reader.Read(data); err != nil {
+			break
+		}
+	}
+
+	if !errors.Is(err, ErrAdaptiveProportion) {
+		t.Fatalf("err %+v isn't ErrAdaptiveProportion", err)
+	}
+
+	// A bigger window with less min entropy allows more occurrences.
+	reader, err = NewReader(&testPatternReader{pattern: []byte{0, 1, 0, 2}}, WithMinEntropy(1), WithWindowSize(1024))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = io.ReadFull(reader, make([]byte, 1<<16)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package health
+
+type Config struct {
+	minEntropy float64
+	alpha      float64
+	windowSize int
+}
+
+func newConfig() *Config {
+	conf := &Config{
+		minEntropy: 4,
+		alpha:      1.0 / (1 << 20),
+		windowSize: 512,
+	}
+
+	return conf
+}
+
+func (c *Config) Apply(opts ...Option) *Config {
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+type Option func(conf *Config)
+
+// WithMinEntropy sets min entropy per byte to config.
+// It should be the min entropy assessed for the source, and it must be in (0, 8].
+func WithMinEntropy(minEntropy float64) Option {
+	return func(conf *Config) {
+		conf.minEntropy = minEntropy
+	}
+}
+
+// WithAlpha sets the false positive probability of health tests to config.
+// It must be in (0, 1), and SP 800-90B recommends it in [2^-40, 2^-20].
+func WithAlpha(alpha float64) Option {
+	return func(conf *Config) {
+		conf.alpha = alpha
+	}
+}
+
+// WithWindowSize sets the window size of adaptive proportion test to config.
+// SP 800-90B uses 512 for non-binary samples.
+func WithWindowSize(windowSize int) Option {
+	return func(conf *Config) {
+		conf.windowSize = windowSize
+	}
+}
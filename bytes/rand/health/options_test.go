@@ -0,0 +1,30 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package health
+
+import "testing"
+
+// go test -v -cover -run=^TestConfig$
+func TestConfig(t *testing.T) {
+	opts := []Option{
+		WithMinEntropy(2),
+		WithAlpha(0.001),
+		WithWindowSize(1024),
+	}
+
+	conf := newConfig().Apply(opts...)
+
+	if conf.minEntropy != 2 {
+		t.Fatalf("got %f != expect %f", conf.minEntropy, 2.0)
+	}
+
+	if conf.alpha != 0.001 {
+		t.Fatalf("got %f != expect %f", conf.alpha, 0.001)
+	}
+
+	if conf.windowSize != 1024 {
+		t.Fatalf("got %d != expect %d", conf.windowSize, 1024)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package health
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Significance is the level of significance used in SP 800-22.
+// A sequence is considered random if the p-value >= Significance.
+const Significance = 0.01
+
+const (
+	igamEpsilon    = 1e-15
+	igamIterations = 1000
+)
+
+// unpackBits unpacks data to bits in msb first order.
+func unpackBits(data []byte) []byte {
+	bits := make([]byte, 0, len(data)*8)
+	for _, b := range data {
+		for i := 7; i >= 0; i-- {
+			bits = append(bits, (b>>i)&1)
+		}
+	}
+
+	return bits
+}
+
+// igamc returns the regularized upper incomplete gamma function Q(a, x).
+func igamc(a float64, x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+
+	lgammaA, _ := math.Lgamma(a)
+	logPrefix := a*math.Log(x) - x - lgammaA
+
+	// Use the series of P(a, x) if x < a + 1, and Q(a, x) = 1 - P(a, x).
+	if x < a+1 {
+		sum := 1 / a
+		term := sum
+
+		for n := 1; n < igamIterations; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+
+			if math.Abs(term) < math.Abs(sum)*igamEpsilon {
+				break
+			}
+		}
+
+		return 1 - sum*math.Exp(logPrefix)
+	}
+
+	// Use the continued fraction of Q(a, x) with modified lentz's method.
+	tiny := 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+
+	for n := 1; n < igamIterations; n++ {
+		an := -float64(n) * (float64(n) - a)
+		b += 2
+
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+
+		d = 1 / d
+		delta := d * c
+		h *= delta
+
+		if math.Abs(delta-1) < igamEpsilon {
+			break
+		}
+	}
+
+	return math.Exp(logPrefix) * h
+}
+
+func frequency(bits []byte) (float64, error) {
+	if len(bits) == 0 {
+		return 0, errors.New("cryptox/health: frequency test needs at least 1 bit")
+	}
+
+	sum := 0
+	for _, bit := range bits {
+		sum += 2*int(bit) - 1
+	}
+
+	n := float64(len(bits))
+	observed := math.Abs(float64(sum)) / math.Sqrt(n)
+	return math.Erfc(observed / math.Sqrt2), nil
+}
+
+func blockFrequency(bits []byte, blockSize int) (float64, error) {
+	if blockSize <= 0 {
+		return 0, fmt.Errorf("cryptox/health: block size %d <= 0", blockSize)
+	}
+
+	blocks := len(bits) / blockSize
+	if blocks == 0 {
+		return 0, fmt.Errorf("cryptox/health: block frequency test needs at least %d bits", blockSize)
+	}
+
+	chiSquared := 0.0
+	for i := 0; i < blocks; i++ {
+		ones := 0
+		for _, bit := range bits[i*blockSize : (i+1)*blockSize] {
+			ones += int(bit)
+		}
+
+		proportion := float64(ones)/float64(blockSize) - 0.5
+		chiSquared += proportion * proportion
+	}
+
+	chiSquared *= 4 * float64(blockSize)
+	return igamc(float64(blocks)/2, chiSquared/2), nil
+}
+
+func runs(bits []byte) (float64, error) {
+	if len(bits) == 0 {
+		return 0, errors.New("cryptox/health: runs test needs at least 1 bit")
+	}
+
+	ones := 0
+	for _, bit := range bits {
+		ones += int(bit)
+	}
+
+	n := float64(len(bits))
+	proportion := float64(ones) / n
+
+	// The runs test is not applicable if the frequency test fails.
+	if math.Abs(proportion-0.5) >= 2/math.Sqrt(n) {
+		return 0, nil
+	}
+
+	observed := 1
+	for i := 1; i < len(bits); i++ {
+		if bits[i] != bits[i-1] {
+			observed++
+		}
+	}
+
+	variance := proportion * (1 - proportion)
+	numerator := math.Abs(float64(observed) - 2*n*variance)
+	denominator := 2 * math.Sqrt(2*n) * variance
+	return math.Erfc(numerator / denominator), nil
+}
+
+// Frequency runs the frequency (monobit) test in SP 800-22 2.1 and returns the p-value.
+// The data should have at least 100 bits.
+func Frequency(data []byte) (float64, error) {
+	return frequency(unpackBits(data))
+}
+
+// BlockFrequency runs the frequency test within a block in SP 800-22 2.2 and returns the p-value.
+// The data should have at least 100 bits, and the block size in bits should be >= 20 and > 1% of the data.
+func BlockFrequency(data []byte, blockSize int) (float64, error) {
+	return blockFrequency(unpackBits(data), blockSize)
+}
+
+// Runs runs the runs test in SP 800-22 2.3 and returns the p-value.
+// The p-value is 0 if the data doesn't pass the frequency prerequisite.
+// The data should have at least 100 bits.
+func Runs(data []byte) (float64, error) {
+	return runs(unpackBits(data))
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package health
+
+import (
+	"crypto/rand"
+	"math"
+	"slices"
+	"testing"
+)
+
+// epsilon100 is the 100 bits sequence used in the examples of SP 800-22.
+const epsilon100 = "1100100100001111110110101010001000100001011010001100001000110100110001001100011001100010100010111000"
+
+func parseBits(t *testing.T, str string) []byte {
+	bits := make([]byte, 0, len(str))
+	for _, char := range str {
+		if char != '0' && char != '1' {
+			t.Fatalf("char %q isn't a bit", char)
+		}
+
+		bits = append(bits, byte(char-'0'))
+	}
+
+	return bits
+}
+
+func checkPValue(t *testing.T, got float64, expect float64) {
+	if math.Abs(got-expect) > 1e-6 {
+		t.Fatalf("got %f != expect %f", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestUnpackBits$
+func TestUnpackBits(t *testing.T) {
+	got := unpackBits([]byte{0xA5, 0x01})
+	expect := []byte{1, 0, 1, 0, 0, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1}
+
+	if !slices.Equal(got, expect) {
+		t.Fatalf("got %+v != expect %+v", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestIgamc$
+func TestIgamc(t *testing.T) {
+	// Q(1, x) = e^-x and Q(0.5, x) = erfc(sqrt(x)).
+	for _, x := range []float64{0.1, 0.5, 1, 2, 5, 10, 30} {
+		checkPValue(t, igamc(1, x), math.Exp(-x))
+		checkPValue(t, igamc(0.5, x), math.Erfc(math.Sqrt(x)))
+	}
+
+	checkPValue(t, igamc(3, 0), 1)
+}
+
+// go test -v -cover -run=^TestFrequency$
+func TestFrequency(t *testing.T) {
+	// The vectors come from SP 800-22 2.1.4 and 2.1.8.
+	pValue, err := frequency(parseBits(t, "1011010101"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkPValue(t, pValue, 0.527089)
+
+	pValue, err = frequency(parseBits(t, epsilon100))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkPValue(t, pValue, 0.109599)
+
+	if _, err = Frequency(nil); err == nil {
+		t.Fatal("frequency test without data should fail")
+	}
+
+	pValue, err = Frequency(make([]byte, 128))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pValue >= Significance {
+		t.Fatalf("p-value %f of zeros >= %f", pValue, Significance)
+	}
+}
+
+// go test -v -cover -run=^TestBlockFrequency$
+func TestBlockFrequency(t *testing.T) {
+	// The vectors come from SP 800-22 2.2.4 and 2.2.8.
+	pValue, err := blockFrequency(parseBits(t, "0110011010"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkPValue(t, pValue, 0.801252)
+
+	pValue, err = blockFrequency(parseBits(t, epsilon100), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkPValue(t, pValue, 0.706438)
+
+	if _, err = BlockFrequency(make([]byte, 1), 0); err == nil {
+		t.Fatal("block frequency test with block size 0 should fail")
+	}
+
+	if _, err = BlockFrequency(make([]byte, 1), 9); err == nil {
+		t.Fatal("block frequency test with less bits than block size should fail")
+	}
+}
+
+// go test -v -cover -run=^TestRuns$
+func TestRuns(t *testing.T) {
+	// The vectors come from SP 800-22 2.3.4 and 2.3.8.
+	pValue, err := runs(parseBits(t, "1001101011"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkPValue(t, pValue, 0.147232)
+
+	pValue, err = runs(parseBits(t, epsilon100))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkPValue(t, pValue, 0.500798)
+
+	if _, err = Runs(nil); err == nil {
+		t.Fatal("runs test without data should fail")
+	}
+
+	pValue, err = Runs(make([]byte, 128))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pValue != 0 {
+		t.Fatalf("p-value %f of zeros != 0", pValue)
+	}
+
+	// The bits alternate so there are too many runs.
+	data := make([]byte, 128)
+	for i := range data {
+		data[i] = 0x55
+	}
+
+	pValue, err = Runs(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pValue >= Significance {
+		t.Fatalf("p-value %f of alternating bits >= %f", pValue, Significance)
+	}
+}
+
+// go test -v -cover -run=^TestRandomData$
+func TestRandomData(t *testing.T) {
+	data := make([]byte, 1<<17)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+
+	pValues := make([]float64, 0, 3)
+
+	pValue, err := Frequency(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pValues = append(pValues, pValue)
+
+	pValue, err = BlockFrequency(data, 128)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pValues = append(pValues, pValue)
+
+	pValue, err = Runs(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pValues = append(pValues, pValue)
+	t.Log(pValues)
+
+	// The probability of a false positive is about 1e-6 for each test.
+	for _, pValue := range pValues {
+		if pValue < 1e-6 {
+			t.Fatalf("p-value %f of random data < 1e-6", pValue)
+		}
+	}
+}
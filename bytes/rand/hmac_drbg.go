@@ -0,0 +1,148 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"crypto/hmac"
+	"fmt"
+	"sync"
+)
+
+// HMACDRBG is a hmac drbg in nist sp 800-90a which can be used as an io.Reader.
+// It's safe to use it in concurrency.
+// The random readers of crypto functions may be ignored by GODEBUG=cryptocustomrand, see WithDRBGSeed.
+type HMACDRBG struct {
+	conf          *DRBGConfig
+	strength      int
+	key           []byte
+	value         []byte
+	reseedCounter uint64
+	lock          sync.Mutex
+}
+
+// NewHMACDRBG returns a new hmac drbg instantiated with entropy input from entropy source.
+// The default hash is sha256 and the default entropy source is crypto/rand.Reader.
+func NewHMACDRBG(opts ...DRBGOption) (*HMACDRBG, error) {
+	conf := newDRBGConfig().Apply(opts...)
+
+	size := conf.hash().Size()
+	drbg := &HMACDRBG{
+		conf:     conf,
+		strength: hmacDRBGStrength(size),
+		key:      make([]byte, size),
+		value:    make([]byte, size),
+	}
+
+	material, err := seedMaterial(conf, drbg.strength)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range drbg.value {
+		drbg.value[i] = 0x01
+	}
+
+	drbg.update(material)
+	drbg.reseedCounter = 1
+	return drbg, nil
+}
+
+// hmacDRBGStrength returns the security strength in bytes of the hash with size.
+func hmacDRBGStrength(size int) int {
+	if size >= 32 {
+		return 32
+	}
+
+	if size >= 28 {
+		return 24
+	}
+
+	return 16
+}
+
+func (hd *HMACDRBG) hmac(chunks ...[]byte) []byte {
+	h := hmac.New(hd.conf.hash, hd.key)
+	for _, chunk := range chunks {
+		h.Write(chunk)
+	}
+
+	return h.Sum(nil)
+}
+
+func (hd *HMACDRBG) update(provided []byte) {
+	hd.key = hd.hmac(hd.value, []byte{0x00}, provided)
+	hd.value = hd.hmac(hd.value)
+
+	if len(provided) <= 0 {
+		return
+	}
+
+	hd.key = hd.hmac(hd.value, []byte{0x01}, provided)
+	hd.value = hd.hmac(hd.value)
+}
+
+func (hd *HMACDRBG) reseed(additional []byte) error {
+	entropy, err := readEntropy(hd.conf.entropy, hd.strength)
+	if err != nil {
+		return err
+	}
+
+	material := append(entropy, additional...)
+	hd.update(material)
+	hd.reseedCounter = 1
+	return nil
+}
+
+// Reseed reseeds the drbg with entropy input from entropy source and additional input.
+func (hd *HMACDRBG) Reseed(additional []byte) error {
+	hd.lock.Lock()
+	defer hd.lock.Unlock()
+
+	return hd.reseed(additional)
+}
+
+func (hd *HMACDRBG) generate(data []byte, additional []byte) error {
+	if len(data) > maxRequestSize {
+		return fmt.Errorf("cryptox/rand: hmac drbg request size %d > max %d", len(data), maxRequestSize)
+	}
+
+	if hd.reseedCounter > hd.conf.reseedInterval {
+		if err := hd.reseed(additional); err != nil {
+			return fmt.Errorf("%w: %w", errReseedRequired, err)
+		}
+
+		additional = nil
+	}
+
+	if len(additional) > 0 {
+		hd.update(additional)
+	}
+
+	for n := 0; n < len(data); {
+		hd.value = hd.hmac(hd.value)
+		n += copy(data[n:], hd.value)
+	}
+
+	hd.update(additional)
+	hd.reseedCounter++
+	return nil
+}
+
+// Generate generates len(data) bytes with additional input to data.
+// The length of data should be less than 64KB.
+func (hd *HMACDRBG) Generate(data []byte, additional []byte) error {
+	hd.lock.Lock()
+	defer hd.lock.Unlock()
+
+	return hd.generate(data, additional)
+}
+
+// Read reads len(data) bytes in random to data.
+func (hd *HMACDRBG) Read(data []byte) (n int, err error) {
+	hd.lock.Lock()
+	defer hd.lock.Unlock()
+
+	return readAll(hd.generate, data)
+}
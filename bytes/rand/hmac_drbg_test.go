@@ -0,0 +1,180 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"slices"
+	"testing"
+)
+
+type drbgTestCase struct {
+	Hash             func() hash.Hash
+	KeySize          int
+	Entropy          string
+	Nonce            string
+	Personalization  string
+	EntropyReseed    string
+	AdditionalReseed string
+	Additional1      string
+	Additional2      string
+	ReturnedBits     string
+}
+
+type testDRBG interface {
+	Reseed(additional []byte) error
+	Generate(data []byte, additional []byte) error
+}
+
+type testNewDRBGFunc func(opts ...DRBGOption) (testDRBG, error)
+
+func decodeHex(t *testing.T, str string) []byte {
+	data, err := hex.DecodeString(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func testDRBGVectors(t *testing.T, newDRBG testNewDRBGFunc, testCases []drbgTestCase) {
+	for i, testCase := range testCases {
+		seed := decodeHex(t, testCase.Entropy+testCase.EntropyReseed)
+
+		drbg, err := newDRBG(
+			WithDRBGSeed(seed),
+			WithDRBGNonce(decodeHex(t, testCase.Nonce)),
+			WithDRBGPersonalization(decodeHex(t, testCase.Personalization)),
+			WithDRBGHash(testCase.Hash),
+			WithDRBGKeySize(testCase.KeySize),
+		)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if testCase.EntropyReseed != "" {
+			if err = drbg.Reseed(decodeHex(t, testCase.AdditionalReseed)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		want := decodeHex(t, testCase.ReturnedBits)
+		got := make([]byte, len(want))
+
+		if err = drbg.Generate(got, decodeHex(t, testCase.Additional1)); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = drbg.Generate(got, decodeHex(t, testCase.Additional2)); err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.Equal(got, want) {
+			t.Fatalf("case %d: got %x != want %x", i, got, want)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestHMACDRBG$
+func TestHMACDRBG(t *testing.T) {
+	// From nist cavp drbgvectors_no_reseed and drbgvectors_pr_false.
+	testCases := []drbgTestCase{
+		{
+			Hash:         sha1.New,
+			Entropy:      "e91b63309e93d1d08e30e8d556906875",
+			Nonce:        "f59747c468b0d0da",
+			ReturnedBits: "b7928f9503a417110788f9d0c2585f8aee6fb73b220a626b3ab9825b7a9facc79723d7e1ba9255e40e65c249b6082a7bc5e3f129d3d8f69b04ed1183419d6c4f2a13b304d2c5743f41c8b0ee73225347",
+		},
+		{
+			Hash:         sha256.New,
+			Entropy:      "ca851911349384bffe89de1cbdc46e6831e44d34a4fb935ee285dd14b71a7488",
+			Nonce:        "659ba96c601dc69fc902940805ec0ca8",
+			ReturnedBits: "e528e9abf2dece54d47c7e75e5fe302149f817ea9fb4bee6f4199697d04d5b89d54fbb978a15b5c443c9ec21036d2460b6f73ebad0dc2aba6e624abf07745bc107694bb7547bb0995f70de25d6b29e2d3011bb19d27676c07162c8b5ccde0668961df86803482cb37ed6d5c0bb8d50cf1f50d476aa0458bdaba806f48be9dcb8",
+		},
+		{
+			Hash:            sha256.New,
+			Entropy:         "5d3286bc53a258a53ba781e2c4dcd79a790e43bbe0e89fb3eed39086be34174b",
+			Nonce:           "c5422294b7318952ace7055ab7570abf",
+			Personalization: "2dba094d008e150d51c4135bb2f03dcde9cbf3468a12908a1b025c120c985b9d",
+			Additional1:     "793a7ef8f6f0482beac542bb785c10f8b7b406a4de92667ab168ecc2cf7573c6",
+			Additional2:     "2238cdb4e23d629fe0c2a83dd8d5144ce1a6229ef41dabe2a99ff722e510b530",
+			ReturnedBits:    "d04678198ae7e1aeb435b45291458ffde0891560748b43330eaf866b5a6385e74c6fa5a5a44bdb284d436e98d244018d6acedcdfa2e9f499d8089e4db86ae89a6ab2d19cb705e2f048f97fb597f04106a1fa6a1416ad3d859118e079a0c319eb95686f4cbcce3b5101c7a0b010ef029c4ef6d06cdfac97efb9773891688c37cf",
+		},
+		{
+			Hash:             sha256.New,
+			Entropy:          "cdb0d9117cc6dbc9ef9dcb06a97579841d72dc18b2d46a1cb61e314012bdf416",
+			Nonce:            "d0c0d01d156016d0eb6b7e9c7c3c8da8",
+			Personalization:  "6f0fb9eab3f9ea7ab0a719bfa879bf0aaed683307fda0c6d73ce018b6e34faaa",
+			EntropyReseed:    "8ec6f7d5a8e2e88f43986f70b86e050d07c84b931bcf18e601c5a3eee3064c82",
+			AdditionalReseed: "1ab4ca9014fa98a55938316de8ba5a68c629b0741bdd058c4d70c91cda5099b3",
+			Additional1:      "16e2d0721b58d839a122852abd3bf2c942a31c84d82fca74211871880d7162ff",
+			Additional2:      "53686f042a7b087d5d2eca0d2a96de131f275ed7151189f7ca52deaa78b79fb2",
+			ReturnedBits:     "dda04a2ca7b8147af1548f5d086591ca4fd951a345ce52b3cd49d47e84aa31a183e31fbc42a1ff1d95afec7143c8008c97bc2a9c091df0a763848391f68cb4a366ad89857ac725a53b303ddea767be8dc5f605b1b95f6d24c9f06be65a973a089320b3cc42569dcfd4b92b62a993785b0301b3fc452445656fce22664827b88f",
+		},
+	}
+
+	newDRBG := func(opts ...DRBGOption) (testDRBG, error) {
+		return NewHMACDRBG(opts...)
+	}
+
+	testDRBGVectors(t, newDRBG, testCases)
+}
+
+// go test -v -cover -run=^TestHMACDRBGRead$
+func TestHMACDRBGRead(t *testing.T) {
+	seed := []byte("123456788765432112345678876543211234567887654321")
+
+	drbg1, err := NewHMACDRBG(WithDRBGSeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	drbg2, err := NewHMACDRBG(WithDRBGSeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data1 := make([]byte, 3*maxRequestSize+1)
+	if _, err = drbg1.Read(data1); err != nil {
+		t.Fatal(err)
+	}
+
+	data2 := make([]byte, len(data1))
+	if _, err = drbg2.Read(data2); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(data1, data2) {
+		t.Fatal("data1 != data2")
+	}
+
+	drbg, err := NewHMACDRBG(WithDRBGReseedInterval(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 10; i++ {
+		if _, err = drbg.Read(data1); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	drbg, err = NewHMACDRBG(WithDRBGSeed(seed), WithDRBGReseedInterval(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = drbg.Read(data1[:32]); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = drbg.Read(data1[:32]); err == nil {
+		t.Fatal("reseed with an exhausted seed should fail")
+	}
+}
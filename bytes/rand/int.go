@@ -0,0 +1,88 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// uint64N returns a uniform random uint64 in [0, n) read from reader.
+// It rejects the values less than 2^64 % n so there is no modulo bias.
+func uint64N(reader io.Reader, n uint64) (uint64, error) {
+	if n == 0 {
+		return 0, errors.New("cryptox/rand: n == 0")
+	}
+
+	threshold := -n % n
+
+	var buffer [8]byte
+	for {
+		if _, err := io.ReadFull(reader, buffer[:]); err != nil {
+			return 0, err
+		}
+
+		value := binary.LittleEndian.Uint64(buffer[:])
+		if value >= threshold {
+			return value % n, nil
+		}
+	}
+}
+
+// Uint64N returns a uniform random uint64 in [0, n) without modulo bias.
+// It always reads from the reader in config and ignores weak.
+func Uint64N(n uint64, opts ...Option) (uint64, error) {
+	conf := newConfig().Apply(opts...)
+	return uint64N(conf.reader, n)
+}
+
+// IntN returns a uniform random int in [0, n) without modulo bias.
+// It always reads from the reader in config and ignores weak.
+func IntN(n int, opts ...Option) (int, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("cryptox/rand: n %d <= 0", n)
+	}
+
+	value, err := Uint64N(uint64(n), opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(value), nil
+}
+
+// Int returns a uniform random int in [min, max) without modulo bias.
+// It always reads from the reader in config and ignores weak.
+func Int(min int, max int, opts ...Option) (int, error) {
+	if min >= max {
+		return 0, fmt.Errorf("cryptox/rand: min %d >= max %d", min, max)
+	}
+
+	value, err := Uint64N(uint64(max)-uint64(min), opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	return min + int(value), nil
+}
+
+// Shuffle shuffles the slice in fisher-yates way.
+// It always reads from the reader in config and ignores weak.
+func Shuffle[T any](slice []T, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	for i := len(slice) - 1; i > 0; i-- {
+		j, err := uint64N(conf.reader, uint64(i+1))
+		if err != nil {
+			return err
+		}
+
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"testing"
+)
+
+func newTestUint64Reader(values ...uint64) *bytes.Reader {
+	data := make([]byte, 0, 8*len(values))
+	for _, value := range values {
+		data = binary.LittleEndian.AppendUint64(data, value)
+	}
+
+	return bytes.NewReader(data)
+}
+
+// go test -v -cover -run=^TestUint64N$
+func TestUint64N(t *testing.T) {
+	// 2^64 % 3 == 1 so 0 should be rejected.
+	reader := newTestUint64Reader(0, 5)
+
+	value, err := Uint64N(3, WithReader(reader))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if value != 2 {
+		t.Fatalf("value %d != 2", value)
+	}
+
+	if _, err = Uint64N(0); err == nil {
+		t.Fatal("uint64n with n 0 should fail")
+	}
+
+	if _, err = Uint64N(3, WithReader(bytes.NewReader(nil))); err == nil {
+		t.Fatal("uint64n with an empty reader should fail")
+	}
+
+	counts := make([]int, 6)
+	for i := 0; i < 6000; i++ {
+		value, err := Uint64N(uint64(len(counts)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		counts[value]++
+	}
+
+	for i, count := range counts {
+		if count < 800 || count > 1200 {
+			t.Fatalf("counts[%d] %d is out of [800, 1200]", i, count)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestIntN$
+func TestIntN(t *testing.T) {
+	for i := 1; i <= 64; i++ {
+		value, err := IntN(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if value < 0 || value >= i {
+			t.Fatalf("value %d not in [0, %d)", value, i)
+		}
+	}
+
+	if _, err := IntN(0); err == nil {
+		t.Fatal("intn with n 0 should fail")
+	}
+
+	if _, err := IntN(-1); err == nil {
+		t.Fatal("intn with n -1 should fail")
+	}
+}
+
+// go test -v -cover -run=^TestInt$
+func TestInt(t *testing.T) {
+	for i := -32; i <= 32; i++ {
+		value, err := Int(i, i+10)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if value < i || value >= i+10 {
+			t.Fatalf("value %d not in [%d, %d)", value, i, i+10)
+		}
+	}
+
+	value, err := Int(-1<<63, 1<<63-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(value)
+
+	if _, err = Int(1, 1); err == nil {
+		t.Fatal("int with min 1 max 1 should fail")
+	}
+}
+
+// go test -v -cover -run=^TestShuffle$
+func TestShuffle(t *testing.T) {
+	slice := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	shuffled := slices.Clone(slice)
+
+	if err := Shuffle(shuffled); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log(shuffled)
+
+	slices.Sort(shuffled)
+	if !slices.Equal(shuffled, slice) {
+		t.Fatalf("shuffled %+v != slice %+v", shuffled, slice)
+	}
+
+	// The reader always returns a multiple of 12 so each j is 0.
+	reader := newTestUint64Reader(12, 12, 12)
+	shuffled = []int{1, 2, 3, 4}
+
+	if err := Shuffle(shuffled, WithReader(reader)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []int{2, 3, 4, 1}
+	if !slices.Equal(shuffled, want) {
+		t.Fatalf("shuffled %+v != want %+v", shuffled, want)
+	}
+
+	if err := Shuffle(shuffled, WithReader(bytes.NewReader(nil))); err == nil {
+		t.Fatal("shuffle with an empty reader should fail")
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	ksuidEpoch        = 1400000000
+	ksuidStringLength = 27
+	base62Alphabet    = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// KSUID is a k-sortable unique identifier.
+// It has a 32 bits timestamp in seconds since 2014-05-13 16:53:20 UTC and 128 bits in random.
+// See https://github.com/segmentio/ksuid.
+type KSUID [20]byte
+
+func ksuidSeconds(now time.Time) (uint32, error) {
+	seconds := now.Unix() - ksuidEpoch
+	if seconds < 0 || seconds > math.MaxUint32 {
+		return 0, fmt.Errorf("cryptox/rand: ksuid seconds %d not in [0, %d]", seconds, uint32(math.MaxUint32))
+	}
+
+	return uint32(seconds), nil
+}
+
+// NewKSUID returns a ksuid which starts with the seconds of now and is followed by random bits.
+// It always reads from the reader in config and ignores weak.
+// Use KSUIDGenerator if you want ksuids generated in the same second to be monotonic.
+func NewKSUID(opts ...Option) (KSUID, error) {
+	conf := newConfig().Apply(opts...)
+
+	seconds, err := ksuidSeconds(conf.now())
+	if err != nil {
+		return KSUID{}, err
+	}
+
+	var ksuid KSUID
+	if _, err = io.ReadFull(conf.reader, ksuid[4:]); err != nil {
+		return KSUID{}, err
+	}
+
+	binary.BigEndian.PutUint32(ksuid[:4], seconds)
+	return ksuid, nil
+}
+
+// KSUIDGenerator generates ksuids which are monotonic in the same process.
+// The payload will be increased by 1 if the ksuid is generated in the same second as the last one.
+type KSUIDGenerator struct {
+	conf *Config
+	last KSUID
+	lock sync.Mutex
+}
+
+// NewKSUIDGenerator returns a generator of monotonic ksuids.
+func NewKSUIDGenerator(opts ...Option) *KSUIDGenerator {
+	generator := &KSUIDGenerator{
+		conf: newConfig().Apply(opts...),
+	}
+
+	return generator
+}
+
+// Next returns the next ksuid which is greater than all ksuids returned before.
+// It returns an error if the payload overflows in the same second.
+func (kg *KSUIDGenerator) Next() (KSUID, error) {
+	seconds, err := ksuidSeconds(kg.conf.now())
+	if err != nil {
+		return KSUID{}, err
+	}
+
+	kg.lock.Lock()
+	defer kg.lock.Unlock()
+
+	if kg.last != (KSUID{}) && seconds <= kg.last.Seconds() {
+		ksuid := kg.last
+		if !increase(ksuid[4:]) {
+			return KSUID{}, errors.New("cryptox/rand: ksuid payload overflows")
+		}
+
+		kg.last = ksuid
+		return ksuid, nil
+	}
+
+	var ksuid KSUID
+	if _, err = io.ReadFull(kg.conf.reader, ksuid[4:]); err != nil {
+		return KSUID{}, err
+	}
+
+	binary.BigEndian.PutUint32(ksuid[:4], seconds)
+	kg.last = ksuid
+	return ksuid, nil
+}
+
+func base62Index(char byte) int {
+	switch {
+	case char >= '0' && char <= '9':
+		return int(char - '0')
+	case char >= 'A' && char <= 'Z':
+		return int(char-'A') + 10
+	case char >= 'a' && char <= 'z':
+		return int(char-'a') + 36
+	default:
+		return -1
+	}
+}
+
+// ParseKSUID parses str in base62 to ksuid.
+// It returns an error if str overflows 160 bits.
+func ParseKSUID(str string) (KSUID, error) {
+	if len(str) != ksuidStringLength {
+		return KSUID{}, fmt.Errorf("cryptox/rand: ksuid %q has invalid length %d", str, len(str))
+	}
+
+	var ksuid KSUID
+	for i := 0; i < len(str); i++ {
+		index := base62Index(str[i])
+		if index < 0 {
+			return KSUID{}, fmt.Errorf("cryptox/rand: ksuid %q has invalid char %q", str, str[i])
+		}
+
+		// ksuid = ksuid * 62 + index
+		carry := uint32(index)
+		for j := len(ksuid) - 1; j >= 0; j-- {
+			value := uint32(ksuid[j])*62 + carry
+			ksuid[j] = byte(value)
+			carry = value >> 8
+		}
+
+		if carry != 0 {
+			return KSUID{}, fmt.Errorf("cryptox/rand: ksuid %q overflows 160 bits", str)
+		}
+	}
+
+	return ksuid, nil
+}
+
+// Seconds returns the seconds since ksuid epoch in ksuid.
+func (k KSUID) Seconds() uint32 {
+	return binary.BigEndian.Uint32(k[:4])
+}
+
+// Time returns the time in ksuid.
+func (k KSUID) Time() time.Time {
+	seconds := int64(k.Seconds()) + ksuidEpoch
+	return time.Unix(seconds, 0)
+}
+
+// Payload returns the random payload in ksuid.
+func (k KSUID) Payload() []byte {
+	return k[4:]
+}
+
+// String returns the ksuid in base62.
+func (k KSUID) String() string {
+	var buffer [ksuidStringLength]byte
+	number := k
+
+	// Divide number by 62 repeatedly and the remainders are the digits from low to high.
+	for i := len(buffer) - 1; i >= 0; i-- {
+		remainder := uint32(0)
+		for j := 0; j < len(number); j++ {
+			value := remainder<<8 | uint32(number[j])
+			number[j] = byte(value / 62)
+			remainder = value % 62
+		}
+
+		buffer[i] = base62Alphabet[remainder]
+	}
+
+	return string(buffer[:])
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k KSUID) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *KSUID) UnmarshalText(text []byte) error {
+	ksuid, err := ParseKSUID(string(text))
+	if err != nil {
+		return err
+	}
+
+	*k = ksuid
+	return nil
+}
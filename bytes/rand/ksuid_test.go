@@ -0,0 +1,168 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// go test -v -cover -run=^TestNewKSUID$
+func TestNewKSUID(t *testing.T) {
+	// The vector comes from https://github.com/segmentio/ksuid.
+	now := time.Unix(ksuidEpoch+107608047, 0)
+	random, err := hex.DecodeString("b5a1cd34b5f99d1154fb6853345c9735")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ksuid, err := NewKSUID(WithReader(bytes.NewReader(random)), WithNow(func() time.Time { return now }))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "0ujtsYcgvSTl8PAuAdqWYSMnLOv"
+	if ksuid.String() != want {
+		t.Fatalf("ksuid %s != want %s", ksuid, want)
+	}
+
+	if !ksuid.Time().Equal(now) {
+		t.Fatalf("ksuid time %s != now %s", ksuid.Time(), now)
+	}
+
+	if !bytes.Equal(ksuid.Payload(), random) {
+		t.Fatalf("ksuid payload %x != random %x", ksuid.Payload(), random)
+	}
+
+	if _, err = NewKSUID(WithNow(func() time.Time { return time.Unix(ksuidEpoch-1, 0) })); err == nil {
+		t.Fatal("new ksuid with a time before epoch should fail")
+	}
+
+	if _, err = NewKSUID(WithReader(bytes.NewReader(nil))); err == nil {
+		t.Fatal("new ksuid with an empty reader should fail")
+	}
+}
+
+// go test -v -cover -run=^TestKSUIDGenerator$
+func TestKSUIDGenerator(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	generator := NewKSUIDGenerator(WithNow(func() time.Time { return now }))
+
+	last, err := generator.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 1024; i++ {
+		if i == 512 {
+			// Move the clock backwards and the ksuids should be still monotonic.
+			now = now.Add(-time.Minute)
+		}
+
+		ksuid, err := generator.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ksuid.String() <= last.String() {
+			t.Fatalf("ksuid %s <= last %s", ksuid, last)
+		}
+
+		last = ksuid
+	}
+
+	now = now.Add(time.Hour)
+
+	ksuid, err := generator.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !ksuid.Time().Equal(now) {
+		t.Fatalf("ksuid time %s != now %s", ksuid.Time(), now)
+	}
+
+	random := bytes.Repeat([]byte{0xFF}, 16)
+	generator = NewKSUIDGenerator(WithReader(bytes.NewReader(random)), WithNow(func() time.Time { return now }))
+
+	if _, err = generator.Next(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = generator.Next(); err == nil {
+		t.Fatal("next ksuid with overflowing payload should fail")
+	}
+}
+
+// go test -v -cover -run=^TestParseKSUID$
+func TestParseKSUID(t *testing.T) {
+	strs := []string{
+		"0ujtsYcgvSTl8PAuAdqWYSMnLOv",
+		"000000000000000000000000000",
+		"aWgEPTl1tmebfsQzFP4bxwgy80V",
+	}
+
+	for _, str := range strs {
+		ksuid, err := ParseKSUID(str)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ksuid.String() != str {
+			t.Fatalf("ksuid %s != str %s", ksuid, str)
+		}
+	}
+
+	ksuid, err := ParseKSUID("aWgEPTl1tmebfsQzFP4bxwgy80V")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ksuid != (KSUID(bytes.Repeat([]byte{0xFF}, 20))) {
+		t.Fatalf("ksuid %x != max", ksuid[:])
+	}
+
+	invalids := []string{
+		"",
+		"0ujtsYcgvSTl8PAuAdqWYSMnLO",
+		"0ujtsYcgvSTl8PAuAdqWYSMnLO-",
+		"aWgEPTl1tmebfsQzFP4bxwgy80W",
+	}
+
+	for _, str := range invalids {
+		if _, err := ParseKSUID(str); err == nil {
+			t.Fatalf("parse ksuid %q should fail", str)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestKSUIDText$
+func TestKSUIDText(t *testing.T) {
+	ksuid, err := NewKSUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	marshaled, err := json.Marshal(ksuid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got KSUID
+	if err = json.Unmarshal(marshaled, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got != ksuid {
+		t.Fatalf("got %s != ksuid %s", got, ksuid)
+	}
+
+	if err = json.Unmarshal([]byte(`"xxx"`), &got); err == nil {
+		t.Fatal("unmarshal an invalid ksuid should fail")
+	}
+}
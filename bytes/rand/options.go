@@ -4,13 +4,26 @@
 
 package rand
 
+import (
+	"bytes"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"hash"
+	"io"
+	"time"
+)
+
 type Config struct {
-	weak bool
+	weak   bool
+	reader io.Reader
+	now    func() time.Time
 }
 
 func newConfig() *Config {
 	conf := &Config{
-		weak: false,
+		weak:   false,
+		reader: crand.Reader,
+		now:    time.Now,
 	}
 
 	return conf
@@ -32,3 +45,245 @@ func WithWeak() Option {
 		conf.weak = true
 	}
 }
+
+// WithReader sets reader to config.
+// The reader can be a drbg created by NewHMACDRBG or NewCTRDRBG.
+func WithReader(reader io.Reader) Option {
+	return func(conf *Config) {
+		conf.reader = reader
+	}
+}
+
+// WithNow sets now function to config.
+// It's used by identifiers with a timestamp like uuid v7, ulid and ksuid.
+func WithNow(now func() time.Time) Option {
+	return func(conf *Config) {
+		conf.now = now
+	}
+}
+
+type DRBGConfig struct {
+	entropy         io.Reader
+	nonce           []byte
+	personalization []byte
+	reseedInterval  uint64
+	hash            func() hash.Hash
+	keySize         int
+}
+
+func newDRBGConfig() *DRBGConfig {
+	conf := &DRBGConfig{
+		entropy:         crand.Reader,
+		nonce:           nil,
+		personalization: nil,
+		reseedInterval:  defaultReseedInterval,
+		hash:            sha256.New,
+		keySize:         32,
+	}
+
+	return conf
+}
+
+func (dc *DRBGConfig) Apply(opts ...DRBGOption) *DRBGConfig {
+	for _, opt := range opts {
+		opt(dc)
+	}
+
+	return dc
+}
+
+type DRBGOption func(conf *DRBGConfig)
+
+// WithDRBGEntropy sets entropy source to drbg config.
+// The drbg reads entropy input and nonce from it when instantiating and reseeding.
+func WithDRBGEntropy(entropy io.Reader) DRBGOption {
+	return func(conf *DRBGConfig) {
+		conf.entropy = entropy
+	}
+}
+
+// WithDRBGSeed sets seed as the entropy source to drbg config.
+// The seed is consumed as entropy input so the same seed always generates the same bytes.
+// Use it in tests only and make sure the seed is long enough for all instantiating and reseeding.
+//
+// The same bytes don't mean the same keys or signatures in the standard library.
+// Since go 1.26, most functions of crypto/rsa, crypto/ecdsa and crypto/ecdh ignore the random reader unless GODEBUG=cryptocustomrand=1 is set.
+// It's set by default only if the main module declares go 1.25 or lower in go.mod, and even then these functions may read an extra byte randomly.
+// Use the seed options of keys or the deterministic signing instead if you need the same outputs.
+func WithDRBGSeed(seed []byte) DRBGOption {
+	return func(conf *DRBGConfig) {
+		conf.entropy = bytes.NewReader(seed)
+	}
+}
+
+// WithDRBGNonce sets nonce to drbg config.
+// The nonce will be read from entropy source if it's not set.
+func WithDRBGNonce(nonce []byte) DRBGOption {
+	return func(conf *DRBGConfig) {
+		conf.nonce = nonce
+	}
+}
+
+// WithDRBGPersonalization sets personalization string to drbg config.
+func WithDRBGPersonalization(personalization []byte) DRBGOption {
+	return func(conf *DRBGConfig) {
+		conf.personalization = personalization
+	}
+}
+
+// WithDRBGReseedInterval sets reseed interval to drbg config.
+// The drbg reseeds itself from entropy source after generating interval times.
+func WithDRBGReseedInterval(interval uint64) DRBGOption {
+	return func(conf *DRBGConfig) {
+		conf.reseedInterval = interval
+	}
+}
+
+// WithDRBGHash sets hash to drbg config.
+// Only hmac drbg uses it.
+func WithDRBGHash(hash func() hash.Hash) DRBGOption {
+	return func(conf *DRBGConfig) {
+		conf.hash = hash
+	}
+}
+
+// WithDRBGKeySize sets aes key size to drbg config.
+// Only ctr drbg uses it and it should be 16, 24 or 32.
+func WithDRBGKeySize(keySize int) DRBGOption {
+	return func(conf *DRBGConfig) {
+		conf.keySize = keySize
+	}
+}
+
+type PasswordConfig struct {
+	reader  io.Reader
+	lower   string
+	upper   string
+	digits  string
+	symbols string
+	exclude string
+}
+
+func newPasswordConfig() *PasswordConfig {
+	conf := &PasswordConfig{
+		reader:  crand.Reader,
+		lower:   AlphabetLower,
+		upper:   AlphabetUpper,
+		digits:  AlphabetDigits,
+		symbols: "",
+		exclude: "",
+	}
+
+	return conf
+}
+
+func (pc *PasswordConfig) Apply(opts ...PasswordOption) *PasswordConfig {
+	for _, opt := range opts {
+		opt(pc)
+	}
+
+	return pc
+}
+
+type PasswordOption func(conf *PasswordConfig)
+
+// WithPasswordReader sets reader to password config.
+func WithPasswordReader(reader io.Reader) PasswordOption {
+	return func(conf *PasswordConfig) {
+		conf.reader = reader
+	}
+}
+
+// WithPasswordLower sets lower letters to password config.
+// The password will include one of them at least, and an empty string means no lower letters.
+func WithPasswordLower(lower string) PasswordOption {
+	return func(conf *PasswordConfig) {
+		conf.lower = lower
+	}
+}
+
+// WithPasswordUpper sets upper letters to password config.
+// The password will include one of them at least, and an empty string means no upper letters.
+func WithPasswordUpper(upper string) PasswordOption {
+	return func(conf *PasswordConfig) {
+		conf.upper = upper
+	}
+}
+
+// WithPasswordDigits sets digits to password config.
+// The password will include one of them at least, and an empty string means no digits.
+func WithPasswordDigits(digits string) PasswordOption {
+	return func(conf *PasswordConfig) {
+		conf.digits = digits
+	}
+}
+
+// WithPasswordSymbols sets symbols to password config.
+// The password will include one of them at least, and an empty string means no symbols.
+func WithPasswordSymbols(symbols string) PasswordOption {
+	return func(conf *PasswordConfig) {
+		conf.symbols = symbols
+	}
+}
+
+// WithPasswordExclude sets characters excluded from password to password config.
+// Generating will fail if all characters of a class are excluded.
+func WithPasswordExclude(chars string) PasswordOption {
+	return func(conf *PasswordConfig) {
+		conf.exclude += chars
+	}
+}
+
+// WithPasswordNoAmbiguous excludes ambiguous characters from password.
+func WithPasswordNoAmbiguous() PasswordOption {
+	return func(conf *PasswordConfig) {
+		conf.exclude += AlphabetAmbiguous
+	}
+}
+
+type PassphraseConfig struct {
+	reader     io.Reader
+	separator  string
+	capitalize bool
+}
+
+func newPassphraseConfig() *PassphraseConfig {
+	conf := &PassphraseConfig{
+		reader:     crand.Reader,
+		separator:  " ",
+		capitalize: false,
+	}
+
+	return conf
+}
+
+func (pc *PassphraseConfig) Apply(opts ...PassphraseOption) *PassphraseConfig {
+	for _, opt := range opts {
+		opt(pc)
+	}
+
+	return pc
+}
+
+type PassphraseOption func(conf *PassphraseConfig)
+
+// WithPassphraseReader sets reader to passphrase config.
+func WithPassphraseReader(reader io.Reader) PassphraseOption {
+	return func(conf *PassphraseConfig) {
+		conf.reader = reader
+	}
+}
+
+// WithPassphraseSeparator sets separator between words to passphrase config.
+func WithPassphraseSeparator(separator string) PassphraseOption {
+	return func(conf *PassphraseConfig) {
+		conf.separator = separator
+	}
+}
+
+// WithPassphraseCapitalize capitalizes the first letter of each word in passphrase.
+func WithPassphraseCapitalize() PassphraseOption {
+	return func(conf *PassphraseConfig) {
+		conf.capitalize = true
+	}
+}
@@ -5,13 +5,23 @@
 package rand
 
 import (
+	"bytes"
+	crand "crypto/rand"
+	"crypto/sha512"
+	"fmt"
+	"slices"
 	"testing"
+	"time"
 )
 
 // go test -v -cover -run=^TestConfig$
 func TestConfig(t *testing.T) {
+	reader := bytes.NewReader(nil)
+
 	opts := []Option{
 		WithWeak(),
+		WithReader(reader),
+		WithNow(time.Now),
 	}
 
 	conf := newConfig().Apply(opts...)
@@ -19,4 +29,131 @@ func TestConfig(t *testing.T) {
 	if !conf.weak {
 		t.Fatalf("got %v != expect %v", conf.weak, true)
 	}
+
+	got := fmt.Sprintf("%p", conf.reader)
+	expect := fmt.Sprintf("%p", reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.now)
+	expect = fmt.Sprintf("%p", time.Now)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestDRBGConfig$
+func TestDRBGConfig(t *testing.T) {
+	nonce := []byte("nonce")
+	personalization := []byte("personalization")
+
+	opts := []DRBGOption{
+		WithDRBGEntropy(crand.Reader),
+		WithDRBGNonce(nonce),
+		WithDRBGPersonalization(personalization),
+		WithDRBGReseedInterval(1024),
+		WithDRBGHash(sha512.New),
+		WithDRBGKeySize(16),
+	}
+
+	conf := newDRBGConfig().Apply(opts...)
+
+	got := fmt.Sprintf("%p", conf.entropy)
+	expect := fmt.Sprintf("%p", crand.Reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if !slices.Equal(conf.nonce, nonce) {
+		t.Fatalf("got %s != expect %s", conf.nonce, nonce)
+	}
+
+	if !slices.Equal(conf.personalization, personalization) {
+		t.Fatalf("got %s != expect %s", conf.personalization, personalization)
+	}
+
+	if conf.reseedInterval != 1024 {
+		t.Fatalf("got %d != expect %d", conf.reseedInterval, 1024)
+	}
+
+	got = fmt.Sprintf("%p", conf.hash)
+	expect = fmt.Sprintf("%p", sha512.New)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if conf.keySize != 16 {
+		t.Fatalf("got %d != expect %d", conf.keySize, 16)
+	}
+
+	seed := []byte("seed")
+	conf.Apply(WithDRBGSeed(seed))
+
+	data := make([]byte, len(seed))
+	if _, err := conf.entropy.Read(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(data, seed) {
+		t.Fatalf("got %s != expect %s", data, seed)
+	}
+}
+
+// go test -v -cover -run=^TestPasswordConfig$
+func TestPasswordConfig(t *testing.T) {
+	opts := []PasswordOption{
+		WithPasswordReader(crand.Reader),
+		WithPasswordLower("abc"),
+		WithPasswordUpper("ABC"),
+		WithPasswordDigits("123"),
+		WithPasswordSymbols("!@#"),
+		WithPasswordExclude("a"),
+		WithPasswordNoAmbiguous(),
+	}
+
+	conf := newPasswordConfig().Apply(opts...)
+
+	got := fmt.Sprintf("%p", conf.reader)
+	expect := fmt.Sprintf("%p", crand.Reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = conf.lower + conf.upper + conf.digits + conf.symbols
+	expect = "abcABC123!@#"
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = conf.exclude
+	expect = "a" + AlphabetAmbiguous
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestPassphraseConfig$
+func TestPassphraseConfig(t *testing.T) {
+	opts := []PassphraseOption{
+		WithPassphraseReader(crand.Reader),
+		WithPassphraseSeparator("-"),
+		WithPassphraseCapitalize(),
+	}
+
+	conf := newPassphraseConfig().Apply(opts...)
+
+	got := fmt.Sprintf("%p", conf.reader)
+	expect := fmt.Sprintf("%p", crand.Reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if conf.separator != "-" {
+		t.Fatalf("got %s != expect %s", conf.separator, "-")
+	}
+
+	if !conf.capitalize {
+		t.Fatalf("got %+v != expect %+v", conf.capitalize, true)
+	}
 }
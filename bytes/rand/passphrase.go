@@ -0,0 +1,68 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// effLargeWordlist is the eff large wordlist for diceware passphrases.
+// See https://www.eff.org/dice, which is licensed under CC BY 3.0 US.
+//
+//go:embed eff_large_wordlist.txt
+var effLargeWordlist string
+
+// effWords returns the words in eff large wordlist ordered by their dice rolls.
+var effWords = sync.OnceValue(func() []string {
+	lines := strings.Split(strings.TrimSpace(effLargeWordlist), "\n")
+	words := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		_, word, _ := strings.Cut(line, "\t")
+		words = append(words, strings.TrimSpace(word))
+	}
+
+	return words
+})
+
+func capitalize(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	return string(unicode.ToUpper(r)) + word[size:]
+}
+
+// Passphrase returns a diceware passphrase including n words chosen from eff large wordlist.
+// It also returns the entropy of the passphrase in bits, which is n * log2(7776) ≈ n * 12.9.
+func Passphrase(n int, opts ...PassphraseOption) (string, float64, error) {
+	conf := newPassphraseConfig().Apply(opts...)
+
+	if n <= 0 {
+		return "", 0, fmt.Errorf("cryptox/rand: passphrase words %d <= 0", n)
+	}
+
+	words := effWords()
+	chosen := make([]string, n)
+
+	for i := range chosen {
+		index, err := uint64N(conf.reader, uint64(len(words)))
+		if err != nil {
+			return "", 0, err
+		}
+
+		chosen[i] = words[index]
+		if conf.capitalize {
+			chosen[i] = capitalize(chosen[i])
+		}
+	}
+
+	passphrase := strings.Join(chosen, conf.separator)
+	entropy := float64(n) * math.Log2(float64(len(words)))
+	return passphrase, entropy, nil
+}
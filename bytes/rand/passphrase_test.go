@@ -0,0 +1,65 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+// go test -v -cover -run=^TestEffWords$
+func TestEffWords(t *testing.T) {
+	words := effWords()
+	if len(words) != 7776 {
+		t.Fatalf("len(words) %d != 7776", len(words))
+	}
+
+	if words[0] != "abacus" || words[len(words)-1] != "zoom" {
+		t.Fatalf("words[0] %q or words[%d] %q is wrong", words[0], len(words)-1, words[len(words)-1])
+	}
+}
+
+// go test -v -cover -run=^TestPassphrase$
+func TestPassphrase(t *testing.T) {
+	for i := 1; i <= 16; i++ {
+		passphrase, entropy, err := Passphrase(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Log(passphrase, entropy)
+
+		words := strings.Split(passphrase, " ")
+		if len(words) != i {
+			t.Fatalf("len(words) %d != %d", len(words), i)
+		}
+
+		want := float64(i) * math.Log2(7776)
+		if math.Abs(entropy-want) > 1e-9 {
+			t.Fatalf("entropy %f != want %f", entropy, want)
+		}
+	}
+
+	reader := newTestUint64Reader(7776*1000, 7776*1000+7775)
+
+	passphrase, _, err := Passphrase(2, WithPassphraseReader(reader), WithPassphraseSeparator("-"), WithPassphraseCapitalize())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if passphrase != "Abacus-Zoom" {
+		t.Fatalf("passphrase %q != %q", passphrase, "Abacus-Zoom")
+	}
+
+	if _, _, err = Passphrase(0); err == nil {
+		t.Fatal("passphrase with 0 words should fail")
+	}
+
+	if _, _, err = Passphrase(1, WithPassphraseReader(bytes.NewReader(nil))); err == nil {
+		t.Fatal("passphrase with an empty reader should fail")
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// passwordClasses returns the character classes of password with excluded characters removed.
+// A required class can't be removed by excluding, or the password will lose the requirement silently.
+func passwordClasses(conf *PasswordConfig) ([]string, error) {
+	candidates := []string{conf.lower, conf.upper, conf.digits, conf.symbols}
+	classes := make([]string, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+
+		class := removeChars(candidate, conf.exclude)
+		if class == "" {
+			return nil, fmt.Errorf("cryptox/rand: password excludes all characters of class %q", candidate)
+		}
+
+		classes = append(classes, class)
+	}
+
+	return classes, nil
+}
+
+// passwordAlphabet returns the union of classes without duplicate characters.
+func passwordAlphabet(classes []string) string {
+	var builder strings.Builder
+	for _, class := range classes {
+		for _, r := range class {
+			if !strings.ContainsRune(builder.String(), r) {
+				builder.WriteRune(r)
+			}
+		}
+	}
+
+	return builder.String()
+}
+
+// Password returns a password including n characters.
+// The password includes lower letters, upper letters and digits at least one of each by default.
+// Check PasswordOption for more information about the policy.
+func Password(n int, opts ...PasswordOption) (string, error) {
+	conf := newPasswordConfig().Apply(opts...)
+
+	classes, err := passwordClasses(conf)
+	if err != nil {
+		return "", err
+	}
+
+	if len(classes) <= 0 {
+		return "", errors.New("cryptox/rand: password has no characters to choose")
+	}
+
+	if n < len(classes) {
+		return "", fmt.Errorf("cryptox/rand: password length %d < classes %d", n, len(classes))
+	}
+
+	readerOpt := WithReader(conf.reader)
+	alphabet := passwordAlphabet(classes)
+	password := make([]rune, 0, n)
+
+	for _, class := range classes {
+		str, err := StringFrom(class, 1, readerOpt)
+		if err != nil {
+			return "", err
+		}
+
+		password = append(password, []rune(str)...)
+	}
+
+	str, err := StringFrom(alphabet, n-len(classes), readerOpt)
+	if err != nil {
+		return "", err
+	}
+
+	password = append(password, []rune(str)...)
+
+	if err = Shuffle(password, readerOpt); err != nil {
+		return "", err
+	}
+
+	return string(password), nil
+}
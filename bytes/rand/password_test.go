@@ -0,0 +1,84 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// go test -v -cover -run=^TestPassword$
+func TestPassword(t *testing.T) {
+	for i := 3; i <= 64; i++ {
+		password, err := Password(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(password) != i {
+			t.Fatalf("len(password) %d != %d", len(password), i)
+		}
+
+		for _, class := range []string{AlphabetLower, AlphabetUpper, AlphabetDigits} {
+			if !strings.ContainsAny(password, class) {
+				t.Fatalf("password %q doesn't contain any of %q", password, class)
+			}
+		}
+	}
+
+	opts := []PasswordOption{
+		WithPasswordSymbols(AlphabetSymbols),
+		WithPasswordNoAmbiguous(),
+		WithPasswordExclude("abc"),
+	}
+
+	for i := 0; i < 64; i++ {
+		password, err := Password(16, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Log(password)
+
+		for _, class := range []string{AlphabetLower, AlphabetUpper, AlphabetDigits, AlphabetSymbols} {
+			if !strings.ContainsAny(password, class) {
+				t.Fatalf("password %q doesn't contain any of %q", password, class)
+			}
+		}
+
+		if strings.ContainsAny(password, AlphabetAmbiguous+"abc") {
+			t.Fatalf("password %q contains excluded characters", password)
+		}
+	}
+
+	password, err := Password(8, WithPasswordLower(""), WithPasswordUpper(""), WithPasswordDigits("你好"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if utf8.RuneCountInString(password) != 8 || strings.Trim(password, "你好") != "" {
+		t.Fatalf("password %q is wrong", password)
+	}
+
+	if _, err = Password(2); err == nil {
+		t.Fatal("password with length 2 should fail")
+	}
+
+	_, err = Password(8, WithPasswordLower(""), WithPasswordUpper(""), WithPasswordDigits(""))
+	if err == nil {
+		t.Fatal("password without any classes should fail")
+	}
+
+	_, err = Password(8, WithPasswordDigits("01"), WithPasswordExclude("10"))
+	if err == nil {
+		t.Fatal("password excluding all characters of a class should fail")
+	}
+
+	_, err = Password(8, WithPasswordSymbols("0O"), WithPasswordNoAmbiguous())
+	if err == nil {
+		t.Fatal("password excluding all characters of a class should fail")
+	}
+}
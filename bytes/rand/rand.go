@@ -5,7 +5,6 @@
 package rand
 
 import (
-	crand "crypto/rand"
 	"io"
 	mrand "math/rand/v2"
 	"unsafe"
@@ -22,15 +21,35 @@ func readFull(data []byte, n int) []byte {
 	return data
 }
 
-// Bytes returns n bytes in random which can be used to generate an iv.
-func Bytes(n int, opts ...Option) []byte {
+// Read reads len(data) bytes in random to data and returns an error if failed.
+func Read(data []byte, opts ...Option) (int, error) {
 	conf := newConfig().Apply(opts...)
 
-	data := make([]byte, n)
 	if conf.weak {
-		readFull(data, n)
-	} else {
-		io.ReadFull(crand.Reader, data)
+		readFull(data, len(data))
+		return len(data), nil
+	}
+
+	return io.ReadFull(conf.reader, data)
+}
+
+// BytesE returns n bytes in random and returns an error if failed.
+func BytesE(n int, opts ...Option) ([]byte, error) {
+	data := make([]byte, n)
+
+	if _, err := Read(data, opts...); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Bytes returns n bytes in random which can be used to generate an iv.
+// It panics if failed to read random bytes, so use BytesE if you want to handle the error.
+func Bytes(n int, opts ...Option) []byte {
+	data, err := BytesE(n, opts...)
+	if err != nil {
+		panic(err)
 	}
 
 	return data
@@ -6,9 +6,85 @@ package rand
 
 import (
 	"bytes"
+	"errors"
+	"slices"
 	"testing"
 )
 
+type testErrorReader struct{}
+
+func (testErrorReader) Read(p []byte) (n int, err error) {
+	return 0, errors.New("test error reader")
+}
+
+// go test -v -cover -run=^TestRead$
+func TestRead(t *testing.T) {
+	data := make([]byte, 64)
+
+	n, err := Read(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != len(data) {
+		t.Fatalf("n %d != len(data) %d", n, len(data))
+	}
+
+	_, err = Read(data, WithReader(testErrorReader{}))
+	if err == nil {
+		t.Fatal("read with an error reader should fail")
+	}
+
+	seed := []byte("123456788765432112345678876543211234567887654321")
+
+	drbg1, err := NewHMACDRBG(WithDRBGSeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	drbg2, err := NewHMACDRBG(WithDRBGSeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data1 := make([]byte, 64)
+	if _, err = Read(data1, WithReader(drbg1)); err != nil {
+		t.Fatal(err)
+	}
+
+	data2 := make([]byte, 64)
+	if _, err = Read(data2, WithReader(drbg2)); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(data1, data2) {
+		t.Fatalf("data1 %+v != data2 %+v", data1, data2)
+	}
+}
+
+// go test -v -cover -run=^TestBytesE$
+func TestBytesE(t *testing.T) {
+	for i := 1; i <= 64; i++ {
+		data, err := BytesE(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(data) != i {
+			t.Fatalf("len(data) %d != i %d", len(data), i)
+		}
+	}
+
+	data, err := BytesE(16, WithReader(testErrorReader{}))
+	if err == nil {
+		t.Fatal("bytes with an error reader should fail")
+	}
+
+	if data != nil {
+		t.Fatalf("data %+v != nil", data)
+	}
+}
+
 // go test -v -cover -run=^TestBytes$
 func TestBytes(t *testing.T) {
 	for i := 1; i <= 64; i++ {
@@ -32,6 +108,14 @@ func TestBytes(t *testing.T) {
 
 		t.Logf("%s\n", data)
 	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("bytes with an error reader should panic")
+		}
+	}()
+
+	Bytes(16, WithReader(testErrorReader{}))
 }
 
 // go test -v -cover -run=^TestString$
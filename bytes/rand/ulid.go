@@ -0,0 +1,189 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+var crockfordIndexes = func() [256]byte {
+	var indexes [256]byte
+	for i := range indexes {
+		indexes[i] = 0xFF
+	}
+
+	for i := 0; i < len(crockfordAlphabet); i++ {
+		char := crockfordAlphabet[i]
+		indexes[char] = byte(i)
+
+		if char >= 'A' && char <= 'Z' {
+			indexes[char+'a'-'A'] = byte(i)
+		}
+	}
+
+	return indexes
+}()
+
+// ULID is a universally unique lexicographically sortable identifier.
+// It has a 48 bits timestamp in unix millis and 80 bits in random.
+// See https://github.com/ulid/spec.
+type ULID [16]byte
+
+// increase adds 1 to data in big endian and returns false if data overflows.
+func increase(data []byte) bool {
+	for i := len(data) - 1; i >= 0; i-- {
+		data[i]++
+
+		if data[i] != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewULID returns an ulid which starts with the unix millis of now and is followed by random bits.
+// It always reads from the reader in config and ignores weak.
+// Use ULIDGenerator if you want ulids generated in the same millisecond to be monotonic.
+func NewULID(opts ...Option) (ULID, error) {
+	conf := newConfig().Apply(opts...)
+
+	millis, err := unixMillis(conf.now())
+	if err != nil {
+		return ULID{}, err
+	}
+
+	var ulid ULID
+	if _, err = io.ReadFull(conf.reader, ulid[6:]); err != nil {
+		return ULID{}, err
+	}
+
+	putMillis(ulid[:], millis)
+	return ulid, nil
+}
+
+// ULIDGenerator generates ulids which are monotonic in the same process.
+// The random bits will be increased by 1 if the ulid is generated in the same millisecond as the last one.
+type ULIDGenerator struct {
+	conf *Config
+	last ULID
+	lock sync.Mutex
+}
+
+// NewULIDGenerator returns a generator of monotonic ulids.
+func NewULIDGenerator(opts ...Option) *ULIDGenerator {
+	generator := &ULIDGenerator{
+		conf: newConfig().Apply(opts...),
+	}
+
+	return generator
+}
+
+// Next returns the next ulid which is greater than all ulids returned before.
+// It returns an error if the random bits overflow in the same millisecond.
+func (ug *ULIDGenerator) Next() (ULID, error) {
+	millis, err := unixMillis(ug.conf.now())
+	if err != nil {
+		return ULID{}, err
+	}
+
+	ug.lock.Lock()
+	defer ug.lock.Unlock()
+
+	if ug.last != (ULID{}) && millis <= ug.last.Millis() {
+		ulid := ug.last
+		if !increase(ulid[6:]) {
+			return ULID{}, errors.New("cryptox/rand: ulid random bits overflow")
+		}
+
+		ug.last = ulid
+		return ulid, nil
+	}
+
+	var ulid ULID
+	if _, err = io.ReadFull(ug.conf.reader, ulid[6:]); err != nil {
+		return ULID{}, err
+	}
+
+	putMillis(ulid[:], millis)
+	ug.last = ulid
+	return ulid, nil
+}
+
+// ParseULID parses str in crockford's base32 to ulid.
+// It's case insensitive and returns an error if str overflows 128 bits.
+func ParseULID(str string) (ULID, error) {
+	if len(str) != 26 {
+		return ULID{}, fmt.Errorf("cryptox/rand: ulid %q has invalid length %d", str, len(str))
+	}
+
+	var hi, lo uint64
+	for i := 0; i < len(str); i++ {
+		index := crockfordIndexes[str[i]]
+		if index == 0xFF {
+			return ULID{}, fmt.Errorf("cryptox/rand: ulid %q has invalid char %q", str, str[i])
+		}
+
+		if i == 0 && index > 7 {
+			return ULID{}, fmt.Errorf("cryptox/rand: ulid %q overflows 128 bits", str)
+		}
+
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(index)
+	}
+
+	var ulid ULID
+	binary.BigEndian.PutUint64(ulid[:8], hi)
+	binary.BigEndian.PutUint64(ulid[8:], lo)
+	return ulid, nil
+}
+
+// Millis returns the unix millis in ulid.
+func (u ULID) Millis() uint64 {
+	return readMillis(u[:])
+}
+
+// Time returns the time in ulid.
+func (u ULID) Time() time.Time {
+	return time.UnixMilli(int64(u.Millis()))
+}
+
+// String returns the ulid in crockford's base32.
+func (u ULID) String() string {
+	hi := binary.BigEndian.Uint64(u[:8])
+	lo := binary.BigEndian.Uint64(u[8:])
+
+	var buffer [26]byte
+	for i := len(buffer) - 1; i >= 0; i-- {
+		buffer[i] = crockfordAlphabet[lo&0x1F]
+		lo = lo>>5 | hi<<59
+		hi = hi >> 5
+	}
+
+	return string(buffer[:])
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u ULID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *ULID) UnmarshalText(text []byte) error {
+	ulid, err := ParseULID(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = ulid
+	return nil
+}
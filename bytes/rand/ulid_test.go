@@ -0,0 +1,169 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// go test -v -cover -run=^TestNewULID$
+func TestNewULID(t *testing.T) {
+	now := time.UnixMilli(1469918176385)
+	random := bytes.Repeat([]byte{0xFF}, 10)
+
+	ulid, err := NewULID(WithReader(bytes.NewReader(random)), WithNow(func() time.Time { return now }))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "01ARYZ6S41ZZZZZZZZZZZZZZZZ"
+	if ulid.String() != want {
+		t.Fatalf("ulid %s != want %s", ulid, want)
+	}
+
+	if !ulid.Time().Equal(now) {
+		t.Fatalf("ulid time %s != now %s", ulid.Time(), now)
+	}
+
+	if _, err = NewULID(WithNow(func() time.Time { return time.UnixMilli(1 << 48) })); err == nil {
+		t.Fatal("new ulid with a time overflowing 48 bits should fail")
+	}
+
+	if _, err = NewULID(WithReader(bytes.NewReader(nil))); err == nil {
+		t.Fatal("new ulid with an empty reader should fail")
+	}
+}
+
+// go test -v -cover -run=^TestULIDGenerator$
+func TestULIDGenerator(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	generator := NewULIDGenerator(WithNow(func() time.Time { return now }))
+
+	last, err := generator.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 1024; i++ {
+		if i == 512 {
+			// Move the clock backwards and the ulids should be still monotonic.
+			now = now.Add(-time.Second)
+		}
+
+		ulid, err := generator.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ulid.String() <= last.String() {
+			t.Fatalf("ulid %s <= last %s", ulid, last)
+		}
+
+		last = ulid
+	}
+
+	now = now.Add(time.Hour)
+
+	ulid, err := generator.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !ulid.Time().Equal(now) {
+		t.Fatalf("ulid time %s != now %s", ulid.Time(), now)
+	}
+
+	random := bytes.Repeat([]byte{0xFF}, 10)
+	generator = NewULIDGenerator(WithReader(bytes.NewReader(random)), WithNow(func() time.Time { return now }))
+
+	if _, err = generator.Next(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = generator.Next(); err == nil {
+		t.Fatal("next ulid with overflowing random bits should fail")
+	}
+}
+
+// go test -v -cover -run=^TestParseULID$
+func TestParseULID(t *testing.T) {
+	strs := []string{
+		"01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		"00000000000000000000000000",
+		"7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+	}
+
+	for _, str := range strs {
+		ulid, err := ParseULID(str)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ulid.String() != str {
+			t.Fatalf("ulid %s != str %s", ulid, str)
+		}
+
+		lower, err := ParseULID(strings.ToLower(str))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if lower != ulid {
+			t.Fatalf("lower %s != ulid %s", lower, ulid)
+		}
+	}
+
+	ulid, err := ParseULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ulid.Millis() != 1469922850259 {
+		t.Fatalf("ulid millis %d != 1469922850259", ulid.Millis())
+	}
+
+	invalids := []string{
+		"",
+		"01ARZ3NDEKTSV4RRFFQ69G5FA",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAU",
+		"8ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+	}
+
+	for _, str := range invalids {
+		if _, err := ParseULID(str); err == nil {
+			t.Fatalf("parse ulid %q should fail", str)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestULIDText$
+func TestULIDText(t *testing.T) {
+	ulid, err := NewULID()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	marshaled, err := json.Marshal(ulid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got ULID
+	if err = json.Unmarshal(marshaled, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got != ulid {
+		t.Fatalf("got %s != ulid %s", got, ulid)
+	}
+
+	if err = json.Unmarshal([]byte(`"xxx"`), &got); err == nil {
+		t.Fatal("unmarshal an invalid ulid should fail")
+	}
+}
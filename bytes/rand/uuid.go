@@ -0,0 +1,228 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	maxMillis         = 1<<48 - 1
+	uuidv7MaxCounter  = 0xFFF
+	uuidv7CounterMask = 0x7FF
+)
+
+// UUID is a universally unique identifier defined in RFC 9562.
+type UUID [16]byte
+
+func unixMillis(now time.Time) (uint64, error) {
+	millis := now.UnixMilli()
+	if millis < 0 || millis > maxMillis {
+		return 0, fmt.Errorf("cryptox/rand: unix millis %d not in [0, %d]", millis, uint64(maxMillis))
+	}
+
+	return uint64(millis), nil
+}
+
+func putMillis(data []byte, millis uint64) {
+	var buffer [8]byte
+	binary.BigEndian.PutUint64(buffer[:], millis)
+	copy(data[:6], buffer[2:])
+}
+
+func readMillis(data []byte) uint64 {
+	var buffer [8]byte
+	copy(buffer[2:], data[:6])
+	return binary.BigEndian.Uint64(buffer[:])
+}
+
+func setUUIDVersion(uuid *UUID, version byte) {
+	uuid[6] = (uuid[6] & 0x0F) | (version << 4)
+	uuid[8] = (uuid[8] & 0x3F) | 0x80
+}
+
+// NewUUIDv4 returns a uuid v4 which is filled with random bits.
+// It always reads from the reader in config and ignores weak.
+func NewUUIDv4(opts ...Option) (UUID, error) {
+	conf := newConfig().Apply(opts...)
+
+	var uuid UUID
+	if _, err := io.ReadFull(conf.reader, uuid[:]); err != nil {
+		return UUID{}, err
+	}
+
+	setUUIDVersion(&uuid, 4)
+	return uuid, nil
+}
+
+// NewUUIDv7 returns a uuid v7 which starts with the unix millis of now and is followed by random bits.
+// It always reads from the reader in config and ignores weak.
+// Use UUIDv7Generator if you want uuids generated in the same millisecond to be monotonic.
+func NewUUIDv7(opts ...Option) (UUID, error) {
+	conf := newConfig().Apply(opts...)
+
+	millis, err := unixMillis(conf.now())
+	if err != nil {
+		return UUID{}, err
+	}
+
+	var uuid UUID
+	if _, err = io.ReadFull(conf.reader, uuid[6:]); err != nil {
+		return UUID{}, err
+	}
+
+	putMillis(uuid[:], millis)
+	setUUIDVersion(&uuid, 7)
+	return uuid, nil
+}
+
+// UUIDv7Generator generates uuid v7 which are monotonic in the same process.
+// It uses the 12 bits of rand_a as a counter seeded in random every millisecond (RFC 9562 method 1).
+// The counter's top bit is always 0 when seeding, so there are at least 2048 uuids in one millisecond.
+// The timestamp will be increased by 1 if the counter overflows or the clock moves backwards.
+type UUIDv7Generator struct {
+	conf       *Config
+	lastMillis uint64
+	counter    uint16
+	lock       sync.Mutex
+}
+
+// NewUUIDv7Generator returns a generator of monotonic uuid v7.
+func NewUUIDv7Generator(opts ...Option) *UUIDv7Generator {
+	generator := &UUIDv7Generator{
+		conf: newConfig().Apply(opts...),
+	}
+
+	return generator
+}
+
+// Next returns the next uuid v7 which is greater than all uuids returned before.
+func (uvg *UUIDv7Generator) Next() (UUID, error) {
+	millis, err := unixMillis(uvg.conf.now())
+	if err != nil {
+		return UUID{}, err
+	}
+
+	var uuid UUID
+	if _, err = io.ReadFull(uvg.conf.reader, uuid[6:]); err != nil {
+		return UUID{}, err
+	}
+
+	uvg.lock.Lock()
+	defer uvg.lock.Unlock()
+
+	if millis > uvg.lastMillis {
+		uvg.lastMillis = millis
+		uvg.counter = binary.BigEndian.Uint16(uuid[6:8]) & uuidv7CounterMask
+	} else if uvg.counter < uuidv7MaxCounter {
+		uvg.counter++
+	} else {
+		if uvg.lastMillis >= maxMillis {
+			return UUID{}, fmt.Errorf("cryptox/rand: unix millis %d > %d", uvg.lastMillis+1, uint64(maxMillis))
+		}
+
+		uvg.lastMillis++
+		uvg.counter = binary.BigEndian.Uint16(uuid[6:8]) & uuidv7CounterMask
+	}
+
+	putMillis(uuid[:], uvg.lastMillis)
+	binary.BigEndian.PutUint16(uuid[6:8], uvg.counter)
+	setUUIDVersion(&uuid, 7)
+	return uuid, nil
+}
+
+// ParseUUID parses str to uuid.
+// It accepts "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", "{xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}",
+// "urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx" and "xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx".
+func ParseUUID(str string) (UUID, error) {
+	var uuid UUID
+
+	switch len(str) {
+	case 32:
+		if _, err := hex.Decode(uuid[:], []byte(str)); err != nil {
+			return UUID{}, fmt.Errorf("cryptox/rand: parse uuid %q failed: %w", str, err)
+		}
+
+		return uuid, nil
+	case 36:
+	case 38:
+		if str[0] != '{' || str[37] != '}' {
+			return UUID{}, fmt.Errorf("cryptox/rand: uuid %q has invalid braces", str)
+		}
+
+		str = str[1:37]
+	case 45:
+		if !strings.EqualFold(str[:9], "urn:uuid:") {
+			return UUID{}, fmt.Errorf("cryptox/rand: uuid %q has invalid urn prefix", str)
+		}
+
+		str = str[9:]
+	default:
+		return UUID{}, fmt.Errorf("cryptox/rand: uuid %q has invalid length %d", str, len(str))
+	}
+
+	if str[8] != '-' || str[13] != '-' || str[18] != '-' || str[23] != '-' {
+		return UUID{}, fmt.Errorf("cryptox/rand: uuid %q has invalid hyphens", str)
+	}
+
+	src := str[0:8] + str[9:13] + str[14:18] + str[19:23] + str[24:]
+	if _, err := hex.Decode(uuid[:], []byte(src)); err != nil {
+		return UUID{}, fmt.Errorf("cryptox/rand: parse uuid %q failed: %w", str, err)
+	}
+
+	return uuid, nil
+}
+
+// Version returns the version of uuid.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// Time returns the time in uuid v7 and returns a zero time if uuid isn't v7.
+func (u UUID) Time() time.Time {
+	if u.Version() != 7 {
+		return time.Time{}
+	}
+
+	millis := readMillis(u[:])
+	return time.UnixMilli(int64(millis))
+}
+
+// String returns the uuid in "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx" format.
+func (u UUID) String() string {
+	var buffer [36]byte
+	hex.Encode(buffer[0:8], u[0:4])
+	buffer[8] = '-'
+	hex.Encode(buffer[9:13], u[4:6])
+	buffer[13] = '-'
+	hex.Encode(buffer[14:18], u[6:8])
+	buffer[18] = '-'
+	hex.Encode(buffer[19:23], u[8:10])
+	buffer[23] = '-'
+	hex.Encode(buffer[24:], u[10:])
+	return string(buffer[:])
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *UUID) UnmarshalText(text []byte) error {
+	uuid, err := ParseUUID(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = uuid
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rand
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// go test -v -cover -run=^TestNewUUIDv4$
+func TestNewUUIDv4(t *testing.T) {
+	// The vector comes from RFC 9562 appendix A.3.
+	random := []byte{0x91, 0x91, 0x08, 0xf7, 0x52, 0xd1, 0x03, 0x20, 0x1b, 0xac, 0xf8, 0x47, 0xdb, 0x41, 0x48, 0xa8}
+
+	uuid, err := NewUUIDv4(WithReader(bytes.NewReader(random)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "919108f7-52d1-4320-9bac-f847db4148a8"
+	if uuid.String() != want {
+		t.Fatalf("uuid %s != want %s", uuid, want)
+	}
+
+	if uuid.Version() != 4 {
+		t.Fatalf("uuid version %d != 4", uuid.Version())
+	}
+
+	if !uuid.Time().IsZero() {
+		t.Fatalf("uuid time %s isn't zero", uuid.Time())
+	}
+
+	seen := make(map[UUID]struct{})
+	for i := 0; i < 1024; i++ {
+		uuid, err = NewUUIDv4()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, ok := seen[uuid]; ok {
+			t.Fatalf("uuid %s is duplicated", uuid)
+		}
+
+		seen[uuid] = struct{}{}
+	}
+
+	if _, err = NewUUIDv4(WithReader(bytes.NewReader(nil))); err == nil {
+		t.Fatal("new uuid v4 with an empty reader should fail")
+	}
+}
+
+// go test -v -cover -run=^TestNewUUIDv7$
+func TestNewUUIDv7(t *testing.T) {
+	// The vector comes from RFC 9562 appendix A.6.
+	now := time.UnixMilli(0x017F22E279B0)
+	random := []byte{0x0c, 0xc3, 0x18, 0xc4, 0xdc, 0x0c, 0x0c, 0x07, 0x39, 0x8f}
+
+	uuid, err := NewUUIDv7(WithReader(bytes.NewReader(random)), WithNow(func() time.Time { return now }))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+	if uuid.String() != want {
+		t.Fatalf("uuid %s != want %s", uuid, want)
+	}
+
+	if uuid.Version() != 7 {
+		t.Fatalf("uuid version %d != 7", uuid.Version())
+	}
+
+	if !uuid.Time().Equal(now) {
+		t.Fatalf("uuid time %s != now %s", uuid.Time(), now)
+	}
+
+	if _, err = NewUUIDv7(WithNow(func() time.Time { return time.UnixMilli(-1) })); err == nil {
+		t.Fatal("new uuid v7 with a negative time should fail")
+	}
+
+	if _, err = NewUUIDv7(WithReader(bytes.NewReader(nil))); err == nil {
+		t.Fatal("new uuid v7 with an empty reader should fail")
+	}
+}
+
+// go test -v -cover -run=^TestUUIDv7Generator$
+func TestUUIDv7Generator(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	generator := NewUUIDv7Generator(WithNow(func() time.Time { return now }))
+
+	last, err := generator.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The counter starts with its top bit 0 so it can't overflow before 2048 uuids.
+	for i := 0; i < 2048; i++ {
+		uuid, err := generator.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if bytes.Compare(uuid[:], last[:]) <= 0 {
+			t.Fatalf("uuid %s <= last %s", uuid, last)
+		}
+
+		if uuid.Version() != 7 || uuid[8]&0xC0 != 0x80 {
+			t.Fatalf("uuid %s has wrong version or variant", uuid)
+		}
+
+		last = uuid
+	}
+
+	if !last.Time().Equal(now) {
+		t.Fatalf("last time %s != now %s", last.Time(), now)
+	}
+
+	// Move the clock backwards and the uuids should be still monotonic.
+	now = now.Add(-time.Second)
+
+	for i := 0; i < 4096; i++ {
+		uuid, err := generator.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if bytes.Compare(uuid[:], last[:]) <= 0 {
+			t.Fatalf("uuid %s <= last %s", uuid, last)
+		}
+
+		last = uuid
+	}
+
+	if !last.Time().After(now.Add(time.Second)) {
+		t.Fatalf("last time %s should be increased after counter overflows", last.Time())
+	}
+}
+
+// go test -v -cover -run=^TestParseUUID$
+func TestParseUUID(t *testing.T) {
+	want := "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+	strs := []string{
+		want,
+		"017F22E2-79B0-7CC3-98C4-DC0C0C07398F",
+		"{017f22e2-79b0-7cc3-98c4-dc0c0c07398f}",
+		"urn:uuid:017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+		"017f22e279b07cc398c4dc0c0c07398f",
+	}
+
+	for _, str := range strs {
+		uuid, err := ParseUUID(str)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if uuid.String() != want {
+			t.Fatalf("uuid %s != want %s", uuid, want)
+		}
+	}
+
+	invalids := []string{
+		"",
+		"017f22e2-79b0-7cc3-98c4-dc0c0c07398",
+		"017f22e2+79b0-7cc3-98c4-dc0c0c07398f",
+		"017f22e2-79b0-7cc3-98c4-dc0c0c07398g",
+		"[017f22e2-79b0-7cc3-98c4-dc0c0c07398f]",
+		"urn:uid:017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+		"017f22e279b07cc398c4dc0c0c07398x",
+	}
+
+	for _, str := range invalids {
+		if _, err := ParseUUID(str); err == nil {
+			t.Fatalf("parse uuid %q should fail", str)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestUUIDText$
+func TestUUIDText(t *testing.T) {
+	uuid, err := NewUUIDv7()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	marshaled, err := json.Marshal(uuid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got UUID
+	if err = json.Unmarshal(marshaled, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got != uuid {
+		t.Fatalf("got %s != uuid %s", got, uuid)
+	}
+
+	if err = json.Unmarshal([]byte(`"xxx"`), &got); err == nil {
+		t.Fatal("unmarshal an invalid uuid should fail")
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package chacha20
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"fmt"
+
+	"golang.org/x/crypto/chacha20"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+func checkNonce(aead cipher.AEAD, nonce []byte) error {
+	if len(nonce) != aead.NonceSize() {
+		return fmt.Errorf("cryptox/chacha20: len(nonce) %d != nonceSize %d", len(nonce), aead.NonceSize())
+	}
+
+	return nil
+}
+
+func xorKeyStream(data []byte, key []byte, nonce []byte, conf *Config) ([]byte, error) {
+	stream, err := chacha20.NewUnauthenticatedCipher(key, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.counter > 0 {
+		stream.SetCounter(conf.counter)
+	}
+
+	dst := bytes.Clone(data)
+	stream.XORKeyStream(dst, data)
+	return dst, nil
+}
+
+func seal(aead cipher.AEAD, data []byte, nonce []byte, conf *Config) ([]byte, error) {
+	if err := checkNonce(aead, nonce); err != nil {
+		return nil, err
+	}
+
+	dst := make([]byte, 0, len(data)+aead.Overhead())
+	dst = aead.Seal(dst, nonce, data, conf.additional)
+	dst = conf.encoding.Encode(dst)
+	return dst, nil
+}
+
+func open(aead cipher.AEAD, data []byte, nonce []byte, conf *Config) ([]byte, error) {
+	if err := checkNonce(aead, nonce); err != nil {
+		return nil, err
+	}
+
+	src, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := bytes.Clone(src)
+	dst = dst[:0]
+
+	return aead.Open(dst, nonce, src, conf.additional)
+}
+
+// Encrypt uses chacha20 to encrypt data without authentication.
+// The nonce can be 12 bytes for chacha20 or 24 bytes for xchacha20.
+func Encrypt(data []byte, key []byte, nonce []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	dst, err := xorKeyStream(data, key, nonce, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	dst = conf.encoding.Encode(dst)
+	return dst, nil
+}
+
+// EncryptPoly1305 uses chacha20-poly1305 in rfc 8439 to encrypt data.
+// The nonce must be 12 bytes and must not be reused with the same key.
+func EncryptPoly1305(data []byte, key []byte, nonce []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return seal(aead, data, nonce, conf)
+}
+
+// EncryptXPoly1305 uses xchacha20-poly1305 to encrypt data.
+// The nonce must be 24 bytes which is safe to be generated randomly.
+func EncryptXPoly1305(data []byte, key []byte, nonce []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return seal(aead, data, nonce, conf)
+}
+
+// Decrypt uses chacha20 to decrypt data without authentication.
+// The nonce can be 12 bytes for chacha20 or 24 bytes for xchacha20.
+func Decrypt(data []byte, key []byte, nonce []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	src, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return xorKeyStream(src, key, nonce, conf)
+}
+
+// DecryptPoly1305 uses chacha20-poly1305 in rfc 8439 to decrypt data.
+// The nonce must be 12 bytes.
+func DecryptPoly1305(data []byte, key []byte, nonce []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(aead, data, nonce, conf)
+}
+
+// DecryptXPoly1305 uses xchacha20-poly1305 to decrypt data.
+// The nonce must be 24 bytes.
+func DecryptXPoly1305(data []byte, key []byte, nonce []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(aead, data, nonce, conf)
+}
@@ -0,0 +1,347 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package chacha20
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+var (
+	testKey        = []byte("12345678876543211234567887654321")
+	testNonce      = []byte("123456abcdef")
+	testXNonce     = []byte("123456abcdef123456abcdef")
+	testAdditional = []byte("8765432112345678")
+)
+
+type testCase struct {
+	Data              []byte
+	EncryptData       []byte
+	EncryptDataHex    []byte
+	EncryptDataBase64 []byte
+}
+
+type testEncryptFunc func(data []byte, opts ...Option) ([]byte, error)
+
+type testDecryptFunc func(data []byte, opts ...Option) ([]byte, error)
+
+func testEncryptAndDecrypt(name string, encrypt testEncryptFunc, decrypt testDecryptFunc, testCases []testCase) error {
+	for _, testCase := range testCases {
+		// None
+		encrypted, err := encrypt(testCase.Data)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(encrypted, testCase.EncryptData) {
+			return fmt.Errorf("%s data %q: got %+v != expect %+v", name, testCase.Data, encrypted, testCase.EncryptData)
+		}
+
+		decrypted, err := decrypt(encrypted)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(decrypted, testCase.Data) {
+			return fmt.Errorf("%s encrypted %q: got %+v != expect %+v", name, encrypted, decrypted, testCase.Data)
+		}
+
+		// Hex
+		encrypted, err = encrypt(testCase.Data, WithHex())
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(encrypted, testCase.EncryptDataHex) {
+			return fmt.Errorf("%s data hex %q: got %s != expect %s", name, testCase.Data, encrypted, testCase.EncryptDataHex)
+		}
+
+		decrypted, err = decrypt(encrypted, WithHex())
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(decrypted, testCase.Data) {
+			return fmt.Errorf("%s encrypted hex %q: got %s != expect %s", name, encrypted, decrypted, testCase.Data)
+		}
+
+		// Base64
+		encrypted, err = encrypt(testCase.Data, WithBase64())
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(encrypted, testCase.EncryptDataBase64) {
+			return fmt.Errorf("%s data base64 %q: got %s != expect %s", name, testCase.Data, encrypted, testCase.EncryptDataBase64)
+		}
+
+		decrypted, err = decrypt(encrypted, WithBase64())
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(decrypted, testCase.Data) {
+			return fmt.Errorf("%s encrypted base64 %q: got %s != expect %s", name, encrypted, decrypted, testCase.Data)
+		}
+	}
+
+	return nil
+}
+
+// go test -v -cover -run=^TestChaCha20$
+func TestChaCha20(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{},
+			EncryptDataHex:    []byte(""),
+			EncryptDataBase64: []byte(""),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{90, 70, 11},
+			EncryptDataHex:    []byte("5a460b"),
+			EncryptDataBase64: []byte("WkYL"),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{143, 201, 152, 224, 77, 205, 187, 192, 242, 221, 64, 62, 1, 234, 99},
+			EncryptDataHex:    []byte("8fc998e04dcdbbc0f2dd403e01ea63"),
+			EncryptDataBase64: []byte("j8mY4E3Nu8Dy3UA+Aepj"),
+		},
+	}
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		return Encrypt(data, testKey, testNonce, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		return Decrypt(data, testKey, testNonce, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestXChaCha20$
+func TestXChaCha20(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{},
+			EncryptDataHex:    []byte(""),
+			EncryptDataBase64: []byte(""),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{77, 2, 6},
+			EncryptDataHex:    []byte("4d0206"),
+			EncryptDataBase64: []byte("TQIG"),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{152, 141, 149, 253, 178, 37, 73, 73, 204, 137, 210, 216, 216, 91, 232},
+			EncryptDataHex:    []byte("988d95fdb2254949cc89d2d8d85be8"),
+			EncryptDataBase64: []byte("mI2V/bIlSUnMidLY2Fvo"),
+		},
+	}
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		return Encrypt(data, testKey, testXNonce, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		return Decrypt(data, testKey, testXNonce, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestPoly1305$
+func TestPoly1305(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{123, 69, 141, 164, 9, 116, 65, 44, 204, 222, 245, 13, 247, 156, 90, 193},
+			EncryptDataHex:    []byte("7b458da40974412cccdef50df79c5ac1"),
+			EncryptDataBase64: []byte("e0WNpAl0QSzM3vUN95xawQ=="),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{221, 4, 237, 89, 70, 90, 136, 162, 81, 59, 167, 73, 184, 77, 0, 181, 3, 225, 127},
+			EncryptDataHex:    []byte("dd04ed59465a88a2513ba749b84d00b503e17f"),
+			EncryptDataBase64: []byte("3QTtWUZaiKJRO6dJuE0AtQPhfw=="),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{8, 139, 126, 101, 211, 190, 172, 58, 30, 74, 129, 124, 110, 232, 80, 42, 9, 41, 14, 164, 92, 127, 121, 235, 59, 185, 23, 76, 56, 241, 83},
+			EncryptDataHex:    []byte("088b7e65d3beac3a1e4a817c6ee8502a09290ea45c7f79eb3bb9174c38f153"),
+			EncryptDataBase64: []byte("CIt+ZdO+rDoeSoF8buhQKgkpDqRcf3nrO7kXTDjxUw=="),
+		},
+	}
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithAdditional(testAdditional))
+		return EncryptPoly1305(data, testKey, testNonce, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithAdditional(testAdditional))
+		return DecryptPoly1305(data, testKey, testNonce, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestXPoly1305$
+func TestXPoly1305(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{59, 167, 107, 148, 79, 233, 169, 187, 13, 124, 192, 144, 64, 43, 178, 208},
+			EncryptDataHex:    []byte("3ba76b944fe9a9bb0d7cc090402bb2d0"),
+			EncryptDataBase64: []byte("O6drlE/pqbsNfMCQQCuy0A=="),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{36, 128, 167, 159, 132, 192, 102, 124, 216, 145, 119, 92, 85, 91, 230, 44, 52, 79, 137},
+			EncryptDataHex:    []byte("2480a79f84c0667cd891775c555be62c344f89"),
+			EncryptDataBase64: []byte("JICnn4TAZnzYkXdcVVvmLDRPiQ=="),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{241, 15, 52, 161, 116, 34, 202, 51, 45, 89, 73, 245, 211, 164, 185, 106, 81, 56, 252, 90, 31, 134, 12, 63, 4, 146, 150, 56, 228, 86, 136},
+			EncryptDataHex:    []byte("f10f34a17422ca332d5949f5d3a4b96a5138fc5a1f860c3f04929638e45688"),
+			EncryptDataBase64: []byte("8Q80oXQiyjMtWUn106S5alE4/Fofhgw/BJKWOORWiA=="),
+		},
+	}
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithAdditional(testAdditional))
+		return EncryptXPoly1305(data, testKey, testXNonce, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithAdditional(testAdditional))
+		return DecryptXPoly1305(data, testKey, testXNonce, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestRFC8439$
+func TestRFC8439(t *testing.T) {
+	data := []byte("Ladies and Gentlemen of the class of '99: If I could offer you only one tip for the future, sunscreen would be it.")
+
+	// Section 2.4.2 of rfc 8439.
+	key, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+	nonce, _ := hex.DecodeString("000000000000004a00000000")
+	expect := "6e2e359a2568f98041ba0728dd0d6981e97e7aec1d4360c20a27afccfd9fae0bf91b65c5524733ab8f593dabcd62b3571639d624e65152ab8f530c359f0861d807ca0dbf500d6a6156a38e088a22b65e52bc514d16ccf806818ce91ab77937365af90bbf74a35be6b40b8eedf2785e42874d"
+
+	encrypted, err := Encrypt(data, key, nonce, WithHex(), WithCounter(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(encrypted) != expect {
+		t.Fatalf("got %s != expect %s", encrypted, expect)
+	}
+
+	decrypted, err := Decrypt(encrypted, key, nonce, WithHex(), WithCounter(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(decrypted) != string(data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+
+	// Section 2.8.2 of rfc 8439.
+	key, _ = hex.DecodeString("808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f")
+	nonce, _ = hex.DecodeString("070000004041424344454647")
+	additional, _ := hex.DecodeString("50515253c0c1c2c3c4c5c6c7")
+	expect = "d31a8d34648e60db7b86afbc53ef7ec2a4aded51296e08fea9e2b5a736ee62d63dbea45e8ca9671282fafb69da92728b1a71de0a9e060b2905d6a5b67ecd3b3692ddbd7f2d778b8c9803aee328091b58fab324e4fad675945585808b4831d7bc3ff4def08e4b7a9de576d26586cec64b6116" + "1ae10b594f09e26a7e902ecbd0600691"
+
+	encrypted, err = EncryptPoly1305(data, key, nonce, WithHex(), WithAdditional(additional))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(encrypted) != expect {
+		t.Fatalf("got %s != expect %s", encrypted, expect)
+	}
+
+	decrypted, err = DecryptPoly1305(encrypted, key, nonce, WithHex(), WithAdditional(additional))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(decrypted) != string(data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+
+	// Appendix a.3.1 of draft-irtf-cfrg-xchacha.
+	nonce, _ = hex.DecodeString("404142434445464748494a4b4c4d4e4f5051525354555657")
+	expect = "bd6d179d3e83d43b9576579493c0e939572a1700252bfaccbed2902c21396cbb731c7f1b0b4aa6440bf3a82f4eda7e39ae64c6708c54c216cb96b72e1213b4522f8c9ba40db5d945b11b69b982c1bb9e3f3fac2bc369488f76b2383565d3fff921f9664c97637da9768812f615c68b13b52e" + "c0875924c1c7987947deafd8780acf49"
+
+	encrypted, err = EncryptXPoly1305(data, key, nonce, WithHex(), WithAdditional(additional))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(encrypted) != expect {
+		t.Fatalf("got %s != expect %s", encrypted, expect)
+	}
+
+	decrypted, err = DecryptXPoly1305(encrypted, key, nonce, WithHex(), WithAdditional(additional))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(decrypted) != string(data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+}
+
+// go test -v -cover -run=^TestDecryptFailed$
+func TestDecryptFailed(t *testing.T) {
+	data := []byte("你好，世界")
+
+	encrypted, err := EncryptXPoly1305(data, testKey, testXNonce, WithAdditional(testAdditional))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = DecryptXPoly1305(encrypted, testKey, testXNonce); err == nil {
+		t.Fatal("decrypt without additional should fail")
+	}
+
+	encrypted[0] ^= 1
+
+	if _, err = DecryptXPoly1305(encrypted, testKey, testXNonce, WithAdditional(testAdditional)); err == nil {
+		t.Fatal("decrypt tampered data should fail")
+	}
+
+	if _, err = EncryptPoly1305(data, testKey, testXNonce); err == nil {
+		t.Fatal("encrypt with a wrong nonce size should fail")
+	}
+
+	if _, err = DecryptXPoly1305(encrypted, testKey, testNonce); err == nil {
+		t.Fatal("decrypt with a wrong nonce size should fail")
+	}
+
+	if _, err = Encrypt(data, testKey[:16], testNonce); err == nil {
+		t.Fatal("encrypt with a wrong key size should fail")
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package chacha20
+
+import (
+	"github.com/FishGoddess/cryptox/bytes/rand"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Nonce returns a standard nonce for chacha20 and chacha20-poly1305.
+// Don't reuse it with the same key because a random 12 bytes nonce may collide after many messages.
+func Nonce() []byte {
+	return rand.Bytes(chacha20poly1305.NonceSize)
+}
+
+// XNonce returns a standard nonce for xchacha20 and xchacha20-poly1305.
+// It's safe to generate it randomly for every message.
+func XNonce() []byte {
+	return rand.Bytes(chacha20poly1305.NonceSizeX)
+}
@@ -0,0 +1,29 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package chacha20
+
+import (
+	"testing"
+)
+
+// go test -v -cover -run=^TestNonce$
+func TestNonce(t *testing.T) {
+	nonce := Nonce()
+	if len(nonce) != 12 {
+		t.Fatalf("len(nonce) %d is wrong", len(nonce))
+	}
+
+	t.Logf("%s\n", nonce)
+}
+
+// go test -v -cover -run=^TestXNonce$
+func TestXNonce(t *testing.T) {
+	nonce := XNonce()
+	if len(nonce) != 24 {
+		t.Fatalf("len(nonce) %d is wrong", len(nonce))
+	}
+
+	t.Logf("%s\n", nonce)
+}
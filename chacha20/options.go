@@ -0,0 +1,65 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package chacha20
+
+import (
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+)
+
+type Config struct {
+	encoding   encoding.Encoding
+	additional []byte
+	counter    uint32
+}
+
+func newConfig() *Config {
+	conf := &Config{
+		encoding:   encoding.None{},
+		additional: nil,
+		counter:    0,
+	}
+
+	return conf
+}
+
+func (c *Config) Apply(opts ...Option) *Config {
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+type Option func(conf *Config)
+
+// WithHex sets hex encoding to config.
+func WithHex() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Hex{}
+	}
+}
+
+// WithBase64 sets base64 encoding to config.
+func WithBase64() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Base64{}
+	}
+}
+
+// WithAdditional sets additional to config.
+// It's only used in poly1305 modes.
+func WithAdditional(additional []byte) Option {
+	return func(conf *Config) {
+		conf.additional = additional
+	}
+}
+
+// WithCounter sets the initial block counter to config.
+// It's only used in raw chacha20 and rfc 8439 starts the counter at 1 for encryption.
+func WithCounter(counter uint32) Option {
+	return func(conf *Config) {
+		conf.counter = counter
+	}
+}
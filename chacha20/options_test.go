@@ -0,0 +1,48 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package chacha20
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+)
+
+// go test -v -cover -run=^TestConfig$
+func TestConfig(t *testing.T) {
+	additional := []byte("additional")
+
+	opts := []Option{
+		WithHex(),
+		WithAdditional(additional),
+		WithCounter(1),
+	}
+
+	conf := newConfig().Apply(opts...)
+
+	got := fmt.Sprintf("%T", conf.encoding)
+	expect := fmt.Sprintf("%T", encoding.Hex{})
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	conf.Apply(WithBase64())
+
+	got = fmt.Sprintf("%T", conf.encoding)
+	expect = fmt.Sprintf("%T", encoding.Base64{})
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if !slices.Equal(conf.additional, additional) {
+		t.Fatalf("got %s != expect %s", conf.additional, additional)
+	}
+
+	if conf.counter != 1 {
+		t.Fatalf("got %d != expect %d", conf.counter, 1)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdh
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+)
+
+// MarshalPKCS8PrivateKey marshals the private key to pkcs8 der.
+func (pk PrivateKey) MarshalPKCS8PrivateKey() ([]byte, error) {
+	return x509.MarshalPKCS8PrivateKey(pk.key)
+}
+
+// UnmarshalPKCS8PrivateKey unmarshals the private key from pkcs8 der.
+// The ec private key is parsed as an ecdsa key by x509 so it will be converted to an ecdh key.
+func (pk *PrivateKey) UnmarshalPKCS8PrivateKey(der []byte) error {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return err
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return errNotNISTCurve
+	}
+
+	ecdhKey, err := ecdsaKey.ECDH()
+	if err != nil {
+		return err
+	}
+
+	privateKey, err := NewPrivateKey(ecdhKey.Curve(), ecdhKey.Bytes())
+	if err != nil {
+		return err
+	}
+
+	*pk = privateKey
+	return nil
+}
+
+// MarshalPKIXPublicKey marshals the public key to pkix der.
+func (pk PublicKey) MarshalPKIXPublicKey() ([]byte, error) {
+	return x509.MarshalPKIXPublicKey(pk.key)
+}
+
+// UnmarshalPKIXPublicKey unmarshals the public key from pkix der.
+// The ec public key is parsed as an ecdsa key by x509 so it will be converted to an ecdh key.
+func (pk *PublicKey) UnmarshalPKIXPublicKey(der []byte) error {
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return err
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return errNotNISTCurve
+	}
+
+	ecdhKey, err := ecdsaKey.ECDH()
+	if err != nil {
+		return err
+	}
+
+	publicKey, err := NewPublicKey(ecdhKey.Curve(), ecdhKey.Bytes())
+	if err != nil {
+		return err
+	}
+
+	*pk = publicKey
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdh
+
+import (
+	"crypto/ecdh"
+	"crypto/elliptic"
+	"errors"
+)
+
+var (
+	errNotNISTCurve    = errors.New("cryptox/ecdh: curve isn't p-256, p-384 or p-521")
+	errInvalidPoint    = errors.New("cryptox/ecdh: invalid point")
+	errCurveMismatched = errors.New("cryptox/ecdh: curve is mismatched")
+)
+
+// ellipticCurve returns the elliptic curve of the ecdh curve which is used to decompress points.
+func ellipticCurve(curve ecdh.Curve) (elliptic.Curve, error) {
+	switch curve {
+	case ecdh.P256():
+		return elliptic.P256(), nil
+	case ecdh.P384():
+		return elliptic.P384(), nil
+	case ecdh.P521():
+		return elliptic.P521(), nil
+	default:
+		return nil, errNotNISTCurve
+	}
+}
+
+type PrivateKey struct {
+	key *ecdh.PrivateKey
+}
+
+// NewPrivateKey returns a private key of the curve.
+// The data is the big-endian scalar in curve size, that is 32/48/66 bytes for p-256/p-384/p-521.
+func NewPrivateKey(curve ecdh.Curve, data []byte) (PrivateKey, error) {
+	if _, err := ellipticCurve(curve); err != nil {
+		return PrivateKey{}, err
+	}
+
+	key, err := curve.NewPrivateKey(data)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	privateKey := PrivateKey{key: key}
+	return privateKey, nil
+}
+
+// Curve returns the curve of the private key.
+func (pk PrivateKey) Curve() ecdh.Curve {
+	return pk.key.Curve()
+}
+
+// Bytes returns the private key in bytes.
+func (pk PrivateKey) Bytes() []byte {
+	return pk.key.Bytes()
+}
+
+// PublicKey returns the public key of the private key.
+func (pk PrivateKey) PublicKey() PublicKey {
+	return PublicKey{key: pk.key.PublicKey()}
+}
+
+// Equal returns if the private key equals to the key.
+func (pk PrivateKey) Equal(key PrivateKey) bool {
+	return pk.key != nil && key.key != nil && pk.key.Equal(key.key)
+}
+
+// SharedSecret computes the shared secret with the peer's public key.
+// The secret is the x coordinate of the shared point which is the z in nist sp 800-56a.
+// It will be derived by the kdf if one of WithHKDF, WithX963KDF and WithConcatKDF is set, so it can be used as an aes key directly.
+func (pk PrivateKey) SharedSecret(publicKey PublicKey, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	if pk.key.Curve() != publicKey.key.Curve() {
+		return nil, errCurveMismatched
+	}
+
+	secret, err := pk.key.ECDH(publicKey.key)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err = deriveKey(conf, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	secret = conf.encoding.Encode(secret)
+	return secret, nil
+}
+
+type PublicKey struct {
+	key *ecdh.PublicKey
+}
+
+// NewPublicKey returns a public key of the curve.
+// The data can be an uncompressed point starting with 0x04 or a compressed point starting with 0x02/0x03.
+func NewPublicKey(curve ecdh.Curve, data []byte) (PublicKey, error) {
+	ec, err := ellipticCurve(curve)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	if len(data) > 0 && (data[0] == 0x02 || data[0] == 0x03) {
+		data, err = decompress(ec, data)
+		if err != nil {
+			return PublicKey{}, err
+		}
+	}
+
+	key, err := curve.NewPublicKey(data)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	publicKey := PublicKey{key: key}
+	return publicKey, nil
+}
+
+// decompress converts the compressed point to the uncompressed point.
+func decompress(curve elliptic.Curve, data []byte) ([]byte, error) {
+	x, y := elliptic.UnmarshalCompressed(curve, data)
+	if x == nil {
+		return nil, errInvalidPoint
+	}
+
+	size := (curve.Params().BitSize + 7) / 8
+
+	point := make([]byte, 1+2*size)
+	point[0] = 0x04
+	x.FillBytes(point[1 : 1+size])
+	y.FillBytes(point[1+size:])
+	return point, nil
+}
+
+// Curve returns the curve of the public key.
+func (pk PublicKey) Curve() ecdh.Curve {
+	return pk.key.Curve()
+}
+
+// Bytes returns the public key in uncompressed point which starts with 0x04.
+func (pk PublicKey) Bytes() []byte {
+	return pk.key.Bytes()
+}
+
+// BytesCompressed returns the public key in compressed point which starts with 0x02 or 0x03.
+func (pk PublicKey) BytesCompressed() []byte {
+	point := pk.key.Bytes()
+	size := (len(point) - 1) / 2
+
+	// The prefix is 0x02 if y is even and 0x03 if y is odd.
+	compressed := make([]byte, 1+size)
+	compressed[0] = 0x02 | point[len(point)-1]&1
+	copy(compressed[1:], point[1:1+size])
+	return compressed
+}
+
+// Equal returns if the public key equals to the key.
+func (pk PublicKey) Equal(key PublicKey) bool {
+	return pk.key != nil && key.key != nil && pk.key.Equal(key.key)
+}
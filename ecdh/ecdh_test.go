@@ -0,0 +1,243 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdh
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func base64URL(t *testing.T, str string) []byte {
+	data, err := base64.RawURLEncoding.DecodeString(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func lengthPrefixed(str string) []byte {
+	data := binary.BigEndian.AppendUint32(nil, uint32(len(str)))
+	return append(data, str...)
+}
+
+// go test -v -cover -run=^TestSharedSecret$
+func TestSharedSecret(t *testing.T) {
+	curves := []ecdh.Curve{ecdh.P256(), ecdh.P384(), ecdh.P521()}
+	secretSizes := []int{32, 48, 66}
+
+	for i, curve := range curves {
+		privateKey, publicKey, err := GenerateKeys(curve)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		peerPrivateKey, peerPublicKey, err := GenerateKeys(curve)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		secret, err := privateKey.SharedSecret(peerPublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		peerSecret, err := peerPrivateKey.SharedSecret(publicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(secret) != string(peerSecret) {
+			t.Fatalf("got %x != expect %x", secret, peerSecret)
+		}
+
+		if len(secret) != secretSizes[i] {
+			t.Fatalf("got %d != expect %d", len(secret), secretSizes[i])
+		}
+
+		opts := []Option{WithHKDF(crypto.SHA256, 32), WithHKDFSalt([]byte("salt")), WithHKDFInfo("aes-gcm key"), WithHex()}
+
+		secret, err = privateKey.SharedSecret(peerPublicKey, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		peerSecret, err = peerPrivateKey.SharedSecret(publicKey, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(secret) != string(peerSecret) {
+			t.Fatalf("got %s != expect %s", secret, peerSecret)
+		}
+
+		if len(secret) != 64 {
+			t.Fatalf("got %d != expect %d", len(secret), 64)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSharedSecretJWE$
+func TestSharedSecretJWE(t *testing.T) {
+	// The ecdh-es example in rfc 7518 appendix c.
+	privateKey, err := NewPrivateKey(ecdh.P256(), base64URL(t, "0_NxaRPUMQoAJt50Gz8YiTr8gRTwyEaCumd-MToTmIo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	point := []byte{0x04}
+	point = append(point, base64URL(t, "weNJy2HscCSM6AEDTDg04biOvhFhyyWvOHQfeF_PxMQ")...)
+	point = append(point, base64URL(t, "e8lnCO-AlStT-NJVX-crhB7QRYhiix03illJOVAOyck")...)
+
+	publicKey, err := NewPublicKey(ecdh.P256(), point)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := privateKey.SharedSecret(publicKey, WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := "9e56d91d817135d372834283bf84269cfb316ea3da806a48f6daa7798cfe90c4"
+	if string(secret) != expect {
+		t.Fatalf("got %s != expect %s", secret, expect)
+	}
+
+	// The other info is algorithm id || party u info || party v info || supp pub info.
+	otherInfo := lengthPrefixed("A128GCM")
+	otherInfo = append(otherInfo, lengthPrefixed("Alice")...)
+	otherInfo = append(otherInfo, lengthPrefixed("Bob")...)
+	otherInfo = binary.BigEndian.AppendUint32(otherInfo, 128)
+
+	key, err := privateKey.SharedSecret(publicKey, WithConcatKDF(crypto.SHA256, 16, otherInfo))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect = "VqqN6vgjbSBcIijNcacQGg"
+	if got := base64.RawURLEncoding.EncodeToString(key); got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestSharedSecretOpenSSL$
+func TestSharedSecretOpenSSL(t *testing.T) {
+	privateKey, err := ReadPrivateKey(strings.NewReader(testPrivateKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey, err := ReadPublicKey(strings.NewReader(testPublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// openssl pkeyutl -derive -inkey private.pem -peerkey public.pem
+	secret, err := privateKey.SharedSecret(publicKey, WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := "9263c15be4ef616cd56f791d23dc14a1426e53d8d3cf9fb5c57ff69c06cbcbf63e25762e369521ea16aeeb10456b4514"
+	if string(secret) != expect {
+		t.Fatalf("got %s != expect %s", secret, expect)
+	}
+
+	_, peerPublicKey, err := GenerateKeys(ecdh.P256())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = privateKey.SharedSecret(peerPublicKey)
+	if err != errCurveMismatched {
+		t.Fatalf("got %+v != expect %+v", err, errCurveMismatched)
+	}
+}
+
+// go test -v -cover -run=^TestPublicKeyCompressed$
+func TestPublicKeyCompressed(t *testing.T) {
+	publicKey, err := ReadPublicKey(strings.NewReader(testPublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// openssl pkey -pubin -ec_conv_form compressed
+	compressed := "020c59b9ff23f8468a77d2743a08df1192f8921861e0c795829b8f086d64801b4bc6a33d294880f8361eb9274ffa137430"
+	if got := hex.EncodeToString(publicKey.BytesCompressed()); got != compressed {
+		t.Fatalf("got %s != expect %s", got, compressed)
+	}
+
+	data, err := hex.DecodeString(compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compressedKey, err := NewPublicKey(ecdh.P384(), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !compressedKey.Equal(publicKey) {
+		t.Fatalf("got %x != expect %x", compressedKey.Bytes(), publicKey.Bytes())
+	}
+
+	curves := []ecdh.Curve{ecdh.P256(), ecdh.P384(), ecdh.P521()}
+
+	// Generate some keys so both even y and odd y will be covered.
+	for _, curve := range curves {
+		for i := 0; i < 16; i++ {
+			_, publicKey, err := GenerateKeys(curve)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			compressed := publicKey.BytesCompressed()
+			if len(compressed) != (len(publicKey.Bytes())+1)/2 {
+				t.Fatalf("got %d != expect %d", len(compressed), (len(publicKey.Bytes())+1)/2)
+			}
+
+			compressedKey, err := NewPublicKey(curve, compressed)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !compressedKey.Equal(publicKey) {
+				t.Fatalf("got %x != expect %x", compressedKey.Bytes(), publicKey.Bytes())
+			}
+		}
+	}
+
+	// The x isn't on the curve when it's flipped.
+	data[len(data)-1] ^= 0xff
+
+	_, err = NewPublicKey(ecdh.P384(), data)
+	if err == nil {
+		t.Fatal("new public key with invalid point should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestNotNISTCurve$
+func TestNotNISTCurve(t *testing.T) {
+	_, _, err := GenerateKeys(ecdh.X25519())
+	if err != errNotNISTCurve {
+		t.Fatalf("got %+v != expect %+v", err, errNotNISTCurve)
+	}
+
+	_, err = NewPrivateKey(ecdh.X25519(), make([]byte, 32))
+	if err != errNotNISTCurve {
+		t.Fatalf("got %+v != expect %+v", err, errNotNISTCurve)
+	}
+
+	_, err = NewPublicKey(ecdh.X25519(), make([]byte, 32))
+	if err != errNotNISTCurve {
+		t.Fatalf("got %+v != expect %+v", err, errNotNISTCurve)
+	}
+}
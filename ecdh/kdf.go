@@ -0,0 +1,89 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdh
+
+import (
+	"crypto"
+	"crypto/hkdf"
+	"encoding/binary"
+	"fmt"
+)
+
+// kdfType is the type of kdf deriving keys from the shared secret.
+type kdfType int
+
+const (
+	kdfNone kdfType = iota
+	kdfHKDF
+	kdfX963
+	kdfConcat
+)
+
+// x963KDF derives a key of keySize bytes in ansi x9.63 and sec 1.
+// The key is H(z || counter || sharedInfo) || H(z || counter+1 || sharedInfo) || ... and the counter starts from 1.
+func x963KDF(hash crypto.Hash, secret []byte, sharedInfo []byte, keySize int) []byte {
+	h := hash.New()
+	key := make([]byte, 0, keySize+h.Size())
+
+	var counter [4]byte
+	for i := uint32(1); len(key) < keySize; i++ {
+		binary.BigEndian.PutUint32(counter[:], i)
+
+		h.Reset()
+		h.Write(secret)
+		h.Write(counter[:])
+		h.Write(sharedInfo)
+		key = h.Sum(key)
+	}
+
+	return key[:keySize]
+}
+
+// concatKDF derives a key of keySize bytes in nist sp 800-56a which is used by jwe ecdh-es.
+// The key is H(counter || z || otherInfo) || H(counter+1 || z || otherInfo) || ... and the counter starts from 1.
+func concatKDF(hash crypto.Hash, secret []byte, otherInfo []byte, keySize int) []byte {
+	h := hash.New()
+	key := make([]byte, 0, keySize+h.Size())
+
+	var counter [4]byte
+	for i := uint32(1); len(key) < keySize; i++ {
+		binary.BigEndian.PutUint32(counter[:], i)
+
+		h.Reset()
+		h.Write(counter[:])
+		h.Write(secret)
+		h.Write(otherInfo)
+		key = h.Sum(key)
+	}
+
+	return key[:keySize]
+}
+
+// deriveKey derives the key from the shared secret with the kdf in config.
+// The secret will be returned directly if there is no kdf in config.
+func deriveKey(conf *Config, secret []byte) ([]byte, error) {
+	if conf.kdf == kdfNone {
+		return secret, nil
+	}
+
+	if !conf.kdfHash.Available() {
+		return nil, fmt.Errorf("cryptox/ecdh: kdf hash %+v isn't available", conf.kdfHash)
+	}
+
+	if conf.kdfKeySize <= 0 {
+		return nil, fmt.Errorf("cryptox/ecdh: kdf key size %d <= 0", conf.kdfKeySize)
+	}
+
+	switch conf.kdf {
+	case kdfHKDF:
+		return hkdf.Key(conf.kdfHash.New, secret, conf.hkdfSalt, conf.hkdfInfo, conf.kdfKeySize)
+	case kdfX963:
+		return x963KDF(conf.kdfHash, secret, conf.kdfInfo, conf.kdfKeySize), nil
+	case kdfConcat:
+		return concatKDF(conf.kdfHash, secret, conf.kdfInfo, conf.kdfKeySize), nil
+	default:
+		return nil, fmt.Errorf("cryptox/ecdh: unknown kdf %d", conf.kdf)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdh
+
+import (
+	"crypto"
+	"encoding/hex"
+	"testing"
+)
+
+// go test -v -cover -run=^TestX963KDF$
+func TestX963KDF(t *testing.T) {
+	secret, _ := hex.DecodeString("9e56d91d817135d372834283bf84269cfb316ea3da806a48f6daa7798cfe90c4")
+	sharedInfo, _ := hex.DecodeString("000000074131323847434d")
+
+	// openssl kdf -keylen 40 -kdfopt digest:SHA256 X963KDF
+	key := x963KDF(crypto.SHA256, secret, sharedInfo, 40)
+
+	expect := "e90efd6339d469c279d996cd37d4ea85f1bc062de3c18566dd65ca84523fdc3e831c6a53a12a5457"
+	if got := hex.EncodeToString(key); got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestConcatKDF$
+func TestConcatKDF(t *testing.T) {
+	secret, _ := hex.DecodeString("9e56d91d817135d372834283bf84269cfb316ea3da806a48f6daa7798cfe90c4")
+	otherInfo, _ := hex.DecodeString("000000074131323847434d")
+
+	// openssl kdf -keylen 40 -kdfopt digest:SHA256 SSKDF
+	key := concatKDF(crypto.SHA256, secret, otherInfo, 40)
+
+	expect := "5775d91610d1a868b6a6766c44f3303aecdd9a998cb2da3c98b65ff99409ce20197995eb382549bd"
+	if got := hex.EncodeToString(key); got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestDeriveKey$
+func TestDeriveKey(t *testing.T) {
+	secret := []byte("secret")
+
+	key, err := deriveKey(newConfig(), secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(key) != string(secret) {
+		t.Fatalf("got %s != expect %s", key, secret)
+	}
+
+	opts := []Option{
+		WithHKDF(crypto.SHA256, 16),
+		WithX963KDF(crypto.SHA384, 24, nil),
+		WithConcatKDF(crypto.SHA512, 32, nil),
+	}
+
+	for _, opt := range opts {
+		conf := newConfig().Apply(opt)
+
+		key, err = deriveKey(conf, secret)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(key) != conf.kdfKeySize {
+			t.Fatalf("got %d != expect %d", len(key), conf.kdfKeySize)
+		}
+	}
+
+	_, err = deriveKey(newConfig().Apply(WithX963KDF(crypto.Hash(999), 16, nil)), secret)
+	if err == nil {
+		t.Fatal("derive key with unavailable hash should be failed")
+	}
+
+	_, err = deriveKey(newConfig().Apply(WithConcatKDF(crypto.SHA256, 0, nil)), secret)
+	if err == nil {
+		t.Fatal("derive key with zero key size should be failed")
+	}
+}
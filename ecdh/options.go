@@ -0,0 +1,175 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdh
+
+import (
+	"crypto"
+	"crypto/rand"
+	"io"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+	"github.com/FishGoddess/cryptox/x509"
+)
+
+type KeyConfig struct {
+	random           io.Reader
+	encodePrivateKey func(key PrivateKey) ([]byte, error)
+	encodePublicKey  func(key PublicKey) ([]byte, error)
+	decodePrivateKey func(data []byte) (PrivateKey, error)
+	decodePublicKey  func(data []byte) (PublicKey, error)
+}
+
+func newKeyConfig() *KeyConfig {
+	conf := &KeyConfig{
+		random:           rand.Reader,
+		encodePrivateKey: x509.EncodePrivateKeyPKCS8[PrivateKey],
+		encodePublicKey:  x509.EncodePublicKeyPKIX[PublicKey],
+		decodePrivateKey: x509.DecodePrivateKeyPKCS8[PrivateKey],
+		decodePublicKey:  x509.DecodePublicKeyPKIX[PublicKey],
+	}
+
+	return conf
+}
+
+func (kc *KeyConfig) Apply(opts ...KeyOption) *KeyConfig {
+	for _, opt := range opts {
+		opt(kc)
+	}
+
+	return kc
+}
+
+type KeyOption func(conf *KeyConfig)
+
+// WithKeyRandom sets random to key config.
+// The random is ignored by crypto/ecdh since go 1.26 unless GODEBUG=cryptocustomrand=1 is set.
+func WithKeyRandom(random io.Reader) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.random = random
+	}
+}
+
+// WithKeyEncodePrivate sets encode to key config.
+func WithKeyEncodePrivate(encode func(key PrivateKey) ([]byte, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.encodePrivateKey = encode
+	}
+}
+
+// WithKeyEncodePublic sets encode to key config.
+func WithKeyEncodePublic(encode func(key PublicKey) ([]byte, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.encodePublicKey = encode
+	}
+}
+
+// WithKeyDecodePrivate sets decode to key config.
+func WithKeyDecodePrivate(decode func(data []byte) (PrivateKey, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.decodePrivateKey = decode
+	}
+}
+
+// WithKeyDecodePublic sets decode to key config.
+func WithKeyDecodePublic(decode func(data []byte) (PublicKey, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.decodePublicKey = decode
+	}
+}
+
+type Config struct {
+	encoding   encoding.Encoding
+	kdf        kdfType
+	kdfHash    crypto.Hash
+	kdfKeySize int
+	kdfInfo    []byte
+	hkdfSalt   []byte
+	hkdfInfo   string
+}
+
+func newConfig() *Config {
+	conf := &Config{
+		encoding:   encoding.None{},
+		kdf:        kdfNone,
+		kdfHash:    crypto.Hash(0),
+		kdfKeySize: 0,
+		kdfInfo:    nil,
+		hkdfSalt:   nil,
+		hkdfInfo:   "",
+	}
+
+	return conf
+}
+
+func (c *Config) Apply(opts ...Option) *Config {
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+type Option func(conf *Config)
+
+// WithHex sets hex encoding to config.
+func WithHex() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Hex{}
+	}
+}
+
+// WithBase64 sets base64 encoding to config.
+func WithBase64() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Base64{}
+	}
+}
+
+// WithHKDF sets hkdf to config.
+// The shared secret will be derived to a key of keySize bytes, such as 16/24/32 for aes.
+func WithHKDF(hash crypto.Hash, keySize int) Option {
+	return func(conf *Config) {
+		conf.kdf = kdfHKDF
+		conf.kdfHash = hash
+		conf.kdfKeySize = keySize
+	}
+}
+
+// WithHKDFSalt sets hkdf salt to config.
+func WithHKDFSalt(salt []byte) Option {
+	return func(conf *Config) {
+		conf.hkdfSalt = salt
+	}
+}
+
+// WithHKDFInfo sets hkdf info to config.
+// It's recommended to bind the derived key to its usage like "aes-gcm key".
+func WithHKDFInfo(info string) Option {
+	return func(conf *Config) {
+		conf.hkdfInfo = info
+	}
+}
+
+// WithX963KDF sets ansi x9.63 kdf to config.
+// The shared secret will be derived to a key of keySize bytes with the shared info, which is used by ecies.
+func WithX963KDF(hash crypto.Hash, keySize int, sharedInfo []byte) Option {
+	return func(conf *Config) {
+		conf.kdf = kdfX963
+		conf.kdfHash = hash
+		conf.kdfKeySize = keySize
+		conf.kdfInfo = sharedInfo
+	}
+}
+
+// WithConcatKDF sets nist sp 800-56a concat kdf to config.
+// The shared secret will be derived to a key of keySize bytes with the other info, which is used by jwe ecdh-es.
+func WithConcatKDF(hash crypto.Hash, keySize int, otherInfo []byte) Option {
+	return func(conf *Config) {
+		conf.kdf = kdfConcat
+		conf.kdfHash = hash
+		conf.kdfKeySize = keySize
+		conf.kdfInfo = otherInfo
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdh
+
+import (
+	"crypto"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+	"github.com/FishGoddess/cryptox/x509"
+)
+
+// go test -v -cover -run=^TestKeyConfig$
+func TestKeyConfig(t *testing.T) {
+	opts := []KeyOption{
+		WithKeyRandom(rand.Reader),
+		WithKeyEncodePrivate(x509.EncodePrivateKeyPKCS8[PrivateKey]),
+		WithKeyEncodePublic(x509.EncodePublicKeyPKIX[PublicKey]),
+		WithKeyDecodePrivate(x509.DecodePrivateKeyPKCS8[PrivateKey]),
+		WithKeyDecodePublic(x509.DecodePublicKeyPKIX[PublicKey]),
+	}
+
+	conf := newKeyConfig().Apply(opts...)
+
+	got := fmt.Sprintf("%p", conf.random)
+	expect := fmt.Sprintf("%p", rand.Reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.encodePrivateKey)
+	expect = fmt.Sprintf("%p", x509.EncodePrivateKeyPKCS8[PrivateKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.encodePublicKey)
+	expect = fmt.Sprintf("%p", x509.EncodePublicKeyPKIX[PublicKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.decodePrivateKey)
+	expect = fmt.Sprintf("%p", x509.DecodePrivateKeyPKCS8[PrivateKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.decodePublicKey)
+	expect = fmt.Sprintf("%p", x509.DecodePublicKeyPKIX[PublicKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestConfig$
+func TestConfig(t *testing.T) {
+	salt := []byte("salt")
+	info := "info"
+
+	opts := []Option{
+		WithHex(),
+		WithHKDF(crypto.SHA256, 32),
+		WithHKDFSalt(salt),
+		WithHKDFInfo(info),
+	}
+
+	conf := newConfig().Apply(opts...)
+
+	got := fmt.Sprintf("%T", conf.encoding)
+	expect := fmt.Sprintf("%T", encoding.Hex{})
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	conf.Apply(WithBase64())
+
+	got = fmt.Sprintf("%T", conf.encoding)
+	expect = fmt.Sprintf("%T", encoding.Base64{})
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if conf.kdf != kdfHKDF || conf.kdfHash != crypto.SHA256 || conf.kdfKeySize != 32 {
+		t.Fatalf("got %d %d %d != expect %d %d %d", conf.kdf, conf.kdfHash, conf.kdfKeySize, kdfHKDF, crypto.SHA256, 32)
+	}
+
+	if string(conf.hkdfSalt) != string(salt) {
+		t.Fatalf("got %s != expect %s", conf.hkdfSalt, salt)
+	}
+
+	if conf.hkdfInfo != info {
+		t.Fatalf("got %s != expect %s", conf.hkdfInfo, info)
+	}
+
+	sharedInfo := []byte("shared info")
+	conf.Apply(WithX963KDF(crypto.SHA384, 24, sharedInfo))
+
+	if conf.kdf != kdfX963 || conf.kdfHash != crypto.SHA384 || conf.kdfKeySize != 24 {
+		t.Fatalf("got %d %d %d != expect %d %d %d", conf.kdf, conf.kdfHash, conf.kdfKeySize, kdfX963, crypto.SHA384, 24)
+	}
+
+	if string(conf.kdfInfo) != string(sharedInfo) {
+		t.Fatalf("got %s != expect %s", conf.kdfInfo, sharedInfo)
+	}
+
+	otherInfo := []byte("other info")
+	conf.Apply(WithConcatKDF(crypto.SHA512, 16, otherInfo))
+
+	if conf.kdf != kdfConcat || conf.kdfHash != crypto.SHA512 || conf.kdfKeySize != 16 {
+		t.Fatalf("got %d %d %d != expect %d %d %d", conf.kdf, conf.kdfHash, conf.kdfKeySize, kdfConcat, crypto.SHA512, 16)
+	}
+
+	if string(conf.kdfInfo) != string(otherInfo) {
+		t.Fatalf("got %s != expect %s", conf.kdfInfo, otherInfo)
+	}
+}
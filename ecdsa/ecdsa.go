@@ -0,0 +1,162 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdsa
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var errVerifyFailed = errors.New("cryptox/ecdsa: verify failed")
+
+type signature struct {
+	R *big.Int
+	S *big.Int
+}
+
+// curveHash returns the crypto hash matching the curve size like sha256 for p-256.
+func curveHash(curve elliptic.Curve) crypto.Hash {
+	bitSize := curve.Params().BitSize
+
+	switch {
+	case bitSize <= 256:
+		return crypto.SHA256
+	case bitSize <= 384:
+		return crypto.SHA384
+	default:
+		return crypto.SHA512
+	}
+}
+
+// curveByteSize returns the byte size of the curve order which is the width of r and s in p1363.
+func curveByteSize(curve elliptic.Curve) int {
+	return (curve.Params().N.BitLen() + 7) / 8
+}
+
+func digest(curve elliptic.Curve, data []byte, cryptoHash crypto.Hash) ([]byte, error) {
+	if cryptoHash == 0 {
+		cryptoHash = curveHash(curve)
+	}
+
+	if !cryptoHash.Available() {
+		return nil, fmt.Errorf("cryptox/ecdsa: crypto hash %+v isn't available", cryptoHash)
+	}
+
+	h := cryptoHash.New()
+	h.Write(data)
+	return h.Sum(nil), nil
+}
+
+// toP1363 converts the asn.1 der signature to the fixed width r||s signature.
+func toP1363(curve elliptic.Curve, sign []byte) ([]byte, error) {
+	var sig signature
+	if rest, err := asn1.Unmarshal(sign, &sig); err != nil || len(rest) > 0 {
+		return nil, errors.New("cryptox/ecdsa: invalid asn1 signature")
+	}
+
+	size := curveByteSize(curve)
+
+	p1363 := make([]byte, 2*size)
+	sig.R.FillBytes(p1363[:size])
+	sig.S.FillBytes(p1363[size:])
+	return p1363, nil
+}
+
+// fromP1363 converts the fixed width r||s signature to the asn.1 der signature.
+func fromP1363(curve elliptic.Curve, sign []byte) ([]byte, error) {
+	size := curveByteSize(curve)
+	if len(sign) != 2*size {
+		return nil, errVerifyFailed
+	}
+
+	sig := signature{
+		R: new(big.Int).SetBytes(sign[:size]),
+		S: new(big.Int).SetBytes(sign[size:]),
+	}
+
+	return asn1.Marshal(sig)
+}
+
+type PrivateKey struct {
+	key *ecdsa.PrivateKey
+}
+
+// Sign hashes data and signs the digest.
+// The hash matches the curve by default and the signature is asn.1 der by default.
+func (pk PrivateKey) Sign(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	digest, err := digest(pk.key.Curve, data, conf.cryptoHash)
+	if err != nil {
+		return nil, err
+	}
+
+	sign, err := ecdsa.SignASN1(conf.random, pk.key, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.format == formatP1363 {
+		sign, err = toP1363(pk.key.Curve, sign)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sign = conf.encoding.Encode(sign)
+	return sign, nil
+}
+
+// PublicKey returns the public key of the private key.
+func (pk PrivateKey) PublicKey() PublicKey {
+	return PublicKey{key: &pk.key.PublicKey}
+}
+
+// Equal returns true if the private key equals to the key.
+func (pk PrivateKey) Equal(key PrivateKey) bool {
+	return pk.key.Equal(key.key)
+}
+
+type PublicKey struct {
+	key *ecdsa.PublicKey
+}
+
+// Verify hashes data and verifies the signature of the digest.
+func (pk PublicKey) Verify(data []byte, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	sign, err := conf.encoding.Decode(sign)
+	if err != nil {
+		return err
+	}
+
+	if conf.format == formatP1363 {
+		sign, err = fromP1363(pk.key.Curve, sign)
+		if err != nil {
+			return err
+		}
+	}
+
+	digest, err := digest(pk.key.Curve, data, conf.cryptoHash)
+	if err != nil {
+		return err
+	}
+
+	if !ecdsa.VerifyASN1(pk.key, digest, sign) {
+		return errVerifyFailed
+	}
+
+	return nil
+}
+
+// Equal returns true if the public key equals to the key.
+func (pk PublicKey) Equal(key PublicKey) bool {
+	return pk.key.Equal(key.key)
+}
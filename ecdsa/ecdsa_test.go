@@ -0,0 +1,172 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdsa
+
+import (
+	"crypto"
+	"crypto/elliptic"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// go test -v -cover -run=^TestSignVerify$
+func TestSignVerify(t *testing.T) {
+	curves := []elliptic.Curve{elliptic.P256(), elliptic.P384(), elliptic.P521()}
+	signSizes := []int{64, 96, 132}
+
+	formats := map[string]Option{"asn1": WithASN1(), "p1363": WithP1363()}
+	encodings := map[string]Option{"none": func(conf *Config) {}, "hex": WithHex(), "base64": WithBase64()}
+
+	for i, curve := range curves {
+		privateKey, publicKey, err := GenerateKeys(curve)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for formatName, format := range formats {
+			for encodingName, encoding := range encodings {
+				name := fmt.Sprintf("%s_%s_%s", curve.Params().Name, formatName, encodingName)
+
+				t.Run(name, func(t *testing.T) {
+					data := []byte(name)
+
+					sign, err := privateKey.Sign(data, format, encoding)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					if formatName == "p1363" && encodingName == "none" && len(sign) != signSizes[i] {
+						t.Fatalf("got %d != expect %d", len(sign), signSizes[i])
+					}
+
+					err = publicKey.Verify(data, sign, format, encoding)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					err = publicKey.Verify([]byte("wrong"), sign, format, encoding)
+					if err != errVerifyFailed {
+						t.Fatalf("got %+v != expect %+v", err, errVerifyFailed)
+					}
+				})
+			}
+		}
+	}
+}
+
+// go test -v -cover -run=^TestVerifyOpenSSL$
+func TestVerifyOpenSSL(t *testing.T) {
+	data := []byte("hello ecdsa")
+
+	publicKey, err := ReadPublicKey(strings.NewReader(testPublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// openssl dgst -sha256 -sign key.pem
+	sign := []byte("3046022100afca32d63c9e0fba383b492ba4322eb6e4e259383cf5bed0453df246dc2e9080022100b2942741e1f0a7dadde77695e2929c95caccdf85a7db8a70da616687331f2508")
+
+	err = publicKey.Verify(data, sign, WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signP1363 := []byte("afca32d63c9e0fba383b492ba4322eb6e4e259383cf5bed0453df246dc2e9080b2942741e1f0a7dadde77695e2929c95caccdf85a7db8a70da616687331f2508")
+
+	err = publicKey.Verify(data, signP1363, WithHex(), WithP1363())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.Verify(data, signP1363[:len(signP1363)-2], WithHex(), WithP1363())
+	if err != errVerifyFailed {
+		t.Fatalf("got %+v != expect %+v", err, errVerifyFailed)
+	}
+
+	err = publicKey.Verify(data, sign, WithHex(), WithCryptoHash(crypto.SHA512))
+	if err != errVerifyFailed {
+		t.Fatalf("got %+v != expect %+v", err, errVerifyFailed)
+	}
+
+	publicKeyP384, err := ReadPublicKey(strings.NewReader(testPublicKeyP384))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// openssl dgst -sha384 -sign key.pem
+	sign = []byte("3065023100f3b64c17abdbb088b2f8e6cb3d3e6ce8a3dd2ffc32982ca489fbfb8477bc9af1da5bb51360a89c4f5922109b6de576b4023044f6f70ddd45fa699334dcd86b83492c5dae38635324d69bf1d10dc52060266330c7b3744acc47eb7055defd6b60c204")
+
+	err = publicKeyP384.Verify(data, sign, WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestP1363$
+func TestP1363(t *testing.T) {
+	curve := elliptic.P256()
+
+	// The r has leading zeros so it must be padded to the curve size.
+	der, err := hex.DecodeString("300702010102020100")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1363, err := toP1363(curve, der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := strings.Repeat("00", 31) + "01" + strings.Repeat("00", 30) + "0100"
+	if got := hex.EncodeToString(p1363); got != want {
+		t.Fatalf("got %s != expect %s", got, want)
+	}
+
+	derGot, err := fromP1363(curve, p1363)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hex.EncodeToString(derGot) != hex.EncodeToString(der) {
+		t.Fatalf("got %x != expect %x", derGot, der)
+	}
+
+	_, err = toP1363(curve, []byte("invalid"))
+	if err == nil {
+		t.Fatal("to p1363 with invalid der should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestSignWithCryptoHash$
+func TestSignWithCryptoHash(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeys(elliptic.P256())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("TestSignWithCryptoHash")
+
+	sign, err := privateKey.Sign(data, WithCryptoHash(crypto.SHA512))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.Verify(data, sign, WithCryptoHash(crypto.SHA512))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.Verify(data, sign)
+	if err != errVerifyFailed {
+		t.Fatalf("got %+v != expect %+v", err, errVerifyFailed)
+	}
+
+	_, err = privateKey.Sign(data, WithCryptoHash(crypto.Hash(999)))
+	if err == nil {
+		t.Fatal("sign with unavailable crypto hash should be failed")
+	}
+}
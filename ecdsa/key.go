@@ -0,0 +1,139 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"io"
+	"os"
+)
+
+// GenerateKeys generates a private key of curve and a public key.
+// The curve should be one of p-256, p-384 and p-521.
+func GenerateKeys(curve elliptic.Curve, opts ...KeyOption) (PrivateKey, PublicKey, error) {
+	conf := newKeyConfig().Apply(opts...)
+
+	key, err := ecdsa.GenerateKey(curve, conf.random)
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, err
+	}
+
+	privateKey := PrivateKey{key: key}
+	publicKey := PublicKey{key: &(key.PublicKey)}
+	return privateKey, publicKey, nil
+}
+
+// WritePrivateKey writes the private key to the writer.
+func WritePrivateKey(writer io.Writer, privateKey PrivateKey, opts ...KeyOption) error {
+	conf := newKeyConfig().Apply(opts...)
+
+	data, err := conf.encodePrivateKey(privateKey.key)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(data)
+	return err
+}
+
+// WritePublicKey writes the public key to the writer.
+func WritePublicKey(writer io.Writer, publicKey PublicKey, opts ...KeyOption) error {
+	conf := newKeyConfig().Apply(opts...)
+
+	data, err := conf.encodePublicKey(publicKey.key)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(data)
+	return err
+}
+
+// ReadPrivateKey reads the private key from the reader.
+func ReadPrivateKey(reader io.Reader, opts ...KeyOption) (PrivateKey, error) {
+	conf := newKeyConfig().Apply(opts...)
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	key, err := conf.decodePrivateKey(data)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	privateKey := PrivateKey{key: key}
+	return privateKey, nil
+}
+
+// ReadPublicKey reads the public key from the reader.
+func ReadPublicKey(reader io.Reader, opts ...KeyOption) (PublicKey, error) {
+	conf := newKeyConfig().Apply(opts...)
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	key, err := conf.decodePublicKey(data)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	publicKey := PublicKey{key: key}
+	return publicKey, nil
+}
+
+func newFile(file string) (*os.File, error) {
+	flag := os.O_CREATE | os.O_WRONLY | os.O_EXCL
+	perm := os.FileMode(0644)
+	return os.OpenFile(file, flag, perm)
+}
+
+// StorePrivateKey stores the private key to the file.
+func StorePrivateKey(file string, privateKey PrivateKey, opts ...KeyOption) error {
+	f, err := newFile(file)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+	return WritePrivateKey(f, privateKey, opts...)
+}
+
+// StorePublicKey stores the public key to the file.
+func StorePublicKey(file string, publicKey PublicKey, opts ...KeyOption) error {
+	f, err := newFile(file)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+	return WritePublicKey(f, publicKey, opts...)
+}
+
+// LoadPrivateKey loads the private key from the file.
+func LoadPrivateKey(file string, opts ...KeyOption) (PrivateKey, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	defer f.Close()
+	return ReadPrivateKey(f, opts...)
+}
+
+// LoadPublicKey loads the public key from the file.
+func LoadPublicKey(file string, opts ...KeyOption) (PublicKey, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	defer f.Close()
+	return ReadPublicKey(f, opts...)
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdsa
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"io"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+	"github.com/FishGoddess/cryptox/x509"
+)
+
+type KeyConfig struct {
+	random           io.Reader
+	encodePrivateKey func(key *ecdsa.PrivateKey) ([]byte, error)
+	encodePublicKey  func(key *ecdsa.PublicKey) ([]byte, error)
+	decodePrivateKey func(data []byte) (*ecdsa.PrivateKey, error)
+	decodePublicKey  func(data []byte) (*ecdsa.PublicKey, error)
+}
+
+func newKeyConfig() *KeyConfig {
+	conf := &KeyConfig{
+		random:           rand.Reader,
+		encodePrivateKey: x509.EncodePrivateKeyPKCS8[*ecdsa.PrivateKey],
+		encodePublicKey:  x509.EncodePublicKeyPKIX[*ecdsa.PublicKey],
+		decodePrivateKey: x509.DecodePrivateKeyPKCS8[*ecdsa.PrivateKey],
+		decodePublicKey:  x509.DecodePublicKeyPKIX[*ecdsa.PublicKey],
+	}
+
+	return conf
+}
+
+func (kc *KeyConfig) Apply(opts ...KeyOption) *KeyConfig {
+	for _, opt := range opts {
+		opt(kc)
+	}
+
+	return kc
+}
+
+type KeyOption func(conf *KeyConfig)
+
+// WithKeyRandom sets random to key config.
+// The random is ignored by crypto/ecdsa since go 1.26 unless GODEBUG=cryptocustomrand=1 is set.
+func WithKeyRandom(random io.Reader) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.random = random
+	}
+}
+
+// WithKeyEncodePrivate sets encode to key config.
+// Use x509.EncodePrivateKeySEC1 if you want the "EC PRIVATE KEY" pem.
+func WithKeyEncodePrivate(encode func(key *ecdsa.PrivateKey) ([]byte, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.encodePrivateKey = encode
+	}
+}
+
+// WithKeyEncodePublic sets encode to key config.
+func WithKeyEncodePublic(encode func(key *ecdsa.PublicKey) ([]byte, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.encodePublicKey = encode
+	}
+}
+
+// WithKeyDecodePrivate sets decode to key config.
+// Use x509.DecodePrivateKeySEC1 if you want the "EC PRIVATE KEY" pem.
+func WithKeyDecodePrivate(decode func(data []byte) (*ecdsa.PrivateKey, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.decodePrivateKey = decode
+	}
+}
+
+// WithKeyDecodePublic sets decode to key config.
+func WithKeyDecodePublic(decode func(data []byte) (*ecdsa.PublicKey, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.decodePublicKey = decode
+	}
+}
+
+// signFormat is the format of signature.
+type signFormat int
+
+const (
+	formatASN1 signFormat = iota
+	formatP1363
+)
+
+type Config struct {
+	encoding   encoding.Encoding
+	random     io.Reader
+	cryptoHash crypto.Hash
+	format     signFormat
+}
+
+func newConfig() *Config {
+	conf := &Config{
+		encoding:   encoding.None{},
+		random:     rand.Reader,
+		cryptoHash: 0,
+		format:     formatASN1,
+	}
+
+	return conf
+}
+
+func (c *Config) Apply(opts ...Option) *Config {
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+type Option func(conf *Config)
+
+// WithHex sets hex encoding to config.
+func WithHex() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Hex{}
+	}
+}
+
+// WithBase64 sets base64 encoding to config.
+func WithBase64() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Base64{}
+	}
+}
+
+// WithRandom sets random to config.
+// The random of signing is ignored since go 1.26 unless GODEBUG=cryptocustomrand=1 is set.
+func WithRandom(random io.Reader) Option {
+	return func(conf *Config) {
+		conf.random = random
+	}
+}
+
+// WithCryptoHash sets crypto hash to config.
+// The hash matches the curve by default, that is sha256 for p-256, sha384 for p-384 and sha512 for p-521.
+func WithCryptoHash(hash crypto.Hash) Option {
+	return func(conf *Config) {
+		conf.cryptoHash = hash
+	}
+}
+
+// WithASN1 sets asn.1 der format of signature to config.
+// It's the format used by openssl and x509 and it's the default format.
+func WithASN1() Option {
+	return func(conf *Config) {
+		conf.format = formatASN1
+	}
+}
+
+// WithP1363 sets ieee p1363 format of signature to config.
+// The signature is r||s in fixed width which is used by jws and webcrypto.
+func WithP1363() Option {
+	return func(conf *Config) {
+		conf.format = formatP1363
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ecdsa
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+	"github.com/FishGoddess/cryptox/x509"
+)
+
+// go test -v -cover -run=^TestKeyConfig$
+func TestKeyConfig(t *testing.T) {
+	opts := []KeyOption{
+		WithKeyRandom(rand.Reader),
+		WithKeyEncodePrivate(x509.EncodePrivateKeySEC1),
+		WithKeyEncodePublic(x509.EncodePublicKeyPKIX),
+		WithKeyDecodePrivate(x509.DecodePrivateKeySEC1),
+		WithKeyDecodePublic(x509.DecodePublicKeyPKIX),
+	}
+
+	conf := newKeyConfig().Apply(opts...)
+
+	got := fmt.Sprintf("%p", conf.random)
+	expect := fmt.Sprintf("%p", rand.Reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.encodePrivateKey)
+	expect = fmt.Sprintf("%p", x509.EncodePrivateKeySEC1)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.encodePublicKey)
+	expect = fmt.Sprintf("%p", x509.EncodePublicKeyPKIX[*ecdsa.PublicKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.decodePrivateKey)
+	expect = fmt.Sprintf("%p", x509.DecodePrivateKeySEC1)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.decodePublicKey)
+	expect = fmt.Sprintf("%p", x509.DecodePublicKeyPKIX[*ecdsa.PublicKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestConfig$
+func TestConfig(t *testing.T) {
+	opts := []Option{
+		WithHex(),
+		WithRandom(rand.Reader),
+		WithCryptoHash(crypto.SHA384),
+		WithP1363(),
+	}
+
+	conf := newConfig().Apply(opts...)
+
+	got := fmt.Sprintf("%T", conf.encoding)
+	expect := fmt.Sprintf("%T", encoding.Hex{})
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	conf.Apply(WithBase64())
+
+	got = fmt.Sprintf("%T", conf.encoding)
+	expect = fmt.Sprintf("%T", encoding.Base64{})
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.random)
+	expect = fmt.Sprintf("%p", rand.Reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if conf.cryptoHash != crypto.SHA384 {
+		t.Fatalf("got %d != expect %d", conf.cryptoHash, crypto.SHA384)
+	}
+
+	if conf.format != formatP1363 {
+		t.Fatalf("got %d != expect %d", conf.format, formatP1363)
+	}
+
+	conf.Apply(WithASN1())
+
+	if conf.format != formatASN1 {
+		t.Fatalf("got %d != expect %d", conf.format, formatASN1)
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ed25519
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"slices"
+
+	"filippo.io/edwards25519"
+)
+
+// domainPrefix is the prefix of dom2 in ed25519ctx and ed25519ph.
+const domainPrefix = "SigEd25519 no Ed25519 collisions"
+
+var errVerifyFailed = errors.New("cryptox/ed25519: verify failed")
+
+// scalarOne is the scalar 1.
+var scalarOne, _ = edwards25519.NewScalar().SetCanonicalBytes([]byte{
+	1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+})
+
+type batchEntry struct {
+	publicKey PublicKey
+	data      []byte
+	sign      []byte
+}
+
+// batchItem is the decoded entry which can be verified in batch.
+type batchItem struct {
+	index int
+	a     *edwards25519.Point
+	r     *edwards25519.Point
+	s     *edwards25519.Scalar
+	k     *edwards25519.Scalar
+}
+
+// BatchVerifier verifies lots of signs in batch which is much faster than verifying them one by one.
+//
+// It follows the ZIP-215 rules so the results are always the same as PublicKey.VerifyZIP215:
+//
+//   - The public key and r can be non-canonical encodings and can have small order components.
+//   - The s must be canonical which means s < l.
+//   - The cofactored equation [8][s]B = [8]R + [8][k]A is used.
+//
+// Note that PublicKey.Verify uses the cofactorless equation [s]B = R + [k]A of the stdlib and rejects non-canonical r.
+// So the results may differ from it in signs crafted with small order components or non-canonical encodings,
+// which honest signers never produce.
+type BatchVerifier struct {
+	entries []batchEntry
+}
+
+// NewBatchVerifier returns a new batch verifier.
+func NewBatchVerifier() *BatchVerifier {
+	return new(BatchVerifier)
+}
+
+// Add adds the public key, data and sign to the batch verifier.
+func (bv *BatchVerifier) Add(publicKey PublicKey, data []byte, sign []byte) {
+	entry := batchEntry{publicKey: publicKey, data: data, sign: sign}
+	bv.entries = append(bv.entries, entry)
+}
+
+// Len returns the count of entries in the batch verifier.
+func (bv *BatchVerifier) Len() int {
+	return len(bv.entries)
+}
+
+// Verify verifies all entries in batch and returns the indexes of failed entries in adding order.
+// The entries are verified with randomized batch verification first and bisected to find the failed ones if it fails.
+// The options are applied to all entries the same as PublicKey.Verify, so the data will be hashed internally in ed25519ph.
+func (bv *BatchVerifier) Verify(opts ...Option) ([]int, error) {
+	conf := newConfig().Apply(opts...)
+
+	prefix, err := domain(conf.cryptoHash, conf.context)
+	if err != nil {
+		return nil, err
+	}
+
+	var failed []int
+
+	items := make([]batchItem, 0, len(bv.entries))
+	for i, entry := range bv.entries {
+		item, ok := newBatchItem(i, entry, prefix, conf)
+		if !ok {
+			failed = append(failed, i)
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	failedItems, err := verifyBisect(items)
+	if err != nil {
+		return nil, err
+	}
+
+	failed = append(failed, failedItems...)
+	slices.Sort(failed)
+	return failed, nil
+}
+
+// domain returns the dom2 prefix of ed25519ctx and ed25519ph.
+// The ed25519 doesn't have any prefix.
+func domain(cryptoHash crypto.Hash, context string) ([]byte, error) {
+	if len(context) > 255 {
+		return nil, fmt.Errorf("cryptox/ed25519: bad context length %d", len(context))
+	}
+
+	var flag byte
+	switch cryptoHash {
+	case crypto.Hash(0):
+		if context == "" {
+			return nil, nil
+		}
+	case crypto.SHA512:
+		flag = 1
+	default:
+		return nil, fmt.Errorf("cryptox/ed25519: expected crypto hash 0 or sha512 but got %s", cryptoHash)
+	}
+
+	prefix := make([]byte, 0, len(domainPrefix)+2+len(context))
+	prefix = append(prefix, domainPrefix...)
+	prefix = append(prefix, flag, byte(len(context)))
+	prefix = append(prefix, context...)
+	return prefix, nil
+}
+
+// newBatchItem decodes the entry to a batch item.
+// It returns false if the entry is invalid so it can't pass the verification.
+func newBatchItem(index int, entry batchEntry, prefix []byte, conf *Config) (batchItem, bool) {
+	sign, err := conf.encoding.Decode(entry.sign)
+	if err != nil || len(sign) != ed25519.SignatureSize {
+		return batchItem{}, false
+	}
+
+	if len(entry.publicKey.key) != ed25519.PublicKeySize {
+		return batchItem{}, false
+	}
+
+	a, err := new(edwards25519.Point).SetBytes(entry.publicKey.key)
+	if err != nil {
+		return batchItem{}, false
+	}
+
+	r, err := new(edwards25519.Point).SetBytes(sign[:32])
+	if err != nil {
+		return batchItem{}, false
+	}
+
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(sign[32:])
+	if err != nil {
+		return batchItem{}, false
+	}
+
+	// The k is computed with the original encodings instead of the decoded points.
+	hash := sha512.New()
+	hash.Write(prefix)
+	hash.Write(sign[:32])
+	hash.Write(entry.publicKey.key)
+	hash.Write(digest(entry.data, conf.cryptoHash))
+
+	k, err := edwards25519.NewScalar().SetUniformBytes(hash.Sum(nil))
+	if err != nil {
+		return batchItem{}, false
+	}
+
+	item := batchItem{index: index, a: a, r: r, s: s, k: k}
+	return item, true
+}
+
+// VerifyZIP215 verifies data with sign in the ZIP-215 rules which are the same as BatchVerifier.
+// The options are the same as PublicKey.Verify, so the data will be hashed internally in ed25519ph.
+func (pk PublicKey) VerifyZIP215(data []byte, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	prefix, err := domain(conf.cryptoHash, conf.context)
+	if err != nil {
+		return err
+	}
+
+	entry := batchEntry{publicKey: pk, data: data, sign: sign}
+
+	item, ok := newBatchItem(0, entry, prefix, conf)
+	if !ok {
+		return errVerifyFailed
+	}
+
+	// Check [8]([s]B - [k]A - R) == 0.
+	minusA := new(edwards25519.Point).Negate(item.a)
+	check := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(item.k, minusA, item.s)
+	check.Subtract(check, item.r)
+	check.MultByCofactor(check)
+
+	if check.Equal(edwards25519.NewIdentityPoint()) != 1 {
+		return errVerifyFailed
+	}
+
+	return nil
+}
+
+// randomScalar returns a random 128 bits scalar.
+func randomScalar() (*edwards25519.Scalar, error) {
+	var buffer [32]byte
+	if _, err := rand.Read(buffer[:16]); err != nil {
+		return nil, err
+	}
+
+	return edwards25519.NewScalar().SetCanonicalBytes(buffer[:])
+}
+
+// verifyBatch checks [8](-[sum(z * s)]B + sum([z]R) + sum([z * k]A)) == 0 with random z.
+// The z is 1 if there is only one item so it's the same as the single verification.
+func verifyBatch(items []batchItem) (bool, error) {
+	if len(items) <= 0 {
+		return true, nil
+	}
+
+	scalars := make([]*edwards25519.Scalar, 0, 1+2*len(items))
+	points := make([]*edwards25519.Point, 0, 1+2*len(items))
+
+	bScalar := edwards25519.NewScalar()
+	scalars = append(scalars, bScalar)
+	points = append(points, edwards25519.NewGeneratorPoint())
+
+	for _, item := range items {
+		z, err := randomScalar()
+		if err != nil {
+			return false, err
+		}
+
+		if len(items) == 1 {
+			z.Set(scalarOne)
+		}
+
+		bScalar.MultiplyAdd(z, item.s, bScalar)
+		scalars = append(scalars, z, edwards25519.NewScalar().Multiply(z, item.k))
+		points = append(points, item.r, item.a)
+	}
+
+	bScalar.Negate(bScalar)
+
+	check := new(edwards25519.Point).VarTimeMultiScalarMult(scalars, points)
+	check.MultByCofactor(check)
+
+	ok := check.Equal(edwards25519.NewIdentityPoint()) == 1
+	return ok, nil
+}
+
+// verifyBisect verifies items in batch and bisects them to find the failed ones if it fails.
+func verifyBisect(items []batchItem) ([]int, error) {
+	ok, err := verifyBatch(items)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		return nil, nil
+	}
+
+	if len(items) == 1 {
+		return []int{items[0].index}, nil
+	}
+
+	half := len(items) / 2
+
+	failed, err := verifyBisect(items[:half])
+	if err != nil {
+		return nil, err
+	}
+
+	rightFailed, err := verifyBisect(items[half:])
+	if err != nil {
+		return nil, err
+	}
+
+	failed = append(failed, rightFailed...)
+	return failed, nil
+}
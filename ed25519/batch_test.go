@@ -0,0 +1,296 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ed25519
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"fmt"
+	"slices"
+	"testing"
+
+	"filippo.io/edwards25519"
+)
+
+// go test -v -cover -run=^TestBatchVerifier$
+func TestBatchVerifier(t *testing.T) {
+	optsList := [][]Option{
+		{},
+		{WithHex()},
+		{WithBase64(), WithContext("context")},
+		{WithCryptoHash(crypto.SHA512)},
+		{WithCryptoHash(crypto.SHA512), WithContext("context")},
+	}
+
+	for _, opts := range optsList {
+		verifier := NewBatchVerifier()
+
+		for i := 0; i < 64; i++ {
+			privateKey, publicKey, err := GenerateKeys()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data := []byte(fmt.Sprintf("data %d", i))
+
+			sign := privateKey.Sign(data, opts...)
+			verifier.Add(publicKey, data, sign)
+
+			err = publicKey.VerifyZIP215(data, sign, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if verifier.Len() != 64 {
+			t.Fatalf("got %d != expect %d", verifier.Len(), 64)
+		}
+
+		failed, err := verifier.Verify(opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(failed) != 0 {
+			t.Fatalf("got %+v != expect %+v", failed, []int{})
+		}
+	}
+
+	failed, err := NewBatchVerifier().Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(failed) != 0 {
+		t.Fatalf("got %+v != expect %+v", failed, []int{})
+	}
+}
+
+// go test -v -cover -run=^TestBatchVerifierFailed$
+func TestBatchVerifierFailed(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, otherPublicKey, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	order := []byte{
+		0xed, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58, 0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+	}
+
+	var entries []batchEntry
+	for i := 0; i < 100; i++ {
+		data := []byte(fmt.Sprintf("data %d", i))
+
+		sign := privateKey.Sign(data)
+		entries = append(entries, batchEntry{publicKey: publicKey, data: data, sign: sign})
+	}
+
+	// Wrong data.
+	entries[3].data = []byte("wrong data")
+
+	// Wrong sign.
+	entries[17].sign[5] ^= 0x01
+
+	// Wrong public key.
+	entries[40].publicKey = otherPublicKey
+
+	// Wrong sign size.
+	entries[41].sign = entries[41].sign[:ed25519.SignatureSize-1]
+
+	// Wrong public key size.
+	entries[42].publicKey = PublicKey{key: publicKey.key[:ed25519.PublicKeySize-1]}
+
+	// Non-canonical s which is s + l.
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(entries[77].sign[32:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nonCanonical := addLittleEndian(s.Bytes(), order)
+	entries[77].sign = append(entries[77].sign[:32:32], nonCanonical...)
+
+	// Invalid point of r.
+	entries[98].sign[0] = 0x02
+	clear(entries[98].sign[1:32])
+
+	verifier := NewBatchVerifier()
+	for _, entry := range entries {
+		verifier.Add(entry.publicKey, entry.data, entry.sign)
+	}
+
+	failed, err := verifier.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []int{3, 17, 40, 41, 42, 77, 98}
+	if !slices.Equal(failed, expect) {
+		t.Fatalf("got %+v != expect %+v", failed, expect)
+	}
+
+	// The results of batch verifying and single verifying must be the same.
+	for i, entry := range entries {
+		single := entry.publicKey.VerifyZIP215(entry.data, entry.sign) == nil
+		batch := !slices.Contains(failed, i)
+
+		if single != batch {
+			t.Fatalf("%d: single %+v != batch %+v", i, single, batch)
+		}
+	}
+}
+
+// addLittleEndian adds a and b in little endian.
+// The sum won't overflow 32 bytes because both of them are less than 2^253.
+func addLittleEndian(a []byte, b []byte) []byte {
+	sum := make([]byte, 32)
+
+	carry := 0
+	for i := range sum {
+		v := int(a[i]) + int(b[i]) + carry
+		sum[i] = byte(v)
+		carry = v >> 8
+	}
+
+	return sum
+}
+
+// go test -v -cover -run=^TestBatchVerifierZIP215$
+func TestBatchVerifierZIP215(t *testing.T) {
+	// A point of order 8.
+	torsion, err := new(edwards25519.Point).SetBytes([]byte{
+		0xc7, 0x17, 0x6a, 0x70, 0x3d, 0x4d, 0xd8, 0x4f, 0xba, 0x3c, 0x0b, 0x76, 0x0d, 0x10, 0x67, 0x0f,
+		0x2a, 0x20, 0x53, 0xfa, 0x2c, 0x39, 0xcc, 0xc6, 0x4e, 0xc7, 0xfd, 0x77, 0x92, 0xac, 0x03, 0x7a,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seed := []byte("12345678876543211234567887654321")
+	expanded := sha512.Sum512(seed)
+
+	a, err := edwards25519.NewScalar().SetBytesWithClamping(expanded[:32])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The public key has a small order component so the cofactorless and cofactored equations differ.
+	mixedPoint := new(edwards25519.Point).ScalarBaseMult(a)
+	mixedPoint.Add(mixedPoint, torsion)
+	mixedKey := mixedPoint.Bytes()
+
+	var entries []batchEntry
+	for i := 0; i < 32; i++ {
+		data := []byte(fmt.Sprintf("data %d", i))
+
+		nonce := sha512.Sum512(append(expanded[32:], data...))
+
+		r, err := edwards25519.NewScalar().SetUniformBytes(nonce[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rBytes := new(edwards25519.Point).ScalarBaseMult(r).Bytes()
+
+		hash := sha512.New()
+		hash.Write(rBytes)
+		hash.Write(mixedKey)
+		hash.Write(data)
+
+		k, err := edwards25519.NewScalar().SetUniformBytes(hash.Sum(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := edwards25519.NewScalar().MultiplyAdd(k, a, r)
+		sign := append(rBytes, s.Bytes()...)
+
+		entries = append(entries, batchEntry{publicKey: PublicKey{key: mixedKey}, data: data, sign: sign})
+	}
+
+	// The identity public key with the identity r and zero s is valid for any data in ZIP-215.
+	identity := edwards25519.NewIdentityPoint().Bytes()
+	identitySign := append(identity, make([]byte, 32)...)
+	entries = append(entries, batchEntry{publicKey: PublicKey{key: identity}, data: []byte("any data"), sign: identitySign})
+
+	// The non-canonical encoding of the identity point which is p + 1.
+	nonCanonical := []byte{
+		0xee, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+	}
+
+	nonCanonicalSign := append(nonCanonical, make([]byte, 32)...)
+	entries = append(entries, batchEntry{publicKey: PublicKey{key: nonCanonical}, data: []byte("any data"), sign: nonCanonicalSign})
+
+	for i, entry := range entries {
+		if err := entry.publicKey.VerifyZIP215(entry.data, entry.sign); err != nil {
+			t.Fatalf("%d: %+v", i, err)
+		}
+	}
+
+	// The stdlib rejects the non-canonical r which is different from ZIP-215.
+	last := entries[len(entries)-1]
+	if err := last.publicKey.Verify(last.data, last.sign); err == nil {
+		t.Fatal("verify non-canonical r should be failed")
+	}
+
+	// Wrong data.
+	entries[5].data = []byte("wrong data")
+
+	// Wrong sign.
+	entries[20].sign = append(entries[20].sign[:32:32], make([]byte, 32)...)
+
+	verifier := NewBatchVerifier()
+	for _, entry := range entries {
+		verifier.Add(entry.publicKey, entry.data, entry.sign)
+	}
+
+	failed, err := verifier.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The results of batch verifying and single verifying must be the same.
+	var expect []int
+	for i, entry := range entries {
+		if entry.publicKey.VerifyZIP215(entry.data, entry.sign) != nil {
+			expect = append(expect, i)
+		}
+	}
+
+	if !slices.Equal(failed, expect) {
+		t.Fatalf("got %+v != expect %+v", failed, expect)
+	}
+
+	if !slices.Equal(expect, []int{5, 20}) {
+		t.Fatalf("got %+v != expect %+v", expect, []int{5, 20})
+	}
+}
+
+// go test -v -cover -run=^TestBatchVerifierInvalidOptions$
+func TestBatchVerifierInvalidOptions(t *testing.T) {
+	verifier := NewBatchVerifier()
+
+	_, err := verifier.Verify(WithCryptoHash(crypto.SHA256))
+	if err == nil {
+		t.Fatal("verify with sha256 should be failed")
+	}
+
+	_, err = verifier.Verify(WithContext(string(make([]byte, 256))))
+	if err == nil {
+		t.Fatal("verify with too long context should be failed")
+	}
+
+	err = PublicKey{}.VerifyZIP215(nil, nil, WithCryptoHash(crypto.SHA256))
+	if err == nil {
+		t.Fatal("verify zip215 with sha256 should be failed")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ed25519
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"errors"
+
+	"filippo.io/edwards25519"
+	"github.com/FishGoddess/cryptox/x25519"
+)
+
+var (
+	errInvalidPoint    = errors.New("cryptox/ed25519: invalid point")
+	errSmallOrderPoint = errors.New("cryptox/ed25519: small order point")
+)
+
+// orderMinusOne is l - 1 in little endian where l is the order of the prime subgroup.
+var orderMinusOne = []byte{
+	0xec, 0xd3, 0xf5, 0x5c, 0x1a, 0x63, 0x12, 0x58, 0xd6, 0x9c, 0xf7, 0xa2, 0xde, 0xf9, 0xde, 0x14,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+}
+
+// ToX25519 converts the private key to a x25519 private key.
+// The x25519 private key is the clamped first half of sha512(seed), which is the same as
+// crypto_sign_ed25519_sk_to_curve25519 in libsodium.
+func (pk PrivateKey) ToX25519() (x25519.PrivateKey, error) {
+	digest := sha512.Sum512(pk.key.Seed())
+
+	scalar := digest[:x25519.KeySize]
+	scalar[0] &= 248
+	scalar[31] &= 127
+	scalar[31] |= 64
+
+	return x25519.NewPrivateKey(scalar)
+}
+
+// ToX25519 converts the public key to a x25519 public key.
+// The edwards point (x, y) is mapped to the montgomery point u = (1 + y) / (1 - y), which is the same as
+// crypto_sign_ed25519_pk_to_curve25519 in libsodium.
+// It returns an error if the point is invalid, has a small order or isn't in the prime order subgroup.
+func (pk PublicKey) ToX25519() (x25519.PublicKey, error) {
+	if len(pk.key) != ed25519.PublicKeySize {
+		return x25519.PublicKey{}, errInvalidPoint
+	}
+
+	point, err := new(edwards25519.Point).SetBytes(pk.key)
+	if err != nil {
+		return x25519.PublicKey{}, errInvalidPoint
+	}
+
+	identity := edwards25519.NewIdentityPoint()
+
+	cofactorPoint := new(edwards25519.Point).MultByCofactor(point)
+	if cofactorPoint.Equal(identity) == 1 {
+		return x25519.PublicKey{}, errSmallOrderPoint
+	}
+
+	// The point is in the prime order subgroup if [l]P = [l - 1]P + P is the identity.
+	scalar, err := edwards25519.NewScalar().SetCanonicalBytes(orderMinusOne)
+	if err != nil {
+		return x25519.PublicKey{}, err
+	}
+
+	orderPoint := new(edwards25519.Point).ScalarMult(scalar, point)
+	orderPoint.Add(orderPoint, point)
+
+	if orderPoint.Equal(identity) != 1 {
+		return x25519.PublicKey{}, errSmallOrderPoint
+	}
+
+	return x25519.NewPublicKey(point.BytesMontgomery())
+}
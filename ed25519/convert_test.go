@@ -0,0 +1,147 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ed25519
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"slices"
+	"testing"
+
+	"filippo.io/edwards25519"
+)
+
+// go test -v -cover -run=^TestToX25519$
+func TestToX25519(t *testing.T) {
+	// The vector is from test/default/ed25519_convert.exp in libsodium.
+	seed, _ := hex.DecodeString("421151a459faeade3d247115f94aedae42318124095afabe4d1451a559faedee")
+	expectPrivateKey := "8052030376d47112be7f73ed7a019293dd12ad910b654455798b4667d73de166"
+	expectPublicKey := "f1814f0e8ff1043d8a44d25babff3cedcae6c22c3edaa48f857ae70de2baae50"
+
+	privateKey, publicKey, err := GenerateKeys(WithKeySeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xPrivateKey, err := privateKey.ToX25519()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := hex.EncodeToString(xPrivateKey.Bytes())
+	if got != expectPrivateKey {
+		t.Fatalf("got %s != expect %s", got, expectPrivateKey)
+	}
+
+	xPublicKey, err := publicKey.ToX25519()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got = hex.EncodeToString(xPublicKey.Bytes())
+	if got != expectPublicKey {
+		t.Fatalf("got %s != expect %s", got, expectPublicKey)
+	}
+
+	if !xPrivateKey.PublicKey().Equal(xPublicKey) {
+		t.Fatalf("got %x != expect %x", xPrivateKey.PublicKey().Bytes(), xPublicKey.Bytes())
+	}
+}
+
+// go test -v -cover -run=^TestToX25519SharedSecret$
+func TestToX25519SharedSecret(t *testing.T) {
+	privateKey1, publicKey1, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey2, publicKey2, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xPrivateKey1, err := privateKey1.ToX25519()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xPublicKey1, err := publicKey1.ToX25519()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xPrivateKey2, err := privateKey2.ToX25519()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xPublicKey2, err := publicKey2.ToX25519()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret1, err := xPrivateKey1.SharedSecret(xPublicKey2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret2, err := xPrivateKey2.SharedSecret(xPublicKey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(secret1, secret2) {
+		t.Fatalf("got %x != expect %x", secret1, secret2)
+	}
+}
+
+// go test -v -cover -run=^TestToX25519Invalid$
+func TestToX25519Invalid(t *testing.T) {
+	_, publicKey, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	point, err := new(edwards25519.Point).SetBytes(publicKey.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The point (0, -1) has order 2 so adding it makes a mixed order point.
+	torsion, err := new(edwards25519.Point).SetBytes([]byte{
+		0xec, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mixedPoint := new(edwards25519.Point).Add(point, torsion)
+
+	type testCase struct {
+		key    ed25519.PublicKey
+		expect error
+	}
+
+	testCases := []testCase{
+		{key: ed25519.PublicKey(edwards25519.NewIdentityPoint().Bytes()), expect: errSmallOrderPoint},
+		{key: ed25519.PublicKey(torsion.Bytes()), expect: errSmallOrderPoint},
+		{key: ed25519.PublicKey(mixedPoint.Bytes()), expect: errSmallOrderPoint},
+		{key: make(ed25519.PublicKey, ed25519.PublicKeySize-1), expect: errInvalidPoint},
+		{key: ed25519.PublicKey{
+			0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		}, expect: errInvalidPoint},
+	}
+
+	for i, testCase := range testCases {
+		publicKey := PublicKey{key: testCase.key}
+
+		_, err := publicKey.ToX25519()
+		if err != testCase.expect {
+			t.Fatalf("%d: got %+v != expect %+v", i, err, testCase.expect)
+		}
+	}
+}
@@ -4,25 +4,82 @@
 
 package ed25519
 
-import "crypto/ed25519"
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"io"
+)
+
+// digest hashes data if the crypto hash is sha512 which means using ed25519ph.
+// The stdlib requires the digest instead of the data in ed25519ph.
+func digest(data []byte, cryptoHash crypto.Hash) []byte {
+	if cryptoHash != crypto.SHA512 {
+		return data
+	}
+
+	sum := sha512.Sum512(data)
+	return sum[:]
+}
+
+// digestReader hashes the data read from reader in sha512.
+func digestReader(reader io.Reader) ([]byte, error) {
+	hash := sha512.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return nil, err
+	}
+
+	return hash.Sum(nil), nil
+}
 
 type PrivateKey struct {
 	key ed25519.PrivateKey
 }
 
-// Sign signs data.
+// Sign signs data in the variant of options.
+// It uses ed25519ph if crypto hash is sha512 and the data will be hashed internally.
+// It uses ed25519ctx if context isn't empty and crypto hash isn't set.
+// It panics if the crypto hash isn't sha512 or the context is longer than 255 bytes.
 func (pk PrivateKey) Sign(data []byte, opts ...Option) []byte {
 	conf := newConfig().Apply(opts...)
-	sign := ed25519.Sign(pk.key, data)
+	signOpts := &ed25519.Options{Hash: conf.cryptoHash, Context: conf.context}
+
+	sign, err := pk.key.Sign(nil, digest(data, conf.cryptoHash), signOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	sign = conf.encoding.Encode(sign)
 	return sign
 }
 
+// SignReader signs the data read from reader in ed25519ph.
+// The data is hashed in sha512 in stream so it's suitable for large files.
+func (pk PrivateKey) SignReader(reader io.Reader, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+	signOpts := &ed25519.Options{Hash: crypto.SHA512, Context: conf.context}
+
+	hashed, err := digestReader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	sign, err := pk.key.Sign(nil, hashed, signOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	sign = conf.encoding.Encode(sign)
+	return sign, nil
+}
+
 type PublicKey struct {
 	key ed25519.PublicKey
 }
 
-// Verify verifies data with sign.
+// Verify verifies data with sign in the variant of options.
+// It uses ed25519ph if crypto hash is sha512 and the data will be hashed internally.
+// It uses ed25519ctx if context isn't empty and crypto hash isn't set.
 func (pk PublicKey) Verify(data []byte, sign []byte, opts ...Option) error {
 	conf := newConfig().Apply(opts...)
 	verifyOpts := &ed25519.Options{Hash: conf.cryptoHash, Context: conf.context}
@@ -32,5 +89,24 @@ func (pk PublicKey) Verify(data []byte, sign []byte, opts ...Option) error {
 		return err
 	}
 
-	return ed25519.VerifyWithOptions(pk.key, data, sign, verifyOpts)
+	return ed25519.VerifyWithOptions(pk.key, digest(data, conf.cryptoHash), sign, verifyOpts)
+}
+
+// VerifyReader verifies the data read from reader with sign in ed25519ph.
+// The data is hashed in sha512 in stream so it's suitable for large files.
+func (pk PublicKey) VerifyReader(reader io.Reader, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+	verifyOpts := &ed25519.Options{Hash: crypto.SHA512, Context: conf.context}
+
+	sign, err := conf.encoding.Decode(sign)
+	if err != nil {
+		return err
+	}
+
+	hashed, err := digestReader(reader)
+	if err != nil {
+		return err
+	}
+
+	return ed25519.VerifyWithOptions(pk.key, hashed, sign, verifyOpts)
 }
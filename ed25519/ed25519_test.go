@@ -6,8 +6,13 @@ package ed25519
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
 	"slices"
 	"testing"
+	"testing/iotest"
 )
 
 type signTestCase struct {
@@ -117,3 +122,215 @@ func TestSignVerify(t *testing.T) {
 		}
 	}
 }
+
+// go test -v -cover -run=^TestRFC8032$
+func TestRFC8032(t *testing.T) {
+	type testCase struct {
+		seed       string
+		publicKey  string
+		data       string
+		cryptoHash crypto.Hash
+		context    string
+		sign       string
+	}
+
+	testCases := []testCase{
+		{
+			// Section 7.1 test 1 of ed25519.
+			seed:       "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+			publicKey:  "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+			data:       "",
+			cryptoHash: crypto.Hash(0),
+			context:    "",
+			sign:       "e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+		},
+		{
+			// Section 7.2 foo of ed25519ctx.
+			seed:       "0305334e381af78f141cb666f6199f57bc3495335a256a95bd2a55bf546663f6",
+			publicKey:  "dfc9425e4f968f7f0c29f0259cf5f9aed6851c2bb4ad8bfb860cfee0ab248292",
+			data:       "f726936d19c800494e3fdaff20b276a8",
+			cryptoHash: crypto.Hash(0),
+			context:    "foo",
+			sign:       "55a4cc2f70a54e04288c5f4cd1e45a7bb520b36292911876cada7323198dd87a8b36950b95130022907a7fb7c4e9b2d5f6cca685a587b4b21f4b888e4e7edb0d",
+		},
+		{
+			// Section 7.3 test abc of ed25519ph.
+			seed:       "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42",
+			publicKey:  "ec172b93ad5e563bf4932c70e1245034c35467ef2efd4d64ebf819683467e2bf",
+			data:       "616263",
+			cryptoHash: crypto.SHA512,
+			context:    "",
+			sign:       "98a70222f0b8121aa9d30f813d683f809e462b469c7ff87639499bb94e6dae4131f85042463c2a355a2003d062adf5aaa10b8c61e636062aaad11c2a26083406",
+		},
+	}
+
+	for i, testCase := range testCases {
+		seed, _ := hex.DecodeString(testCase.seed)
+		data, _ := hex.DecodeString(testCase.data)
+
+		privateKey, publicKey, err := GenerateKeys(WithKeySeed(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := hex.EncodeToString(publicKey.key)
+		if got != testCase.publicKey {
+			t.Fatalf("%d: got %s != expect %s", i, got, testCase.publicKey)
+		}
+
+		opts := []Option{WithHex(), WithCryptoHash(testCase.cryptoHash), WithContext(testCase.context)}
+
+		sign := privateKey.Sign(data, opts...)
+		if string(sign) != testCase.sign {
+			t.Fatalf("%d: got %s != expect %s", i, sign, testCase.sign)
+		}
+
+		err = publicKey.Verify(data, sign, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The sign can't be verified in other variants.
+		err = publicKey.Verify(data, sign, WithHex(), WithContext("bar"))
+		if err == nil {
+			t.Fatalf("%d: verify with wrong context should be failed", i)
+		}
+
+		if testCase.cryptoHash != crypto.SHA512 {
+			continue
+		}
+
+		// The data is hashed internally in ed25519ph so the digest can't be verified.
+		sum := sha512.Sum512(data)
+
+		err = publicKey.Verify(sum[:], sign, opts...)
+		if err == nil {
+			t.Fatalf("%d: verify with digest instead of data should be failed", i)
+		}
+
+		sign, err = privateKey.SignReader(bytes.NewReader(data), WithHex(), WithContext(testCase.context))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(sign) != testCase.sign {
+			t.Fatalf("%d: got %s != expect %s", i, sign, testCase.sign)
+		}
+
+		err = publicKey.VerifyReader(bytes.NewReader(data), sign, WithHex(), WithContext(testCase.context))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSignVerifyReader$
+func TestSignVerifyReader(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	data := bytes.Repeat([]byte("你好，世界"), 10000)
+	context := "file"
+
+	sign, err := privateKey.SignReader(bytes.NewReader(data), WithBase64(), WithContext(context))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.VerifyReader(bytes.NewReader(data), sign, WithBase64(), WithContext(context))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.Verify(data, sign, WithBase64(), WithCryptoHash(crypto.SHA512), WithContext(context))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.VerifyReader(bytes.NewReader(data[1:]), sign, WithBase64(), WithContext(context))
+	if err == nil {
+		t.Fatal("verify reader with wrong data should be failed")
+	}
+
+	_, err = privateKey.SignReader(iotest.ErrReader(io.ErrUnexpectedEOF))
+	if err != io.ErrUnexpectedEOF {
+		t.Fatalf("got %+v != expect %+v", err, io.ErrUnexpectedEOF)
+	}
+
+	err = publicKey.VerifyReader(iotest.ErrReader(io.ErrUnexpectedEOF), sign, WithBase64())
+	if err != io.ErrUnexpectedEOF {
+		t.Fatalf("got %+v != expect %+v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+// go test -v -cover -run=^TestSignVerifyOptions$
+func TestSignVerifyOptions(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	data := []byte("你好，世界")
+
+	// The variants are ed25519, ed25519ctx, ed25519ph and ed25519ph with context.
+	variants := [][]Option{
+		{},
+		{WithContext("context")},
+		{WithCryptoHash(crypto.SHA512)},
+		{WithCryptoHash(crypto.SHA512), WithContext("context")},
+	}
+
+	encodings := []Option{WithHex(), WithBase64()}
+
+	for i, variant := range variants {
+		for _, encoding := range encodings {
+			opts := append([]Option{encoding}, variant...)
+			sign := privateKey.Sign(data, opts...)
+
+			err := publicKey.Verify(data, sign, opts...)
+			if err != nil {
+				t.Fatalf("%d: %+v", i, err)
+			}
+
+			err = publicKey.Verify([]byte("你好，世界！"), sign, opts...)
+			if err == nil {
+				t.Fatalf("%d: verify with wrong data should be failed", i)
+			}
+
+			// The signs of different variants can't be verified by each other.
+			for j, otherVariant := range variants {
+				if j == i {
+					continue
+				}
+
+				otherOpts := append([]Option{encoding}, otherVariant...)
+
+				err = publicKey.Verify(data, sign, otherOpts...)
+				if err == nil {
+					t.Fatalf("%d: verify in variant %d should be failed", i, j)
+				}
+			}
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSignInvalidOptions$
+func TestSignInvalidOptions(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	data := []byte("你好，世界")
+
+	optsList := [][]Option{
+		{WithCryptoHash(crypto.SHA256)},
+		{WithContext(string(make([]byte, 256)))},
+	}
+
+	for i, opts := range optsList {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Fatalf("%d: sign with invalid options should panic", i)
+				}
+			}()
+
+			privateKey.Sign(data, opts...)
+		}()
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"path/filepath"
 	"slices"
 	"testing"
+
+	"github.com/FishGoddess/cryptox/bytes/rand"
 )
 
 // go test -v -cover -run=^TestGenerateKeys$
@@ -48,6 +50,41 @@ func TestGenerateKeys(t *testing.T) {
 	}
 }
 
+// go test -v -cover -run=^TestGenerateKeysWithDRBG$
+func TestGenerateKeysWithDRBG(t *testing.T) {
+	// The ed25519.GenerateKey reads the seed from a non-nil random whatever GODEBUG=cryptocustomrand is.
+	// So the same seeded drbgs always generate the same keys, which isn't true for rsa, ecdsa and ecdh.
+	seed := []byte("123456788765432112345678876543211234567887654321")
+
+	drbg1, err := rand.NewHMACDRBG(rand.WithDRBGSeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	drbg2, err := rand.NewHMACDRBG(rand.WithDRBGSeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey1, publicKey1, err := GenerateKeys(WithKeyRandom(drbg1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey2, publicKey2, err := GenerateKeys(WithKeyRandom(drbg2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(privateKey1.key, privateKey2.key) {
+		t.Fatalf("got %s != expect %s", privateKey1.key, privateKey2.key)
+	}
+
+	if !slices.Equal(publicKey1.key, publicKey2.key) {
+		t.Fatalf("got %s != expect %s", publicKey1.key, publicKey2.key)
+	}
+}
+
 // go test -v -cover -run=^TestWriteReadPrivateKey$
 func TestWriteReadPrivateKey(t *testing.T) {
 	privateKey, _, err := GenerateKeys()
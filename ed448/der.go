@@ -0,0 +1,96 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ed448
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+)
+
+// oidPublicKeyEd448 is the algorithm of ed448 in rfc 8410.
+var oidPublicKeyEd448 = asn1.ObjectIdentifier{1, 3, 101, 113}
+
+var errInvalidDER = errors.New("cryptox/ed448: invalid der")
+
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+type publicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// MarshalPKCS8PrivateKey marshals the private key to pkcs8 der.
+// The private key is the seed wrapped in an octet string which is the same as openssl.
+func (pk PrivateKey) MarshalPKCS8PrivateKey() ([]byte, error) {
+	keyBytes, err := asn1.Marshal(pk.Seed())
+	if err != nil {
+		return nil, err
+	}
+
+	algo := pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyEd448}
+	return asn1.Marshal(pkcs8{Version: 0, Algo: algo, PrivateKey: keyBytes})
+}
+
+// UnmarshalPKCS8PrivateKey unmarshals the private key from pkcs8 der.
+func (pk *PrivateKey) UnmarshalPKCS8PrivateKey(der []byte) error {
+	var info pkcs8
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	if !info.Algo.Algorithm.Equal(oidPublicKeyEd448) || len(info.Algo.Parameters.FullBytes) > 0 {
+		return errInvalidDER
+	}
+
+	var seed []byte
+	if rest, err := asn1.Unmarshal(info.PrivateKey, &seed); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	privateKey, err := NewPrivateKey(seed)
+	if err != nil {
+		return err
+	}
+
+	*pk = privateKey
+	return nil
+}
+
+// MarshalPKIXPublicKey marshals the public key to pkix der.
+func (pk PublicKey) MarshalPKIXPublicKey() ([]byte, error) {
+	publicKey := pk.Bytes()
+
+	info := publicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyEd448},
+		PublicKey: asn1.BitString{Bytes: publicKey, BitLength: 8 * len(publicKey)},
+	}
+
+	return asn1.Marshal(info)
+}
+
+// UnmarshalPKIXPublicKey unmarshals the public key from pkix der.
+func (pk *PublicKey) UnmarshalPKIXPublicKey(der []byte) error {
+	var info publicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	if !info.Algorithm.Algorithm.Equal(oidPublicKeyEd448) || len(info.Algorithm.Parameters.FullBytes) > 0 {
+		return errInvalidDER
+	}
+
+	publicKey, err := NewPublicKey(info.PublicKey.RightAlign())
+	if err != nil {
+		return err
+	}
+
+	*pk = publicKey
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ed448
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/cloudflare/circl/sign/ed448"
+)
+
+const (
+	// SeedSize is the size of private key seed in rfc 8032.
+	SeedSize = ed448.SeedSize
+
+	// PublicKeySize is the size of public key.
+	PublicKeySize = ed448.PublicKeySize
+
+	// SignatureSize is the size of signature.
+	SignatureSize = ed448.SignatureSize
+
+	// contextMaxSize is the max size of context.
+	contextMaxSize = ed448.ContextMaxSize
+)
+
+var errVerifyFailed = errors.New("cryptox/ed448: verify failed")
+
+func checkContext(context string) error {
+	if len(context) > contextMaxSize {
+		return fmt.Errorf("cryptox/ed448: bad context length %d", len(context))
+	}
+
+	return nil
+}
+
+type PrivateKey struct {
+	key ed448.PrivateKey
+}
+
+// NewPrivateKey returns a private key of the 57 bytes seed.
+func NewPrivateKey(seed []byte) (PrivateKey, error) {
+	if len(seed) != SeedSize {
+		return PrivateKey{}, fmt.Errorf("cryptox/ed448: invalid seed size %d", len(seed))
+	}
+
+	privateKey := PrivateKey{key: ed448.NewKeyFromSeed(seed)}
+	return privateKey, nil
+}
+
+// Seed returns the seed of the private key.
+func (pk PrivateKey) Seed() []byte {
+	return pk.key.Seed()
+}
+
+// PublicKey returns the public key of the private key.
+func (pk PrivateKey) PublicKey() PublicKey {
+	return PublicKey{key: pk.key.Public().(ed448.PublicKey)}
+}
+
+// Equal returns if the private key equals to the key.
+func (pk PrivateKey) Equal(key PrivateKey) bool {
+	return len(pk.key) > 0 && subtle.ConstantTimeCompare(pk.key, key.key) == 1
+}
+
+// Sign signs data.
+// It uses ed448ph if pre hash is set and the data will be hashed in shake256 internally.
+func (pk PrivateKey) Sign(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	if err := checkContext(conf.context); err != nil {
+		return nil, err
+	}
+
+	var sign []byte
+	if conf.preHash {
+		sign = ed448.SignPh(pk.key, data, conf.context)
+	} else {
+		sign = ed448.Sign(pk.key, data, conf.context)
+	}
+
+	sign = conf.encoding.Encode(sign)
+	return sign, nil
+}
+
+type PublicKey struct {
+	key ed448.PublicKey
+}
+
+// NewPublicKey returns a public key of the 57 bytes.
+func NewPublicKey(data []byte) (PublicKey, error) {
+	if len(data) != PublicKeySize {
+		return PublicKey{}, fmt.Errorf("cryptox/ed448: invalid public key size %d", len(data))
+	}
+
+	publicKey := PublicKey{key: slices.Clone(data)}
+	return publicKey, nil
+}
+
+// Bytes returns the public key in 57 bytes.
+func (pk PublicKey) Bytes() []byte {
+	return bytes.Clone(pk.key)
+}
+
+// Equal returns if the public key equals to the key.
+func (pk PublicKey) Equal(key PublicKey) bool {
+	return len(pk.key) > 0 && pk.key.Equal(key.key)
+}
+
+// Verify verifies data with sign.
+// It uses ed448ph if pre hash is set and the data will be hashed in shake256 internally.
+func (pk PublicKey) Verify(data []byte, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	if err := checkContext(conf.context); err != nil {
+		return err
+	}
+
+	sign, err := conf.encoding.Decode(sign)
+	if err != nil {
+		return err
+	}
+
+	var ok bool
+	if conf.preHash {
+		ok = ed448.VerifyPh(pk.key, data, sign, conf.context)
+	} else {
+		ok = ed448.Verify(pk.key, data, sign, conf.context)
+	}
+
+	if !ok {
+		return errVerifyFailed
+	}
+
+	return nil
+}
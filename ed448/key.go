@@ -0,0 +1,133 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package ed448
+
+import (
+	"io"
+	"os"
+)
+
+// GenerateKeys generates a private key and a public key.
+// The seed must be 57 bytes if it's set.
+func GenerateKeys(opts ...KeyOption) (PrivateKey, PublicKey, error) {
+	conf := newKeyConfig().Apply(opts...)
+
+	seed := conf.seed
+	if len(seed) <= 0 {
+		seed = make([]byte, SeedSize)
+
+		if _, err := io.ReadFull(conf.random, seed); err != nil {
+			return PrivateKey{}, PublicKey{}, err
+		}
+	}
+
+	privateKey, err := NewPrivateKey(seed)
+	if err != nil {
+		return PrivateKey{}, PublicKey{}, err
+	}
+
+	publicKey := privateKey.PublicKey()
+	return privateKey, publicKey, nil
+}
+
+// WritePrivateKey writes the private key to the writer.
+func WritePrivateKey(writer io.Writer, privateKey PrivateKey, opts ...KeyOption) error {
+	conf := newKeyConfig().Apply(opts...)
+
+	data, err := conf.encodePrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(data)
+	return err
+}
+
+// WritePublicKey writes the public key to the writer.
+func WritePublicKey(writer io.Writer, publicKey PublicKey, opts ...KeyOption) error {
+	conf := newKeyConfig().Apply(opts...)
+
+	data, err := conf.encodePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(data)
+	return err
+}
+
+// ReadPrivateKey reads the private key from the reader.
+func ReadPrivateKey(reader io.Reader, opts ...KeyOption) (PrivateKey, error) {
+	conf := newKeyConfig().Apply(opts...)
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	return conf.decodePrivateKey(data)
+}
+
+// ReadPublicKey reads the public key from the reader.
+func ReadPublicKey(reader io.Reader, opts ...KeyOption) (PublicKey, error) {
+	conf := newKeyConfig().Apply(opts...)
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	return conf.decodePublicKey(data)
+}
+
+func newFile(file string) (*os.File, error) {
+	flag := os.O_CREATE | os.O_WRONLY | os.O_EXCL
+	perm := os.FileMode(0644)
+	return os.OpenFile(file, flag, perm)
+}
+
+// StorePrivateKey stores the private key to the file.
+func StorePrivateKey(file string, privateKey PrivateKey, opts ...KeyOption) error {
+	f, err := newFile(file)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+	return WritePrivateKey(f, privateKey, opts...)
+}
+
+// StorePublicKey stores the public key to the file.
+func StorePublicKey(file string, publicKey PublicKey, opts ...KeyOption) error {
+	f, err := newFile(file)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+	return WritePublicKey(f, publicKey, opts...)
+}
+
+// LoadPrivateKey loads the private key from the file.
+func LoadPrivateKey(file string, opts ...KeyOption) (PrivateKey, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	defer f.Close()
+	return ReadPrivateKey(f, opts...)
+}
+
+// LoadPublicKey loads the public key from the file.
+func LoadPublicKey(file string, opts ...KeyOption) (PublicKey, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	defer f.Close()
+	return ReadPublicKey(f, opts...)
+}
@@ -1,3 +1,12 @@
 module github.com/FishGoddess/cryptox
 
-go 1.25
+go 1.25.0
+
+require (
+	filippo.io/edwards25519 v1.2.0
+	github.com/cloudflare/circl v1.6.5
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.1
+	golang.org/x/crypto v0.54.0
+)
+
+require golang.org/x/sys v0.47.0 // indirect
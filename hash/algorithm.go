@@ -0,0 +1,100 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha3"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+	"hash/fnv"
+
+	xsha3 "golang.org/x/crypto/sha3"
+)
+
+// Algorithm is the name of a hash algorithm which can be used to hash an io.Reader or a file.
+type Algorithm string
+
+const (
+	AlgorithmMD5        Algorithm = "md5"
+	AlgorithmSHA1       Algorithm = "sha1"
+	AlgorithmSHA224     Algorithm = "sha224"
+	AlgorithmSHA256     Algorithm = "sha256"
+	AlgorithmSHA384     Algorithm = "sha384"
+	AlgorithmSHA512     Algorithm = "sha512"
+	AlgorithmSHA3_224   Algorithm = "sha3-224"
+	AlgorithmSHA3_256   Algorithm = "sha3-256"
+	AlgorithmSHA3_384   Algorithm = "sha3-384"
+	AlgorithmSHA3_512   Algorithm = "sha3-512"
+	AlgorithmKeccak256  Algorithm = "keccak256"
+	AlgorithmBLAKE2b256 Algorithm = "blake2b-256"
+	AlgorithmBLAKE2b512 Algorithm = "blake2b-512"
+	AlgorithmBLAKE2s256 Algorithm = "blake2s-256"
+	AlgorithmBLAKE3     Algorithm = "blake3"
+	AlgorithmSM3        Algorithm = "sm3"
+	AlgorithmCRC32      Algorithm = "crc32"
+	AlgorithmCRC64ISO   Algorithm = "crc64-iso"
+	AlgorithmCRC64ECMA  Algorithm = "crc64-ecma"
+	AlgorithmFnv32      Algorithm = "fnv32"
+	AlgorithmFnv32a     Algorithm = "fnv32a"
+	AlgorithmFnv64      Algorithm = "fnv64"
+	AlgorithmFnv64a     Algorithm = "fnv64a"
+	AlgorithmFnv128     Algorithm = "fnv128"
+	AlgorithmFnv128a    Algorithm = "fnv128a"
+)
+
+var algorithms = map[Algorithm]func() hash.Hash{
+	AlgorithmMD5:        md5.New,
+	AlgorithmSHA1:       sha1.New,
+	AlgorithmSHA224:     sha256.New224,
+	AlgorithmSHA256:     sha256.New,
+	AlgorithmSHA384:     sha512.New384,
+	AlgorithmSHA512:     sha512.New,
+	AlgorithmSHA3_224:   func() hash.Hash { return sha3.New224() },
+	AlgorithmSHA3_256:   func() hash.Hash { return sha3.New256() },
+	AlgorithmSHA3_384:   func() hash.Hash { return sha3.New384() },
+	AlgorithmSHA3_512:   func() hash.Hash { return sha3.New512() },
+	AlgorithmKeccak256:  xsha3.NewLegacyKeccak256,
+	AlgorithmBLAKE2b256: func() hash.Hash { return mustNew(NewBLAKE2b(WithOutputLength(32))) },
+	AlgorithmBLAKE2b512: func() hash.Hash { return mustNew(NewBLAKE2b()) },
+	AlgorithmBLAKE2s256: func() hash.Hash { return mustNew(NewBLAKE2s()) },
+	AlgorithmBLAKE3:     func() hash.Hash { return mustNew(NewBLAKE3()) },
+	AlgorithmSM3:        NewSM3,
+	AlgorithmCRC32:      func() hash.Hash { return crc32.New(tableIEEE) },
+	AlgorithmCRC64ISO:   func() hash.Hash { return crc64.New(tableISO) },
+	AlgorithmCRC64ECMA:  func() hash.Hash { return crc64.New(tableECMA) },
+	AlgorithmFnv32:      func() hash.Hash { return fnv.New32() },
+	AlgorithmFnv32a:     func() hash.Hash { return fnv.New32a() },
+	AlgorithmFnv64:      func() hash.Hash { return fnv.New64() },
+	AlgorithmFnv64a:     func() hash.Hash { return fnv.New64a() },
+	AlgorithmFnv128:     fnv.New128,
+	AlgorithmFnv128a:    fnv.New128a,
+}
+
+// mustNew returns h and panics if err isn't nil.
+// It's only used by algorithms whose options are always valid.
+func mustNew(h hash.Hash, err error) hash.Hash {
+	if err != nil {
+		panic(err)
+	}
+
+	return h
+}
+
+// New returns a new hash.Hash of the algorithm.
+// The checksums like crc32 are in big endian.
+func (a Algorithm) New() (hash.Hash, error) {
+	newHash, ok := algorithms[a]
+	if !ok {
+		return nil, fmt.Errorf("cryptox/hash: algorithm %q not supported", a)
+	}
+
+	return newHash(), nil
+}
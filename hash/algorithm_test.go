@@ -0,0 +1,80 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"slices"
+	"testing"
+)
+
+// go test -v -cover -run=^TestAlgorithm$
+func TestAlgorithm(t *testing.T) {
+	data := []byte("你好，世界")
+
+	testCases := map[Algorithm][]byte{
+		AlgorithmMD5:       MD5(data),
+		AlgorithmSHA1:      SHA1(data),
+		AlgorithmSHA224:    SHA224(data),
+		AlgorithmSHA256:    SHA256(data),
+		AlgorithmSHA384:    SHA384(data),
+		AlgorithmSHA512:    SHA512(data),
+		AlgorithmSHA3_224:  SHA3_224(data),
+		AlgorithmSHA3_256:  SHA3_256(data),
+		AlgorithmSHA3_384:  SHA3_384(data),
+		AlgorithmSHA3_512:  SHA3_512(data),
+		AlgorithmKeccak256: Keccak256(data),
+		AlgorithmFnv128:    Fnv128(data),
+		AlgorithmFnv128a:   Fnv128a(data),
+	}
+
+	blake2b256, err := BLAKE2b(data, WithOutputLength(32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blake2b512, err := BLAKE2b(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blake2s256, err := BLAKE2s(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blake3, err := BLAKE3(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases[AlgorithmBLAKE2b256] = blake2b256
+	testCases[AlgorithmBLAKE2b512] = blake2b512
+	testCases[AlgorithmBLAKE2s256] = blake2s256
+	testCases[AlgorithmBLAKE3] = blake3
+
+	for algorithm, expect := range testCases {
+		h, err := algorithm.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		h.Write(data)
+
+		got := h.Sum(nil)
+		if !slices.Equal(got, expect) {
+			t.Fatalf("%s: got %+v != expect %+v", algorithm, got, expect)
+		}
+	}
+
+	for algorithm := range algorithms {
+		if _, err := algorithm.New(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := Algorithm("unknown").New(); err == nil {
+		t.Fatal("new unknown algorithm should fail")
+	}
+}
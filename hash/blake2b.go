@@ -0,0 +1,232 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"math/bits"
+)
+
+const (
+	blake2bBlockSize    = 128
+	blake2bSize         = 64
+	blake2bKeySize      = 64
+	blake2bSaltSize     = 16
+	blake2bPersonalSize = 16
+
+	// blake2FanoutDepth is the fanout 1 and depth 1 of blake2 parameter block in sequential mode.
+	blake2FanoutDepth = 0x01010000
+)
+
+var blake2bIV = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+var blake2Sigma = [10][16]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+}
+
+// blake2Params checks the parameters of blake2 and returns them padded with zeros.
+func blake2Params(conf *Config, name string, maxSize int, defaultSize int, keySize int, saltSize int, personalSize int) (size int, salt []byte, personal []byte, err error) {
+	size = conf.outputLength
+	if size == 0 {
+		size = defaultSize
+	}
+
+	if size < 1 || size > maxSize {
+		return 0, nil, nil, fmt.Errorf("cryptox/hash: %s output length %d not in [1, %d]", name, size, maxSize)
+	}
+
+	if len(conf.key) > keySize {
+		return 0, nil, nil, fmt.Errorf("cryptox/hash: %s key length %d > %d", name, len(conf.key), keySize)
+	}
+
+	if len(conf.salt) > saltSize {
+		return 0, nil, nil, fmt.Errorf("cryptox/hash: %s salt length %d > %d", name, len(conf.salt), saltSize)
+	}
+
+	if len(conf.personalization) > personalSize {
+		return 0, nil, nil, fmt.Errorf("cryptox/hash: %s personalization length %d > %d", name, len(conf.personalization), personalSize)
+	}
+
+	salt = make([]byte, saltSize)
+	copy(salt, conf.salt)
+
+	personal = make([]byte, personalSize)
+	copy(personal, conf.personalization)
+	return size, salt, personal, nil
+}
+
+type blake2b struct {
+	h        [8]uint64
+	t        [2]uint64
+	buffer   [blake2bBlockSize]byte
+	offset   int
+	size     int
+	key      []byte
+	initialH [8]uint64
+}
+
+// NewBLAKE2b returns a blake2b hash.Hash which is keyed if WithKey is used.
+// The output length is 64 bytes by default, and you can use WithOutputLength to change it in [1, 64].
+// The key, salt and personalization can be at most 64, 16 and 16 bytes.
+func NewBLAKE2b(opts ...Option) (hash.Hash, error) {
+	conf := newConfig().Apply(opts...)
+
+	size, salt, personal, err := blake2Params(conf, "blake2b", blake2bSize, blake2bSize, blake2bKeySize, blake2bSaltSize, blake2bPersonalSize)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &blake2b{size: size, key: append([]byte(nil), conf.key...)}
+	b.initialH = blake2bIV
+	b.initialH[0] ^= blake2FanoutDepth ^ uint64(len(conf.key))<<8 ^ uint64(size)
+	b.initialH[4] ^= binary.LittleEndian.Uint64(salt[0:8])
+	b.initialH[5] ^= binary.LittleEndian.Uint64(salt[8:16])
+	b.initialH[6] ^= binary.LittleEndian.Uint64(personal[0:8])
+	b.initialH[7] ^= binary.LittleEndian.Uint64(personal[8:16])
+
+	b.Reset()
+	return b, nil
+}
+
+func (b *blake2b) Reset() {
+	b.h = b.initialH
+	b.t = [2]uint64{}
+	b.buffer = [blake2bBlockSize]byte{}
+	b.offset = 0
+
+	// The key is padded to a full block and is processed as the first block.
+	if len(b.key) > 0 {
+		copy(b.buffer[:], b.key)
+		b.offset = blake2bBlockSize
+	}
+}
+
+func (b *blake2b) Size() int {
+	return b.size
+}
+
+func (b *blake2b) BlockSize() int {
+	return blake2bBlockSize
+}
+
+func (b *blake2b) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		// Keep the last block in buffer since it needs to be compressed with the final flag.
+		if b.offset == blake2bBlockSize {
+			b.increase(blake2bBlockSize)
+			b.compress(false)
+			b.offset = 0
+		}
+
+		copied := copy(b.buffer[b.offset:], p)
+		b.offset += copied
+		p = p[copied:]
+	}
+
+	return n, nil
+}
+
+func (b *blake2b) Sum(in []byte) []byte {
+	final := *b
+	clear(final.buffer[final.offset:])
+	final.increase(final.offset)
+	final.compress(true)
+
+	var sum [blake2bSize]byte
+	for i, h := range final.h {
+		binary.LittleEndian.PutUint64(sum[i*8:], h)
+	}
+
+	return append(in, sum[:b.size]...)
+}
+
+func (b *blake2b) increase(n int) {
+	b.t[0] += uint64(n)
+	if b.t[0] < uint64(n) {
+		b.t[1]++
+	}
+}
+
+func (b *blake2b) compress(last bool) {
+	var m [16]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(b.buffer[i*8:])
+	}
+
+	v0, v1, v2, v3, v4, v5, v6, v7 := b.h[0], b.h[1], b.h[2], b.h[3], b.h[4], b.h[5], b.h[6], b.h[7]
+	v8, v9, v10, v11 := blake2bIV[0], blake2bIV[1], blake2bIV[2], blake2bIV[3]
+	v12, v13, v14, v15 := blake2bIV[4]^b.t[0], blake2bIV[5]^b.t[1], blake2bIV[6], blake2bIV[7]
+
+	if last {
+		v14 = ^v14
+	}
+
+	for round := 0; round < 12; round++ {
+		s := &blake2Sigma[round%10]
+		v0, v4, v8, v12 = g64(v0, v4, v8, v12, m[s[0]], m[s[1]])
+		v1, v5, v9, v13 = g64(v1, v5, v9, v13, m[s[2]], m[s[3]])
+		v2, v6, v10, v14 = g64(v2, v6, v10, v14, m[s[4]], m[s[5]])
+		v3, v7, v11, v15 = g64(v3, v7, v11, v15, m[s[6]], m[s[7]])
+		v0, v5, v10, v15 = g64(v0, v5, v10, v15, m[s[8]], m[s[9]])
+		v1, v6, v11, v12 = g64(v1, v6, v11, v12, m[s[10]], m[s[11]])
+		v2, v7, v8, v13 = g64(v2, v7, v8, v13, m[s[12]], m[s[13]])
+		v3, v4, v9, v14 = g64(v3, v4, v9, v14, m[s[14]], m[s[15]])
+	}
+
+	b.h[0] ^= v0 ^ v8
+	b.h[1] ^= v1 ^ v9
+	b.h[2] ^= v2 ^ v10
+	b.h[3] ^= v3 ^ v11
+	b.h[4] ^= v4 ^ v12
+	b.h[5] ^= v5 ^ v13
+	b.h[6] ^= v6 ^ v14
+	b.h[7] ^= v7 ^ v15
+}
+
+// g64 is the mixing function of blake2b.
+func g64(a, b, c, d, x, y uint64) (uint64, uint64, uint64, uint64) {
+	a = a + b + x
+	d = bits.RotateLeft64(d^a, -32)
+	c = c + d
+	b = bits.RotateLeft64(b^c, -24)
+	a = a + b + y
+	d = bits.RotateLeft64(d^a, -16)
+	c = c + d
+	b = bits.RotateLeft64(b^c, -63)
+	return a, b, c, d
+}
+
+// BLAKE2b uses blake2b to hash data and returns an error if failed.
+// Use WithKey to make it a mac, and use WithSalt or WithPersonalization to separate different usages.
+// The output length is 64 bytes by default, and you can use WithOutputLength to change it in [1, 64].
+func BLAKE2b(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	h, err := NewBLAKE2b(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Write(data)
+
+	sum := h.Sum(nil)
+	return conf.encoding.Encode(sum), nil
+}
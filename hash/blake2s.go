@@ -0,0 +1,183 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+const (
+	blake2sBlockSize    = 64
+	blake2sSize         = 32
+	blake2sKeySize      = 32
+	blake2sSaltSize     = 8
+	blake2sPersonalSize = 8
+)
+
+var blake2sIV = [8]uint32{
+	0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a, 0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19,
+}
+
+type blake2s struct {
+	h        [8]uint32
+	t        [2]uint32
+	buffer   [blake2sBlockSize]byte
+	offset   int
+	size     int
+	key      []byte
+	initialH [8]uint32
+}
+
+// NewBLAKE2s returns a blake2s hash.Hash which is keyed if WithKey is used.
+// The output length is 32 bytes by default, and you can use WithOutputLength to change it in [1, 32].
+// The key, salt and personalization can be at most 32, 8 and 8 bytes.
+func NewBLAKE2s(opts ...Option) (hash.Hash, error) {
+	conf := newConfig().Apply(opts...)
+
+	size, salt, personal, err := blake2Params(conf, "blake2s", blake2sSize, blake2sSize, blake2sKeySize, blake2sSaltSize, blake2sPersonalSize)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &blake2s{size: size, key: append([]byte(nil), conf.key...)}
+	s.initialH = blake2sIV
+	s.initialH[0] ^= blake2FanoutDepth ^ uint32(len(conf.key))<<8 ^ uint32(size)
+	s.initialH[4] ^= binary.LittleEndian.Uint32(salt[0:4])
+	s.initialH[5] ^= binary.LittleEndian.Uint32(salt[4:8])
+	s.initialH[6] ^= binary.LittleEndian.Uint32(personal[0:4])
+	s.initialH[7] ^= binary.LittleEndian.Uint32(personal[4:8])
+
+	s.Reset()
+	return s, nil
+}
+
+func (s *blake2s) Reset() {
+	s.h = s.initialH
+	s.t = [2]uint32{}
+	s.buffer = [blake2sBlockSize]byte{}
+	s.offset = 0
+
+	// The key is padded to a full block and is processed as the first block.
+	if len(s.key) > 0 {
+		copy(s.buffer[:], s.key)
+		s.offset = blake2sBlockSize
+	}
+}
+
+func (s *blake2s) Size() int {
+	return s.size
+}
+
+func (s *blake2s) BlockSize() int {
+	return blake2sBlockSize
+}
+
+func (s *blake2s) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		// Keep the last block in buffer since it needs to be compressed with the final flag.
+		if s.offset == blake2sBlockSize {
+			s.increase(blake2sBlockSize)
+			s.compress(false)
+			s.offset = 0
+		}
+
+		copied := copy(s.buffer[s.offset:], p)
+		s.offset += copied
+		p = p[copied:]
+	}
+
+	return n, nil
+}
+
+func (s *blake2s) Sum(in []byte) []byte {
+	final := *s
+	clear(final.buffer[final.offset:])
+	final.increase(final.offset)
+	final.compress(true)
+
+	var sum [blake2sSize]byte
+	for i, h := range final.h {
+		binary.LittleEndian.PutUint32(sum[i*4:], h)
+	}
+
+	return append(in, sum[:s.size]...)
+}
+
+func (s *blake2s) increase(n int) {
+	s.t[0] += uint32(n)
+	if s.t[0] < uint32(n) {
+		s.t[1]++
+	}
+}
+
+func (s *blake2s) compress(last bool) {
+	var m [16]uint32
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint32(s.buffer[i*4:])
+	}
+
+	v0, v1, v2, v3, v4, v5, v6, v7 := s.h[0], s.h[1], s.h[2], s.h[3], s.h[4], s.h[5], s.h[6], s.h[7]
+	v8, v9, v10, v11 := blake2sIV[0], blake2sIV[1], blake2sIV[2], blake2sIV[3]
+	v12, v13, v14, v15 := blake2sIV[4]^s.t[0], blake2sIV[5]^s.t[1], blake2sIV[6], blake2sIV[7]
+
+	if last {
+		v14 = ^v14
+	}
+
+	for round := 0; round < 10; round++ {
+		sigma := &blake2Sigma[round]
+		v0, v4, v8, v12 = g32(v0, v4, v8, v12, m[sigma[0]], m[sigma[1]])
+		v1, v5, v9, v13 = g32(v1, v5, v9, v13, m[sigma[2]], m[sigma[3]])
+		v2, v6, v10, v14 = g32(v2, v6, v10, v14, m[sigma[4]], m[sigma[5]])
+		v3, v7, v11, v15 = g32(v3, v7, v11, v15, m[sigma[6]], m[sigma[7]])
+		v0, v5, v10, v15 = g32(v0, v5, v10, v15, m[sigma[8]], m[sigma[9]])
+		v1, v6, v11, v12 = g32(v1, v6, v11, v12, m[sigma[10]], m[sigma[11]])
+		v2, v7, v8, v13 = g32(v2, v7, v8, v13, m[sigma[12]], m[sigma[13]])
+		v3, v4, v9, v14 = g32(v3, v4, v9, v14, m[sigma[14]], m[sigma[15]])
+	}
+
+	s.h[0] ^= v0 ^ v8
+	s.h[1] ^= v1 ^ v9
+	s.h[2] ^= v2 ^ v10
+	s.h[3] ^= v3 ^ v11
+	s.h[4] ^= v4 ^ v12
+	s.h[5] ^= v5 ^ v13
+	s.h[6] ^= v6 ^ v14
+	s.h[7] ^= v7 ^ v15
+}
+
+// g32 is the mixing function of blake2s and blake3.
+func g32(a, b, c, d, x, y uint32) (uint32, uint32, uint32, uint32) {
+	a = a + b + x
+	d = bits.RotateLeft32(d^a, -16)
+	c = c + d
+	b = bits.RotateLeft32(b^c, -12)
+	a = a + b + y
+	d = bits.RotateLeft32(d^a, -8)
+	c = c + d
+	b = bits.RotateLeft32(b^c, -7)
+	return a, b, c, d
+}
+
+// BLAKE2s uses blake2s to hash data and returns an error if failed.
+// Use WithKey to make it a mac, and use WithSalt or WithPersonalization to separate different usages.
+// The output length is 32 bytes by default, and you can use WithOutputLength to change it in [1, 32].
+func BLAKE2s(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	h, err := NewBLAKE2s(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Write(data)
+
+	sum := h.Sum(nil)
+	return conf.encoding.Encode(sum), nil
+}
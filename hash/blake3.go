@@ -0,0 +1,351 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+)
+
+const (
+	blake3BlockSize = 64
+	blake3ChunkSize = 1024
+	blake3KeySize   = 32
+	blake3Size      = 32
+
+	// blake3MaxDepth is enough for 2^64 bytes input.
+	blake3MaxDepth = 54
+)
+
+const (
+	blake3ChunkStart        = 1 << 0
+	blake3ChunkEnd          = 1 << 1
+	blake3Parent            = 1 << 2
+	blake3Root              = 1 << 3
+	blake3KeyedHash         = 1 << 4
+	blake3DeriveKeyContext  = 1 << 5
+	blake3DeriveKeyMaterial = 1 << 6
+)
+
+var blake3IV = [8]uint32{
+	0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a, 0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19,
+}
+
+var blake3MessagePermutation = [16]int{2, 6, 3, 10, 7, 0, 4, 13, 1, 11, 12, 5, 9, 14, 15, 8}
+
+// blake3Schedule is the message indexes of each round which are permuted from the last round.
+var blake3Schedule = func() (schedule [7][16]byte) {
+	for i := range schedule[0] {
+		schedule[0][i] = byte(i)
+	}
+
+	for round := 1; round < len(schedule); round++ {
+		for i, j := range blake3MessagePermutation {
+			schedule[round][i] = schedule[round-1][j]
+		}
+	}
+
+	return schedule
+}()
+
+func blake3Compress(cv *[8]uint32, block *[16]uint32, counter uint64, blockLen uint32, flags uint32) [16]uint32 {
+	v0, v1, v2, v3, v4, v5, v6, v7 := cv[0], cv[1], cv[2], cv[3], cv[4], cv[5], cv[6], cv[7]
+	v8, v9, v10, v11 := blake3IV[0], blake3IV[1], blake3IV[2], blake3IV[3]
+	v12, v13, v14, v15 := uint32(counter), uint32(counter>>32), blockLen, flags
+
+	m := block
+	for round := range blake3Schedule {
+		s := &blake3Schedule[round]
+		v0, v4, v8, v12 = g32(v0, v4, v8, v12, m[s[0]], m[s[1]])
+		v1, v5, v9, v13 = g32(v1, v5, v9, v13, m[s[2]], m[s[3]])
+		v2, v6, v10, v14 = g32(v2, v6, v10, v14, m[s[4]], m[s[5]])
+		v3, v7, v11, v15 = g32(v3, v7, v11, v15, m[s[6]], m[s[7]])
+		v0, v5, v10, v15 = g32(v0, v5, v10, v15, m[s[8]], m[s[9]])
+		v1, v6, v11, v12 = g32(v1, v6, v11, v12, m[s[10]], m[s[11]])
+		v2, v7, v8, v13 = g32(v2, v7, v8, v13, m[s[12]], m[s[13]])
+		v3, v4, v9, v14 = g32(v3, v4, v9, v14, m[s[14]], m[s[15]])
+	}
+
+	return [16]uint32{
+		v0 ^ v8, v1 ^ v9, v2 ^ v10, v3 ^ v11, v4 ^ v12, v5 ^ v13, v6 ^ v14, v7 ^ v15,
+		v8 ^ cv[0], v9 ^ cv[1], v10 ^ cv[2], v11 ^ cv[3], v12 ^ cv[4], v13 ^ cv[5], v14 ^ cv[6], v15 ^ cv[7],
+	}
+}
+
+func blake3Words(data []byte) (words [16]uint32) {
+	var block [blake3BlockSize]byte
+	copy(block[:], data)
+
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint32(block[i*4:])
+	}
+
+	return words
+}
+
+func blake3KeyWords(key []byte) (words [8]uint32) {
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint32(key[i*4:])
+	}
+
+	return words
+}
+
+// blake3Output is the state to compute a chaining value or the root output.
+type blake3Output struct {
+	cv       [8]uint32
+	block    [16]uint32
+	counter  uint64
+	blockLen uint32
+	flags    uint32
+}
+
+func (bo *blake3Output) chainingValue() (cv [8]uint32) {
+	v := blake3Compress(&bo.cv, &bo.block, bo.counter, bo.blockLen, bo.flags)
+	copy(cv[:], v[:8])
+	return cv
+}
+
+func (bo *blake3Output) rootBytes(out []byte) {
+	var block [blake3BlockSize]byte
+	for counter := uint64(0); len(out) > 0; counter++ {
+		v := blake3Compress(&bo.cv, &bo.block, counter, bo.blockLen, bo.flags|blake3Root)
+		for i, word := range v {
+			binary.LittleEndian.PutUint32(block[i*4:], word)
+		}
+
+		n := copy(out, block[:])
+		out = out[n:]
+	}
+}
+
+type blake3ChunkState struct {
+	cv               [8]uint32
+	counter          uint64
+	block            [blake3BlockSize]byte
+	blockLen         int
+	blocksCompressed int
+	flags            uint32
+}
+
+func newBLAKE3ChunkState(key [8]uint32, counter uint64, flags uint32) blake3ChunkState {
+	return blake3ChunkState{cv: key, counter: counter, flags: flags}
+}
+
+func (bcs *blake3ChunkState) len() int {
+	return bcs.blocksCompressed*blake3BlockSize + bcs.blockLen
+}
+
+func (bcs *blake3ChunkState) startFlag() uint32 {
+	if bcs.blocksCompressed == 0 {
+		return blake3ChunkStart
+	}
+
+	return 0
+}
+
+func (bcs *blake3ChunkState) update(p []byte) {
+	for len(p) > 0 {
+		// Keep the last block in buffer since it needs to be compressed with the chunk end flag.
+		if bcs.blockLen == blake3BlockSize {
+			words := blake3Words(bcs.block[:])
+			v := blake3Compress(&bcs.cv, &words, bcs.counter, blake3BlockSize, bcs.flags|bcs.startFlag())
+			copy(bcs.cv[:], v[:8])
+
+			bcs.blocksCompressed++
+			bcs.block = [blake3BlockSize]byte{}
+			bcs.blockLen = 0
+		}
+
+		n := copy(bcs.block[bcs.blockLen:], p)
+		bcs.blockLen += n
+		p = p[n:]
+	}
+}
+
+func (bcs *blake3ChunkState) output() blake3Output {
+	output := blake3Output{
+		cv:       bcs.cv,
+		block:    blake3Words(bcs.block[:bcs.blockLen]),
+		counter:  bcs.counter,
+		blockLen: uint32(bcs.blockLen),
+		flags:    bcs.flags | bcs.startFlag() | blake3ChunkEnd,
+	}
+
+	return output
+}
+
+func blake3ParentOutput(left [8]uint32, right [8]uint32, key [8]uint32, flags uint32) blake3Output {
+	var block [16]uint32
+	copy(block[:8], left[:])
+	copy(block[8:], right[:])
+
+	output := blake3Output{
+		cv:       key,
+		block:    block,
+		counter:  0,
+		blockLen: blake3BlockSize,
+		flags:    flags | blake3Parent,
+	}
+
+	return output
+}
+
+type blake3 struct {
+	key        [8]uint32
+	flags      uint32
+	size       int
+	chunkState blake3ChunkState
+	cvStack    [blake3MaxDepth][8]uint32
+	cvStackLen int
+}
+
+func newBLAKE3(key [8]uint32, flags uint32, size int) *blake3 {
+	b := &blake3{key: key, flags: flags, size: size}
+	b.Reset()
+	return b
+}
+
+func blake3OutputLength(conf *Config) (int, error) {
+	if conf.outputLength < 0 {
+		return 0, fmt.Errorf("cryptox/hash: blake3 output length %d < 0", conf.outputLength)
+	}
+
+	if conf.outputLength == 0 {
+		return blake3Size, nil
+	}
+
+	return conf.outputLength, nil
+}
+
+// NewBLAKE3 returns a blake3 hash.Hash which is keyed if WithKey is used.
+// The key must be 32 bytes if set.
+// The output length is 32 bytes by default, and you can use WithOutputLength to change it to any length.
+func NewBLAKE3(opts ...Option) (hash.Hash, error) {
+	conf := newConfig().Apply(opts...)
+
+	size, err := blake3OutputLength(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.key == nil {
+		return newBLAKE3(blake3IV, 0, size), nil
+	}
+
+	if len(conf.key) != blake3KeySize {
+		return nil, fmt.Errorf("cryptox/hash: blake3 key length %d != %d", len(conf.key), blake3KeySize)
+	}
+
+	return newBLAKE3(blake3KeyWords(conf.key), blake3KeyedHash, size), nil
+}
+
+func (b *blake3) Reset() {
+	b.chunkState = newBLAKE3ChunkState(b.key, 0, b.flags)
+	b.cvStackLen = 0
+}
+
+func (b *blake3) Size() int {
+	return b.size
+}
+
+func (b *blake3) BlockSize() int {
+	return blake3BlockSize
+}
+
+func (b *blake3) pushChunk(cv [8]uint32, totalChunks uint64) {
+	// Merge the subtrees which are complete, and the count of them is the trailing zeros of total chunks.
+	for totalChunks&1 == 0 {
+		b.cvStackLen--
+
+		output := blake3ParentOutput(b.cvStack[b.cvStackLen], cv, b.key, b.flags)
+		cv = output.chainingValue()
+		totalChunks >>= 1
+	}
+
+	b.cvStack[b.cvStackLen] = cv
+	b.cvStackLen++
+}
+
+func (b *blake3) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		// Keep the last chunk in state since it may be the root.
+		if b.chunkState.len() == blake3ChunkSize {
+			output := b.chunkState.output()
+			totalChunks := b.chunkState.counter + 1
+
+			b.pushChunk(output.chainingValue(), totalChunks)
+			b.chunkState = newBLAKE3ChunkState(b.key, totalChunks, b.flags)
+		}
+
+		want := blake3ChunkSize - b.chunkState.len()
+		want = min(want, len(p))
+
+		b.chunkState.update(p[:want])
+		p = p[want:]
+	}
+
+	return n, nil
+}
+
+func (b *blake3) output() blake3Output {
+	output := b.chunkState.output()
+
+	for i := b.cvStackLen - 1; i >= 0; i-- {
+		output = blake3ParentOutput(b.cvStack[i], output.chainingValue(), b.key, b.flags)
+	}
+
+	return output
+}
+
+func (b *blake3) Sum(in []byte) []byte {
+	output := b.output()
+
+	sum := make([]byte, b.size)
+	output.rootBytes(sum)
+	return append(in, sum...)
+}
+
+// BLAKE3 uses blake3 to hash data and returns an error if failed.
+// Use WithKey to make it a mac and the key must be 32 bytes.
+// The output length is 32 bytes by default, and you can use WithOutputLength to change it to any length.
+func BLAKE3(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	h, err := NewBLAKE3(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Write(data)
+
+	sum := h.Sum(nil)
+	return conf.encoding.Encode(sum), nil
+}
+
+// BLAKE3DeriveKey uses blake3 in derive_key mode to derive a key from material.
+// The context should be hardcoded, globally unique and application-specific.
+// The output length is 32 bytes by default, and you can use WithOutputLength to change it to any length.
+func BLAKE3DeriveKey(context string, material []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	size, err := blake3OutputLength(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	contextHash := newBLAKE3(blake3IV, blake3DeriveKeyContext, blake3KeySize)
+	contextHash.Write([]byte(context))
+	contextKey := contextHash.Sum(nil)
+
+	h := newBLAKE3(blake3KeyWords(contextKey), blake3DeriveKeyMaterial, size)
+	h.Write(material)
+
+	sum := h.Sum(nil)
+	return conf.encoding.Encode(sum), nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"slices"
+	"testing"
+)
+
+const blake3TestContext = "BLAKE3 2019-12-27 16:29:52 test vectors context"
+
+type blake3TestVectors struct {
+	Key   string `json:"key"`
+	Cases []struct {
+		InputLen  int    `json:"input_len"`
+		Hash      string `json:"hash"`
+		KeyedHash string `json:"keyed_hash"`
+		DeriveKey string `json:"derive_key"`
+	} `json:"cases"`
+}
+
+func loadBLAKE3TestVectors(t *testing.T) *blake3TestVectors {
+	data, err := os.ReadFile("testdata/blake3_vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vectors := new(blake3TestVectors)
+	if err = json.Unmarshal(data, vectors); err != nil {
+		t.Fatal(err)
+	}
+
+	return vectors
+}
+
+// go test -v -cover -run=^TestBLAKE3$
+func TestBLAKE3(t *testing.T) {
+	// The vectors come from the official test_vectors.json of blake3.
+	vectors := loadBLAKE3TestVectors(t)
+	key := []byte(vectors.Key)
+
+	for _, testCase := range vectors.Cases {
+		data := newBLAKE2TestData(testCase.InputLen)
+		outputLength := len(testCase.Hash) / 2
+
+		got, err := BLAKE3(data, WithHex(), WithOutputLength(outputLength))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != testCase.Hash {
+			t.Fatalf("input len %d: got %s != expect %s", testCase.InputLen, got, testCase.Hash)
+		}
+
+		// The default output is the first 32 bytes.
+		got, err = BLAKE3(data, WithHex())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != testCase.Hash[:64] {
+			t.Fatalf("input len %d: got %s != expect %s", testCase.InputLen, got, testCase.Hash[:64])
+		}
+
+		got, err = BLAKE3(data, WithHex(), WithKey(key), WithOutputLength(outputLength))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != testCase.KeyedHash {
+			t.Fatalf("input len %d: got %s != expect %s", testCase.InputLen, got, testCase.KeyedHash)
+		}
+
+		got, err = BLAKE3DeriveKey(blake3TestContext, data, WithHex(), WithOutputLength(outputLength))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != testCase.DeriveKey {
+			t.Fatalf("input len %d: got %s != expect %s", testCase.InputLen, got, testCase.DeriveKey)
+		}
+	}
+
+	if _, err := BLAKE3(nil, WithKey([]byte("short key"))); err == nil {
+		t.Fatal("blake3 with a short key should fail")
+	}
+
+	if _, err := BLAKE3(nil, WithOutputLength(-1)); err == nil {
+		t.Fatal("blake3 with output length -1 should fail")
+	}
+
+	if _, err := BLAKE3DeriveKey(blake3TestContext, nil, WithOutputLength(-1)); err == nil {
+		t.Fatal("blake3 derive key with output length -1 should fail")
+	}
+}
+
+// go test -v -cover -run=^TestBLAKE3Stream$
+func TestBLAKE3Stream(t *testing.T) {
+	vectors := loadBLAKE3TestVectors(t)
+
+	h, err := NewBLAKE3()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if h.Size() != 32 || h.BlockSize() != 64 {
+		t.Fatalf("size %d or block size %d is wrong", h.Size(), h.BlockSize())
+	}
+
+	for _, testCase := range vectors.Cases {
+		data := newBLAKE2TestData(testCase.InputLen)
+
+		// Write data in odd pieces and sum in the middle to make sure sum doesn't change the state.
+		h.Reset()
+		for _, chunk := range slices.Collect(slices.Chunk(data, 997)) {
+			h.Write(chunk)
+			h.Sum(nil)
+		}
+
+		got := hex.EncodeToString(h.Sum(nil))
+		if got != testCase.Hash[:64] {
+			t.Fatalf("input len %d: got %s != expect %s", testCase.InputLen, got, testCase.Hash[:64])
+		}
+	}
+}
@@ -8,13 +8,29 @@ import (
 	"github.com/FishGoddess/cryptox/bytes/encoding"
 )
 
+// Progress is called after reading data with the count of bytes read so far and the total count.
+// The total is -1 if it's unknown like hashing an io.Reader.
+type Progress func(read int64, total int64)
+
 type Config struct {
-	encoding encoding.Encoding
+	encoding        encoding.Encoding
+	progress        Progress
+	bufferSize      int
+	outputLength    int
+	key             []byte
+	salt            []byte
+	personalization []byte
 }
 
 func newConfig() *Config {
 	conf := &Config{
-		encoding: encoding.None{},
+		encoding:        encoding.None{},
+		progress:        nil,
+		bufferSize:      32 * 1024,
+		outputLength:    0,
+		key:             nil,
+		salt:            nil,
+		personalization: nil,
 	}
 
 	return conf
@@ -43,3 +59,51 @@ func WithBase64() Option {
 		conf.encoding = encoding.Base64{}
 	}
 }
+
+// WithProgress sets progress to config.
+// It's used when hashing an io.Reader or a file.
+func WithProgress(progress Progress) Option {
+	return func(conf *Config) {
+		conf.progress = progress
+	}
+}
+
+// WithBufferSize sets buffer size to config.
+// It's used when hashing an io.Reader or a file.
+func WithBufferSize(bufferSize int) Option {
+	return func(conf *Config) {
+		conf.bufferSize = bufferSize
+	}
+}
+
+// WithOutputLength sets output length in bytes to config.
+// It's used by xofs like shake and cshake.
+func WithOutputLength(outputLength int) Option {
+	return func(conf *Config) {
+		conf.outputLength = outputLength
+	}
+}
+
+// WithKey sets key to config.
+// It's used by keyed hashes like blake2 and blake3.
+func WithKey(key []byte) Option {
+	return func(conf *Config) {
+		conf.key = key
+	}
+}
+
+// WithSalt sets salt to config.
+// It's used by blake2.
+func WithSalt(salt []byte) Option {
+	return func(conf *Config) {
+		conf.salt = salt
+	}
+}
+
+// WithPersonalization sets personalization to config.
+// It's used by blake2.
+func WithPersonalization(personalization []byte) Option {
+	return func(conf *Config) {
+		conf.personalization = personalization
+	}
+}
@@ -13,8 +13,16 @@ import (
 
 // go test -v -cover -run=^TestConfig$
 func TestConfig(t *testing.T) {
+	progress := func(read int64, total int64) {}
+
 	opts := []Option{
 		WithHex(),
+		WithProgress(progress),
+		WithBufferSize(1024),
+		WithOutputLength(64),
+		WithKey([]byte("key")),
+		WithSalt([]byte("salt")),
+		WithPersonalization([]byte("personalization")),
 	}
 
 	conf := newConfig().Apply(opts...)
@@ -25,6 +33,32 @@ func TestConfig(t *testing.T) {
 		t.Fatalf("got %s != expect %s", got, expect)
 	}
 
+	got = fmt.Sprintf("%p", conf.progress)
+	expect = fmt.Sprintf("%p", progress)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if conf.bufferSize != 1024 {
+		t.Fatalf("got %d != expect %d", conf.bufferSize, 1024)
+	}
+
+	if conf.outputLength != 64 {
+		t.Fatalf("got %d != expect %d", conf.outputLength, 64)
+	}
+
+	if string(conf.key) != "key" {
+		t.Fatalf("got %s != expect %s", conf.key, "key")
+	}
+
+	if string(conf.salt) != "salt" {
+		t.Fatalf("got %s != expect %s", conf.salt, "salt")
+	}
+
+	if string(conf.personalization) != "personalization" {
+		t.Fatalf("got %s != expect %s", conf.personalization, "personalization")
+	}
+
 	conf.Apply(WithBase64())
 
 	got = fmt.Sprintf("%T", conf.encoding)
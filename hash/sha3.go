@@ -0,0 +1,114 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"crypto/sha3"
+
+	xsha3 "golang.org/x/crypto/sha3"
+)
+
+const (
+	shake128OutputLength = 32
+	shake256OutputLength = 64
+)
+
+func xofOutputLength(conf *Config, defaultLength int) int {
+	if conf.outputLength <= 0 {
+		return defaultLength
+	}
+
+	return conf.outputLength
+}
+
+// SHA3_224 uses sha3-224 to hash data.
+func SHA3_224(data []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	sum := sha3.Sum224(data)
+	return conf.encoding.Encode(sum[:])
+}
+
+// SHA3_256 uses sha3-256 to hash data.
+func SHA3_256(data []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	sum := sha3.Sum256(data)
+	return conf.encoding.Encode(sum[:])
+}
+
+// SHA3_384 uses sha3-384 to hash data.
+func SHA3_384(data []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	sum := sha3.Sum384(data)
+	return conf.encoding.Encode(sum[:])
+}
+
+// SHA3_512 uses sha3-512 to hash data.
+func SHA3_512(data []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	sum := sha3.Sum512(data)
+	return conf.encoding.Encode(sum[:])
+}
+
+// SHAKE128 uses shake128 to hash data.
+// The output length is 32 bytes by default, and you can use WithOutputLength to change it.
+func SHAKE128(data []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	sum := sha3.SumSHAKE128(data, xofOutputLength(conf, shake128OutputLength))
+	return conf.encoding.Encode(sum)
+}
+
+// SHAKE256 uses shake256 to hash data.
+// The output length is 64 bytes by default, and you can use WithOutputLength to change it.
+func SHAKE256(data []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	sum := sha3.SumSHAKE256(data, xofOutputLength(conf, shake256OutputLength))
+	return conf.encoding.Encode(sum)
+}
+
+// CSHAKE128 uses cshake128 with function name and customization to hash data.
+// The function name is reserved for functions defined by NIST, so it's usually nil.
+// The output length is 32 bytes by default, and you can use WithOutputLength to change it.
+func CSHAKE128(data []byte, functionName []byte, customization []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	shake := sha3.NewCSHAKE128(functionName, customization)
+	shake.Write(data)
+
+	sum := make([]byte, xofOutputLength(conf, shake128OutputLength))
+	shake.Read(sum)
+	return conf.encoding.Encode(sum)
+}
+
+// CSHAKE256 uses cshake256 with function name and customization to hash data.
+// The function name is reserved for functions defined by NIST, so it's usually nil.
+// The output length is 64 bytes by default, and you can use WithOutputLength to change it.
+func CSHAKE256(data []byte, functionName []byte, customization []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	shake := sha3.NewCSHAKE256(functionName, customization)
+	shake.Write(data)
+
+	sum := make([]byte, xofOutputLength(conf, shake256OutputLength))
+	shake.Read(sum)
+	return conf.encoding.Encode(sum)
+}
+
+// Keccak256 uses legacy keccak-256 to hash data.
+// It's different from sha3-256 in padding and is used by ethereum.
+func Keccak256(data []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	keccak := xsha3.NewLegacyKeccak256()
+	keccak.Write(data)
+
+	sum := keccak.Sum(nil)
+	return conf.encoding.Encode(sum)
+}
@@ -0,0 +1,277 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"encoding/hex"
+	"slices"
+	"testing"
+)
+
+// go test -v -cover -run=^TestSHA3_224$
+func TestSHA3_224(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{107, 78, 3, 66, 54, 103, 219, 183, 59, 110, 21, 69, 79, 14, 177, 171, 212, 89, 127, 154, 27, 7, 142, 63, 91, 90, 107, 199},
+			HashDataHex:    []byte("6b4e03423667dbb73b6e15454f0eb1abd4597f9a1b078e3f5b5a6bc7"),
+			HashDataBase64: []byte("a04DQjZn27c7bhVFTw6xq9RZf5obB44/W1prxw=="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{96, 43, 220, 32, 65, 64, 219, 1, 107, 238, 83, 116, 137, 94, 85, 104, 206, 66, 47, 171, 225, 126, 6, 64, 97, 216, 0, 151},
+			HashDataHex:    []byte("602bdc204140db016bee5374895e5568ce422fabe17e064061d80097"),
+			HashDataBase64: []byte("YCvcIEFA2wFr7lN0iV5VaM5CL6vhfgZAYdgAlw=="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{153, 149, 28, 71, 205, 155, 90, 24, 17, 235, 153, 8, 221, 124, 170, 10, 24, 85, 1, 14, 252, 125, 33, 31, 143, 41, 129, 252},
+			HashDataHex:    []byte("99951c47cd9b5a1811eb9908dd7caa0a1855010efc7d211f8f2981fc"),
+			HashDataBase64: []byte("mZUcR82bWhgR65kI3XyqChhVAQ78fSEfjymB/A=="),
+		},
+	}
+
+	if err := testHash(t.Name(), SHA3_224, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSHA3_256$
+func TestSHA3_256(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{167, 255, 198, 248, 191, 30, 215, 102, 81, 193, 71, 86, 160, 97, 214, 98, 245, 128, 255, 77, 228, 59, 73, 250, 130, 216, 10, 75, 128, 248, 67, 74},
+			HashDataHex:    []byte("a7ffc6f8bf1ed76651c14756a061d662f580ff4de43b49fa82d80a4b80f8434a"),
+			HashDataBase64: []byte("p//G+L8e12ZRwUdWoGHWYvWA/03kO0n6gtgKS4D4Q0o="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{160, 58, 177, 155, 134, 111, 197, 133, 181, 203, 24, 18, 162, 246, 60, 168, 97, 231, 231, 100, 62, 229, 212, 63, 215, 16, 107, 98, 55, 37, 253, 103},
+			HashDataHex:    []byte("a03ab19b866fc585b5cb1812a2f63ca861e7e7643ee5d43fd7106b623725fd67"),
+			HashDataBase64: []byte("oDqxm4ZvxYW1yxgSovY8qGHn52Q+5dQ/1xBrYjcl/Wc="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{217, 47, 130, 182, 5, 249, 50, 123, 18, 196, 60, 233, 150, 249, 231, 190, 110, 172, 36, 152, 184, 195, 52, 90, 191, 182, 33, 220, 130, 83, 252, 239},
+			HashDataHex:    []byte("d92f82b605f9327b12c43ce996f9e7be6eac2498b8c3345abfb621dc8253fcef"),
+			HashDataBase64: []byte("2S+CtgX5MnsSxDzplvnnvm6sJJi4wzRav7Yh3IJT/O8="),
+		},
+	}
+
+	if err := testHash(t.Name(), SHA3_256, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSHA3_384$
+func TestSHA3_384(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{12, 99, 167, 91, 132, 94, 79, 125, 1, 16, 125, 133, 46, 76, 36, 133, 197, 26, 80, 170, 170, 148, 252, 97, 153, 94, 113, 187, 238, 152, 58, 42, 195, 113, 56, 49, 38, 74, 219, 71, 251, 107, 209, 224, 88, 213, 240, 4},
+			HashDataHex:    []byte("0c63a75b845e4f7d01107d852e4c2485c51a50aaaa94fc61995e71bbee983a2ac3713831264adb47fb6bd1e058d5f004"),
+			HashDataBase64: []byte("DGOnW4ReT30BEH2FLkwkhcUaUKqqlPxhmV5xu+6YOirDcTgxJkrbR/tr0eBY1fAE"),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{155, 217, 66, 209, 103, 138, 37, 208, 41, 177, 20, 48, 111, 94, 29, 174, 73, 254, 138, 190, 234, 205, 3, 207, 171, 15, 21, 106, 162, 227, 99, 201, 136, 177, 193, 40, 3, 212, 168, 201, 186, 56, 253, 200, 115, 229, 240, 7},
+			HashDataHex:    []byte("9bd942d1678a25d029b114306f5e1dae49fe8abeeacd03cfab0f156aa2e363c988b1c12803d4a8c9ba38fdc873e5f007"),
+			HashDataBase64: []byte("m9lC0WeKJdApsRQwb14drkn+ir7qzQPPqw8VaqLjY8mIscEoA9Soybo4/chz5fAH"),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{175, 213, 208, 36, 61, 222, 123, 71, 71, 145, 246, 5, 22, 57, 132, 193, 153, 141, 125, 230, 44, 135, 166, 204, 190, 18, 252, 149, 93, 96, 62, 95, 241, 120, 125, 51, 74, 175, 109, 58, 192, 198, 148, 57, 15, 229, 87, 195},
+			HashDataHex:    []byte("afd5d0243dde7b474791f605163984c1998d7de62c87a6ccbe12fc955d603e5ff1787d334aaf6d3ac0c694390fe557c3"),
+			HashDataBase64: []byte("r9XQJD3ee0dHkfYFFjmEwZmNfeYsh6bMvhL8lV1gPl/xeH0zSq9tOsDGlDkP5VfD"),
+		},
+	}
+
+	if err := testHash(t.Name(), SHA3_384, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSHA3_512$
+func TestSHA3_512(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{166, 159, 115, 204, 162, 58, 154, 197, 200, 181, 103, 220, 24, 90, 117, 110, 151, 201, 130, 22, 79, 226, 88, 89, 224, 209, 220, 193, 71, 92, 128, 166, 21, 178, 18, 58, 241, 245, 249, 76, 17, 227, 233, 64, 44, 58, 197, 88, 245, 0, 25, 157, 149, 182, 211, 227, 1, 117, 133, 134, 40, 29, 205, 38},
+			HashDataHex:    []byte("a69f73cca23a9ac5c8b567dc185a756e97c982164fe25859e0d1dcc1475c80a615b2123af1f5f94c11e3e9402c3ac558f500199d95b6d3e301758586281dcd26"),
+			HashDataBase64: []byte("pp9zzKI6msXItWfcGFp1bpfJghZP4lhZ4NHcwUdcgKYVshI68fX5TBHj6UAsOsVY9QAZnZW20+MBdYWGKB3NJg=="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{72, 200, 148, 127, 105, 192, 84, 165, 202, 169, 52, 103, 76, 232, 136, 29, 2, 187, 24, 251, 89, 213, 166, 62, 234, 221, 255, 115, 91, 14, 152, 1, 232, 114, 148, 120, 50, 129, 174, 73, 252, 130, 135, 160, 253, 134, 119, 155, 39, 215, 151, 45, 62, 132, 240, 250, 13, 130, 109, 124, 182, 125, 254, 252},
+			HashDataHex:    []byte("48c8947f69c054a5caa934674ce8881d02bb18fb59d5a63eeaddff735b0e9801e87294783281ae49fc8287a0fd86779b27d7972d3e84f0fa0d826d7cb67dfefc"),
+			HashDataBase64: []byte("SMiUf2nAVKXKqTRnTOiIHQK7GPtZ1aY+6t3/c1sOmAHocpR4MoGuSfyCh6D9hnebJ9eXLT6E8PoNgm18tn3+/A=="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{149, 211, 65, 123, 124, 231, 218, 135, 218, 107, 81, 108, 132, 203, 116, 123, 66, 123, 142, 108, 168, 16, 181, 176, 187, 84, 104, 44, 169, 24, 120, 77, 75, 58, 105, 220, 36, 94, 45, 254, 169, 46, 227, 214, 13, 98, 235, 223, 167, 63, 61, 6, 182, 198, 169, 186, 157, 174, 202, 75, 107, 86, 83, 73},
+			HashDataHex:    []byte("95d3417b7ce7da87da6b516c84cb747b427b8e6ca810b5b0bb54682ca918784d4b3a69dc245e2dfea92ee3d60d62ebdfa73f3d06b6c6a9ba9daeca4b6b565349"),
+			HashDataBase64: []byte("ldNBe3zn2ofaa1FshMt0e0J7jmyoELWwu1RoLKkYeE1LOmncJF4t/qku49YNYuvfpz89BrbGqbqdrspLa1ZTSQ=="),
+		},
+	}
+
+	if err := testHash(t.Name(), SHA3_512, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSHAKE128$
+func TestSHAKE128(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{127, 156, 43, 164, 232, 143, 130, 125, 97, 96, 69, 80, 118, 5, 133, 62, 215, 59, 128, 147, 246, 239, 188, 136, 235, 26, 110, 172, 250, 102, 239, 38},
+			HashDataHex:    []byte("7f9c2ba4e88f827d616045507605853ed73b8093f6efbc88eb1a6eacfa66ef26"),
+			HashDataBase64: []byte("f5wrpOiPgn1hYEVQdgWFPtc7gJP277yI6xpurPpm7yY="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{214, 185, 189, 189, 161, 76, 56, 88, 195, 109, 90, 244, 23, 253, 8, 59, 252, 139, 25, 176, 191, 83, 88, 49, 160, 154, 5, 125, 155, 110, 110, 66},
+			HashDataHex:    []byte("d6b9bdbda14c3858c36d5af417fd083bfc8b19b0bf535831a09a057d9b6e6e42"),
+			HashDataBase64: []byte("1rm9vaFMOFjDbVr0F/0IO/yLGbC/U1gxoJoFfZtubkI="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{10, 111, 224, 107, 33, 222, 206, 83, 129, 186, 27, 88, 18, 208, 161, 2, 162, 110, 57, 69, 1, 117, 47, 124, 185, 33, 236, 210, 88, 161, 115, 47},
+			HashDataHex:    []byte("0a6fe06b21dece5381ba1b5812d0a102a26e394501752f7cb921ecd258a1732f"),
+			HashDataBase64: []byte("Cm/gayHezlOBuhtYEtChAqJuOUUBdS98uSHs0lihcy8="),
+		},
+	}
+
+	if err := testHash(t.Name(), SHAKE128, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSHAKE256$
+func TestSHAKE256(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{70, 185, 221, 43, 11, 168, 141, 19, 35, 59, 63, 235, 116, 62, 235, 36, 63, 205, 82, 234, 98, 184, 27, 130, 181, 12, 39, 100, 110, 213, 118, 47, 215, 93, 196, 221, 216, 192, 242, 0, 203, 5, 1, 157, 103, 181, 146, 246, 252, 130, 28, 73, 71, 154, 180, 134, 64, 41, 46, 172, 179, 183, 196, 190},
+			HashDataHex:    []byte("46b9dd2b0ba88d13233b3feb743eeb243fcd52ea62b81b82b50c27646ed5762fd75dc4ddd8c0f200cb05019d67b592f6fc821c49479ab48640292eacb3b7c4be"),
+			HashDataBase64: []byte("RrndKwuojRMjOz/rdD7rJD/NUupiuBuCtQwnZG7Vdi/XXcTd2MDyAMsFAZ1ntZL2/IIcSUeatIZAKS6ss7fEvg=="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{222, 70, 232, 135, 114, 115, 83, 218, 55, 123, 99, 237, 78, 123, 71, 37, 209, 129, 148, 66, 174, 114, 132, 246, 145, 212, 19, 232, 29, 224, 62, 42, 204, 85, 198, 231, 61, 133, 126, 83, 150, 179, 223, 21, 222, 244, 201, 4, 174, 87, 1, 10, 86, 128, 104, 86, 129, 117, 196, 10, 174, 206, 108, 104},
+			HashDataHex:    []byte("de46e887727353da377b63ed4e7b4725d1819442ae7284f691d413e81de03e2acc55c6e73d857e5396b3df15def4c904ae57010a568068568175c40aaece6c68"),
+			HashDataBase64: []byte("3kboh3JzU9o3e2PtTntHJdGBlEKucoT2kdQT6B3gPirMVcbnPYV+U5az3xXe9MkErlcBClaAaFaBdcQKrs5saA=="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{237, 12, 226, 220, 35, 92, 61, 62, 77, 213, 81, 103, 140, 81, 22, 37, 218, 173, 215, 213, 122, 28, 132, 34, 205, 215, 47, 185, 51, 173, 228, 155, 7, 133, 43, 143, 244, 236, 219, 104, 194, 158, 115, 204, 197, 167, 68, 92, 92, 105, 156, 231, 202, 129, 55, 15, 135, 225, 14, 109, 239, 34, 230, 4},
+			HashDataHex:    []byte("ed0ce2dc235c3d3e4dd551678c511625daadd7d57a1c8422cdd72fb933ade49b07852b8ff4ecdb68c29e73ccc5a7445c5c699ce7ca81370f87e10e6def22e604"),
+			HashDataBase64: []byte("7Qzi3CNcPT5N1VFnjFEWJdqt19V6HIQizdcvuTOt5JsHhSuP9OzbaMKec8zFp0RcXGmc58qBNw+H4Q5t7yLmBA=="),
+		},
+	}
+
+	if err := testHash(t.Name(), SHAKE256, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestKeccak256$
+func TestKeccak256(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{197, 210, 70, 1, 134, 247, 35, 60, 146, 126, 125, 178, 220, 199, 3, 192, 229, 0, 182, 83, 202, 130, 39, 59, 123, 250, 216, 4, 93, 133, 164, 112},
+			HashDataHex:    []byte("c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"),
+			HashDataBase64: []byte("xdJGAYb3IzySfn2y3McDwOUAtlPKgic7e/rYBF2FpHA="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{100, 230, 4, 120, 124, 191, 25, 72, 65, 231, 182, 141, 124, 210, 135, 134, 246, 201, 160, 163, 171, 159, 139, 10, 14, 135, 203, 67, 135, 171, 1, 7},
+			HashDataHex:    []byte("64e604787cbf194841e7b68d7cd28786f6c9a0a3ab9f8b0a0e87cb4387ab0107"),
+			HashDataBase64: []byte("ZOYEeHy/GUhB57aNfNKHhvbJoKOrn4sKDofLQ4erAQc="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{81, 2, 140, 2, 32, 46, 207, 97, 183, 110, 69, 3, 246, 20, 191, 92, 181, 116, 184, 107, 36, 111, 143, 143, 232, 5, 135, 179, 149, 144, 22, 226},
+			HashDataHex:    []byte("51028c02202ecf61b76e4503f614bf5cb574b86b246f8f8fe80587b3959016e2"),
+			HashDataBase64: []byte("UQKMAiAuz2G3bkUD9hS/XLV0uGskb4+P6AWHs5WQFuI="),
+		},
+	}
+
+	if err := testHash(t.Name(), Keccak256, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSHAKEOutputLength$
+func TestSHAKEOutputLength(t *testing.T) {
+	data := []byte("你好，世界")
+
+	for _, length := range []int{1, 16, 32, 64, 200} {
+		got := SHAKE128(data, WithOutputLength(length))
+		if len(got) != length {
+			t.Fatalf("len(got) %d != length %d", len(got), length)
+		}
+
+		expect := SHAKE128(data, WithOutputLength(256))
+		if !slices.Equal(got, expect[:length]) {
+			t.Fatalf("got %+v != expect %+v", got, expect[:length])
+		}
+
+		got = SHAKE256(data, WithOutputLength(length))
+		if len(got) != length {
+			t.Fatalf("len(got) %d != length %d", len(got), length)
+		}
+
+		expect = SHAKE256(data, WithOutputLength(256))
+		if !slices.Equal(got, expect[:length]) {
+			t.Fatalf("got %+v != expect %+v", got, expect[:length])
+		}
+	}
+}
+
+// go test -v -cover -run=^TestCSHAKE$
+func TestCSHAKE(t *testing.T) {
+	// The vectors come from cshake samples of SP 800-185.
+	data := []byte{0x00, 0x01, 0x02, 0x03}
+	customization := []byte("Email Signature")
+
+	got := CSHAKE128(data, nil, customization, WithHex())
+	expect := []byte("c1c36925b6409a04f1b504fcbca9d82b4017277cb5ed2b2065fc1d3814d5aaf5")
+	if !slices.Equal(got, expect) {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = CSHAKE256(data, nil, customization, WithHex())
+	expect = []byte("d008828e2b80ac9d2218ffee1d070c48b8e4c87bff32c9699d5b6896eee0edd164020e2be0560858d9c00c037e34a96937c561a74c412bb4c746469527281c8c")
+	if !slices.Equal(got, expect) {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	// Cshake without function name and customization is equal to shake.
+	got = CSHAKE128(data, nil, nil, WithOutputLength(100))
+	expect = SHAKE128(data, WithOutputLength(100))
+	if !slices.Equal(got, expect) {
+		t.Fatalf("got %+v != expect %+v", got, expect)
+	}
+
+	got = CSHAKE256(data, nil, nil)
+	expect = SHAKE256(data)
+	if !slices.Equal(got, expect) {
+		t.Fatalf("got %+v != expect %+v", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestKeccak256Ethereum$
+func TestKeccak256Ethereum(t *testing.T) {
+	// The selector of transfer(address,uint256) in erc20 is the first 4 bytes of keccak-256.
+	got := Keccak256([]byte("transfer(address,uint256)"))
+
+	selector := hex.EncodeToString(got[:4])
+	if selector != "a9059cbb" {
+		t.Fatalf("selector %s != expect %s", selector, "a9059cbb")
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+const (
+	sm3Size      = 32
+	sm3BlockSize = 64
+)
+
+var sm3IV = [8]uint32{
+	0x7380166f, 0x4914b2b9, 0x172442d7, 0xda8a0600, 0xa96f30bc, 0x163138aa, 0xe38dee4d, 0xb0fb0e4e,
+}
+
+// sm3T is the constant T rotated left j bits of round j.
+var sm3T = func() (t [64]uint32) {
+	for j := range t {
+		if j < 16 {
+			t[j] = bits.RotateLeft32(0x79cc4519, j)
+		} else {
+			t[j] = bits.RotateLeft32(0x7a879d8a, j%32)
+		}
+	}
+
+	return t
+}()
+
+type sm3 struct {
+	h      [8]uint32
+	buffer [sm3BlockSize]byte
+	offset int
+	length uint64
+}
+
+// NewSM3 returns a sm3 hash.Hash defined in GB/T 32905-2016.
+func NewSM3() hash.Hash {
+	s := new(sm3)
+	s.Reset()
+	return s
+}
+
+func (s *sm3) Reset() {
+	s.h = sm3IV
+	s.buffer = [sm3BlockSize]byte{}
+	s.offset = 0
+	s.length = 0
+}
+
+func (s *sm3) Size() int {
+	return sm3Size
+}
+
+func (s *sm3) BlockSize() int {
+	return sm3BlockSize
+}
+
+func (s *sm3) Write(p []byte) (int, error) {
+	n := len(p)
+	s.length += uint64(n)
+
+	if s.offset > 0 {
+		copied := copy(s.buffer[s.offset:], p)
+		s.offset += copied
+		p = p[copied:]
+
+		if s.offset < sm3BlockSize {
+			return n, nil
+		}
+
+		s.compress(s.buffer[:])
+		s.offset = 0
+	}
+
+	for len(p) >= sm3BlockSize {
+		s.compress(p[:sm3BlockSize])
+		p = p[sm3BlockSize:]
+	}
+
+	s.offset = copy(s.buffer[:], p)
+	return n, nil
+}
+
+func (s *sm3) Sum(in []byte) []byte {
+	final := *s
+
+	// Pad with 0x80, zeros and the length in bits so the length is a multiple of block size.
+	var padding [sm3BlockSize + 8]byte
+	padding[0] = 0x80
+
+	paddingLen := sm3BlockSize - 8 - int(s.length%sm3BlockSize)
+	if paddingLen <= 0 {
+		paddingLen += sm3BlockSize
+	}
+
+	binary.BigEndian.PutUint64(padding[paddingLen:], s.length*8)
+	final.Write(padding[:paddingLen+8])
+
+	var sum [sm3Size]byte
+	for i, h := range final.h {
+		binary.BigEndian.PutUint32(sum[i*4:], h)
+	}
+
+	return append(in, sum[:]...)
+}
+
+func sm3P0(x uint32) uint32 {
+	return x ^ bits.RotateLeft32(x, 9) ^ bits.RotateLeft32(x, 17)
+}
+
+func sm3P1(x uint32) uint32 {
+	return x ^ bits.RotateLeft32(x, 15) ^ bits.RotateLeft32(x, 23)
+}
+
+func (s *sm3) compress(block []byte) {
+	var w [68]uint32
+	for j := 0; j < 16; j++ {
+		w[j] = binary.BigEndian.Uint32(block[j*4:])
+	}
+
+	for j := 16; j < 68; j++ {
+		w[j] = sm3P1(w[j-16]^w[j-9]^bits.RotateLeft32(w[j-3], 15)) ^ bits.RotateLeft32(w[j-13], 7) ^ w[j-6]
+	}
+
+	a, b, c, d, e, f, g, h := s.h[0], s.h[1], s.h[2], s.h[3], s.h[4], s.h[5], s.h[6], s.h[7]
+
+	for j := 0; j < 16; j++ {
+		a12 := bits.RotateLeft32(a, 12)
+		ss1 := bits.RotateLeft32(a12+e+sm3T[j], 7)
+		ss2 := ss1 ^ a12
+		tt1 := (a ^ b ^ c) + d + ss2 + (w[j] ^ w[j+4])
+		tt2 := (e ^ f ^ g) + h + ss1 + w[j]
+
+		a, b, c, d = tt1, a, bits.RotateLeft32(b, 9), c
+		e, f, g, h = sm3P0(tt2), e, bits.RotateLeft32(f, 19), g
+	}
+
+	for j := 16; j < 64; j++ {
+		a12 := bits.RotateLeft32(a, 12)
+		ss1 := bits.RotateLeft32(a12+e+sm3T[j], 7)
+		ss2 := ss1 ^ a12
+		tt1 := ((a & b) | (a & c) | (b & c)) + d + ss2 + (w[j] ^ w[j+4])
+		tt2 := ((e & f) | (^e & g)) + h + ss1 + w[j]
+
+		a, b, c, d = tt1, a, bits.RotateLeft32(b, 9), c
+		e, f, g, h = sm3P0(tt2), e, bits.RotateLeft32(f, 19), g
+	}
+
+	s.h[0] ^= a
+	s.h[1] ^= b
+	s.h[2] ^= c
+	s.h[3] ^= d
+	s.h[4] ^= e
+	s.h[5] ^= f
+	s.h[6] ^= g
+	s.h[7] ^= h
+}
+
+// SM3 uses sm3 to hash data.
+func SM3(data []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	h := NewSM3()
+	h.Write(data)
+
+	sum := h.Sum(nil)
+	return conf.encoding.Encode(sum)
+}
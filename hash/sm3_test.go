@@ -0,0 +1,100 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"bytes"
+	"encoding/hex"
+	"slices"
+	"testing"
+)
+
+// go test -v -cover -run=^TestSM3$
+func TestSM3(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{26, 178, 29, 131, 85, 207, 161, 127, 142, 97, 25, 72, 49, 232, 26, 143, 34, 190, 200, 199, 40, 254, 251, 116, 126, 208, 53, 235, 80, 130, 170, 43},
+			HashDataHex:    []byte("1ab21d8355cfa17f8e61194831e81a8f22bec8c728fefb747ed035eb5082aa2b"),
+			HashDataBase64: []byte("GrIdg1XPoX+OYRlIMegajyK+yMco/vt0ftA161CCqis="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{110, 15, 158, 20, 52, 76, 84, 6, 160, 207, 90, 59, 77, 251, 102, 95, 135, 244, 167, 113, 163, 31, 126, 219, 181, 199, 40, 116, 163, 43, 41, 87},
+			HashDataHex:    []byte("6e0f9e14344c5406a0cf5a3b4dfb665f87f4a771a31f7edbb5c72874a32b2957"),
+			HashDataBase64: []byte("bg+eFDRMVAagz1o7TftmX4f0p3GjH37btccodKMrKVc="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{196, 178, 107, 169, 251, 1, 232, 160, 56, 132, 198, 206, 179, 166, 202, 174, 75, 120, 239, 131, 145, 187, 137, 119, 227, 66, 102, 42, 50, 176, 66, 117},
+			HashDataHex:    []byte("c4b26ba9fb01e8a03884c6ceb3a6caae4b78ef8391bb8977e342662a32b04275"),
+			HashDataBase64: []byte("xLJrqfsB6KA4hMbOs6bKrkt474ORu4l340JmKjKwQnU="),
+		},
+	}
+
+	if err := testHash(t.Name(), SM3, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSM3GBT32905$
+func TestSM3GBT32905(t *testing.T) {
+	// The vectors come from the examples in appendix A of GB/T 32905-2016.
+	testCases := map[string]string{
+		"abc":                                    "66c7f0f462eeedd9d1f2d46bdc10e4e24167c4875cf2f7a2297da02b8f4ba8e0",
+		string(bytes.Repeat([]byte("abcd"), 16)): "debe9ff92275b8a138604889c18e5a4d6fdb70e5387e5765293dcba39c0c5732",
+	}
+
+	for data, expect := range testCases {
+		got := SM3([]byte(data), WithHex())
+		if string(got) != expect {
+			t.Fatalf("data %q: got %s != expect %s", data, got, expect)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSM3Stream$
+func TestSM3Stream(t *testing.T) {
+	data := newBLAKE2TestData(1000)
+	expect := "b38fc481302b502c3f2f6608d060c47c5b6bd8fd65e148b7cd3af4988245f48a"
+
+	h := NewSM3()
+	if h.Size() != 32 || h.BlockSize() != 64 {
+		t.Fatalf("size %d or block size %d is wrong", h.Size(), h.BlockSize())
+	}
+
+	for _, n := range []int{1, 7, 55, 56, 63, 64, 65, 1000} {
+		h.Reset()
+
+		// Write data in small pieces and sum in the middle to make sure sum doesn't change the state.
+		for _, chunk := range slices.Collect(slices.Chunk(data, n)) {
+			h.Write(chunk)
+			h.Sum(nil)
+		}
+
+		got := hex.EncodeToString(h.Sum(nil))
+		if got != expect {
+			t.Fatalf("chunk size %d: got %s != expect %s", n, got, expect)
+		}
+	}
+
+	// The padding needs an extra block if the length mod 64 is in [56, 64).
+	paddingCases := map[int]string{
+		55:  "a79cf9dcee3404abf7f769698201647fd9d3ff61d629d0f58bb4b5579a427db8",
+		56:  "62f7363b15f4de76dd925c493b9d6d00d4ba0ef2a1f334c1d0f13b293aeb40d1",
+		57:  "441f67cc31781dd2986fc612b92dfade871d81357f2487f5c86d94a8c6778d82",
+		63:  "6165e4cbb15cde01c6226e0015a47f710f8f8e1f2c296700033bb34d9212109c",
+		64:  "93566f236d157aae078d1ddb5cebdbba1520b5142e22a8915564345ba2ae1d63",
+		119: "8f3ea392a89a7119982d6634660db1a95f35d68267a2235e3255998a857f4fbf",
+		120: "6babee35e6a1515af9d6255109c24f3c08897829422c6225d235fd4c8527e9ec",
+	}
+
+	for n, expect := range paddingCases {
+		got := SM3(data[:n], WithHex())
+		if string(got) != expect {
+			t.Fatalf("length %d: got %s != expect %s", n, got, expect)
+		}
+	}
+}
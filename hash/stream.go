@@ -0,0 +1,306 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"crypto/sha3"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+	"hash/fnv"
+	"io"
+	"os"
+)
+
+type progressWriter struct {
+	progress Progress
+	read     int64
+	total    int64
+}
+
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	pw.read += int64(len(p))
+	pw.progress(pw.read, pw.total)
+	return len(p), nil
+}
+
+// copyToHashes reads all data from reader and writes them to hashes in a single pass.
+func copyToHashes(reader io.Reader, total int64, conf *Config, hashes ...io.Writer) error {
+	if conf.bufferSize <= 0 {
+		return fmt.Errorf("cryptox/hash: buffer size %d <= 0", conf.bufferSize)
+	}
+
+	writers := make([]io.Writer, 0, len(hashes)+1)
+	writers = append(writers, hashes...)
+
+	if conf.progress != nil {
+		writers = append(writers, &progressWriter{progress: conf.progress, total: total})
+	}
+
+	buffer := make([]byte, conf.bufferSize)
+	writer := io.MultiWriter(writers...)
+
+	// Hide the WriterTo of reader like *os.File so the buffer will be used.
+	_, err := io.CopyBuffer(writer, struct{ io.Reader }{reader}, buffer)
+	return err
+}
+
+func hashReader(reader io.Reader, total int64, newHash func() hash.Hash, conf *Config) ([]byte, error) {
+	h := newHash()
+	if err := copyToHashes(reader, total, conf, h); err != nil {
+		return nil, err
+	}
+
+	sum := h.Sum(nil)
+	return conf.encoding.Encode(sum), nil
+}
+
+// Reader uses the algorithm to hash all data read from reader.
+func Reader(reader io.Reader, algorithm Algorithm, opts ...Option) ([]byte, error) {
+	newHash, ok := algorithms[algorithm]
+	if !ok {
+		return nil, fmt.Errorf("cryptox/hash: algorithm %q not supported", algorithm)
+	}
+
+	conf := newConfig().Apply(opts...)
+	return hashReader(reader, -1, newHash, conf)
+}
+
+// MD5Reader uses md5 to hash all data read from reader.
+func MD5Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmMD5, opts...)
+}
+
+// SHA1Reader uses sha1 to hash all data read from reader.
+func SHA1Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmSHA1, opts...)
+}
+
+// SHA224Reader uses sha224 to hash all data read from reader.
+func SHA224Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmSHA224, opts...)
+}
+
+// SHA256Reader uses sha256 to hash all data read from reader.
+func SHA256Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmSHA256, opts...)
+}
+
+// SHA384Reader uses sha384 to hash all data read from reader.
+func SHA384Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmSHA384, opts...)
+}
+
+// SHA512Reader uses sha512 to hash all data read from reader.
+func SHA512Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmSHA512, opts...)
+}
+
+// Fnv128Reader uses fnv-1/128bit to hash all data read from reader.
+func Fnv128Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmFnv128, opts...)
+}
+
+// Fnv128aReader uses fnv-1a/128bit to hash all data read from reader.
+func Fnv128aReader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmFnv128a, opts...)
+}
+
+// SHA3_224Reader uses sha3-224 to hash all data read from reader.
+func SHA3_224Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmSHA3_224, opts...)
+}
+
+// SHA3_256Reader uses sha3-256 to hash all data read from reader.
+func SHA3_256Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmSHA3_256, opts...)
+}
+
+// SHA3_384Reader uses sha3-384 to hash all data read from reader.
+func SHA3_384Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmSHA3_384, opts...)
+}
+
+// SHA3_512Reader uses sha3-512 to hash all data read from reader.
+func SHA3_512Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmSHA3_512, opts...)
+}
+
+// Keccak256Reader uses legacy keccak-256 to hash all data read from reader.
+func Keccak256Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmKeccak256, opts...)
+}
+
+// SM3Reader uses sm3 to hash all data read from reader.
+func SM3Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return Reader(reader, AlgorithmSM3, opts...)
+}
+
+func shakeReader(reader io.Reader, shake *sha3.SHAKE, defaultLength int, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+	if err := copyToHashes(reader, -1, conf, shake); err != nil {
+		return nil, err
+	}
+
+	sum := make([]byte, xofOutputLength(conf, defaultLength))
+	shake.Read(sum)
+	return conf.encoding.Encode(sum), nil
+}
+
+// SHAKE128Reader uses shake128 to hash all data read from reader.
+// The output length is 32 bytes by default, and you can use WithOutputLength to change it.
+func SHAKE128Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return shakeReader(reader, sha3.NewSHAKE128(), shake128OutputLength, opts...)
+}
+
+// SHAKE256Reader uses shake256 to hash all data read from reader.
+// The output length is 64 bytes by default, and you can use WithOutputLength to change it.
+func SHAKE256Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	return shakeReader(reader, sha3.NewSHAKE256(), shake256OutputLength, opts...)
+}
+
+func sum32Reader(reader io.Reader, h hash.Hash32, opts ...Option) (uint32, error) {
+	conf := newConfig().Apply(opts...)
+	if err := copyToHashes(reader, -1, conf, h); err != nil {
+		return 0, err
+	}
+
+	return h.Sum32(), nil
+}
+
+func sum64Reader(reader io.Reader, h hash.Hash64, opts ...Option) (uint64, error) {
+	conf := newConfig().Apply(opts...)
+	if err := copyToHashes(reader, -1, conf, h); err != nil {
+		return 0, err
+	}
+
+	return h.Sum64(), nil
+}
+
+// CRC32Reader uses given table to checksum all data read from reader.
+// Use IEEE table if passed table is nil.
+func CRC32Reader(reader io.Reader, table *crc32.Table, opts ...Option) (uint32, error) {
+	if table == nil {
+		table = tableIEEE
+	}
+
+	return sum32Reader(reader, crc32.New(table), opts...)
+}
+
+// CRC64Reader uses given table to checksum all data read from reader.
+// Use ISO table if passed table is nil.
+func CRC64Reader(reader io.Reader, table *crc64.Table, opts ...Option) (uint64, error) {
+	if table == nil {
+		table = tableISO
+	}
+
+	return sum64Reader(reader, crc64.New(table), opts...)
+}
+
+// Fnv32Reader uses fnv-1/32bit to hash all data read from reader.
+func Fnv32Reader(reader io.Reader, opts ...Option) (uint32, error) {
+	return sum32Reader(reader, fnv.New32(), opts...)
+}
+
+// Fnv32aReader uses fnv-1a/32bit to hash all data read from reader.
+func Fnv32aReader(reader io.Reader, opts ...Option) (uint32, error) {
+	return sum32Reader(reader, fnv.New32a(), opts...)
+}
+
+// Fnv64Reader uses fnv-1/64bit to hash all data read from reader.
+func Fnv64Reader(reader io.Reader, opts ...Option) (uint64, error) {
+	return sum64Reader(reader, fnv.New64(), opts...)
+}
+
+// Fnv64aReader uses fnv-1a/64bit to hash all data read from reader.
+func Fnv64aReader(reader io.Reader, opts ...Option) (uint64, error) {
+	return sum64Reader(reader, fnv.New64a(), opts...)
+}
+
+// MultiReader uses all algorithms to hash data read from reader in a single pass.
+// The returned map contains the sum of each algorithm.
+func MultiReader(reader io.Reader, algorithms []Algorithm, opts ...Option) (map[Algorithm][]byte, error) {
+	conf := newConfig().Apply(opts...)
+	return multiReader(reader, -1, algorithms, conf)
+}
+
+func multiReader(reader io.Reader, total int64, algorithms []Algorithm, conf *Config) (map[Algorithm][]byte, error) {
+	if len(algorithms) == 0 {
+		return nil, errors.New("cryptox/hash: no algorithms to hash")
+	}
+
+	hashes := make([]hash.Hash, 0, len(algorithms))
+	writers := make([]io.Writer, 0, len(algorithms))
+	for _, algorithm := range algorithms {
+		h, err := algorithm.New()
+		if err != nil {
+			return nil, err
+		}
+
+		hashes = append(hashes, h)
+		writers = append(writers, h)
+	}
+
+	if err := copyToHashes(reader, total, conf, writers...); err != nil {
+		return nil, err
+	}
+
+	sums := make(map[Algorithm][]byte, len(algorithms))
+	for i, algorithm := range algorithms {
+		sum := hashes[i].Sum(nil)
+		sums[algorithm] = conf.encoding.Encode(sum)
+	}
+
+	return sums, nil
+}
+
+func openFile(path string) (*os.File, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, err
+	}
+
+	return file, info.Size(), nil
+}
+
+// File uses the algorithm to hash the file in path without reading it fully into memory.
+func File(path string, algorithm Algorithm, opts ...Option) ([]byte, error) {
+	newHash, ok := algorithms[algorithm]
+	if !ok {
+		return nil, fmt.Errorf("cryptox/hash: algorithm %q not supported", algorithm)
+	}
+
+	file, total, err := openFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	conf := newConfig().Apply(opts...)
+	return hashReader(file, total, newHash, conf)
+}
+
+// MultiFile uses all algorithms to hash the file in path in a single pass.
+// The returned map contains the sum of each algorithm.
+func MultiFile(path string, algorithms []Algorithm, opts ...Option) (map[Algorithm][]byte, error) {
+	file, total, err := openFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	conf := newConfig().Apply(opts...)
+	return multiReader(file, total, algorithms, conf)
+}
@@ -0,0 +1,302 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+)
+
+type testErrorReader struct{}
+
+func (testErrorReader) Read(p []byte) (int, error) {
+	return 0, errors.New("test error")
+}
+
+// go test -v -cover -run=^TestReader$
+func TestReader(t *testing.T) {
+	data := bytes.Repeat([]byte("你好，世界"), 1024)
+
+	testCases := map[string]struct {
+		hash       testHashFunc
+		hashReader func(reader *bytes.Reader, opts ...Option) ([]byte, error)
+	}{
+		"md5":       {hash: MD5, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return MD5Reader(reader, opts...) }},
+		"sha1":      {hash: SHA1, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHA1Reader(reader, opts...) }},
+		"sha224":    {hash: SHA224, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHA224Reader(reader, opts...) }},
+		"sha256":    {hash: SHA256, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHA256Reader(reader, opts...) }},
+		"sha384":    {hash: SHA384, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHA384Reader(reader, opts...) }},
+		"sha512":    {hash: SHA512, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHA512Reader(reader, opts...) }},
+		"sha3-224":  {hash: SHA3_224, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHA3_224Reader(reader, opts...) }},
+		"sha3-256":  {hash: SHA3_256, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHA3_256Reader(reader, opts...) }},
+		"sha3-384":  {hash: SHA3_384, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHA3_384Reader(reader, opts...) }},
+		"sha3-512":  {hash: SHA3_512, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHA3_512Reader(reader, opts...) }},
+		"shake128":  {hash: SHAKE128, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHAKE128Reader(reader, opts...) }},
+		"shake256":  {hash: SHAKE256, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return SHAKE256Reader(reader, opts...) }},
+		"keccak256": {hash: Keccak256, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return Keccak256Reader(reader, opts...) }},
+		"fnv128":    {hash: Fnv128, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return Fnv128Reader(reader, opts...) }},
+		"fnv128a":   {hash: Fnv128a, hashReader: func(reader *bytes.Reader, opts ...Option) ([]byte, error) { return Fnv128aReader(reader, opts...) }},
+	}
+
+	for name, testCase := range testCases {
+		for _, opts := range [][]Option{nil, {WithHex()}, {WithBase64()}, {WithOutputLength(100)}} {
+			expect := testCase.hash(data, opts...)
+
+			got, err := testCase.hashReader(bytes.NewReader(data), opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(got, expect) {
+				t.Fatalf("%s: got %s != expect %s", name, got, expect)
+			}
+		}
+	}
+
+	if _, err := Reader(bytes.NewReader(data), "unknown"); err == nil {
+		t.Fatal("reader with unknown algorithm should fail")
+	}
+
+	if _, err := SHA256Reader(testErrorReader{}); err == nil {
+		t.Fatal("reader with error reader should fail")
+	}
+
+	if _, err := SHA256Reader(bytes.NewReader(data), WithBufferSize(0)); err == nil {
+		t.Fatal("reader with buffer size 0 should fail")
+	}
+
+	if _, err := SHAKE128Reader(testErrorReader{}); err == nil {
+		t.Fatal("shake reader with error reader should fail")
+	}
+}
+
+// go test -v -cover -run=^TestChecksumReader$
+func TestChecksumReader(t *testing.T) {
+	data := bytes.Repeat([]byte("你好，世界"), 1024)
+
+	crc32Sum, err := CRC32Reader(bytes.NewReader(data), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if crc32Sum != CRC32IEEE(data) {
+		t.Fatalf("got %d != expect %d", crc32Sum, CRC32IEEE(data))
+	}
+
+	table := crc32.MakeTable(crc32.Castagnoli)
+
+	crc32Sum, err = CRC32Reader(bytes.NewReader(data), table)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if crc32Sum != CRC32(data, table) {
+		t.Fatalf("got %d != expect %d", crc32Sum, CRC32(data, table))
+	}
+
+	crc64Sum, err := CRC64Reader(bytes.NewReader(data), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if crc64Sum != CRC64ISO(data) {
+		t.Fatalf("got %d != expect %d", crc64Sum, CRC64ISO(data))
+	}
+
+	crc64Sum, err = CRC64Reader(bytes.NewReader(data), tableECMA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if crc64Sum != CRC64ECMA(data) {
+		t.Fatalf("got %d != expect %d", crc64Sum, CRC64ECMA(data))
+	}
+
+	fnv32Sums := map[string][2]uint32{}
+
+	sum32, err := Fnv32Reader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fnv32Sums["fnv32"] = [2]uint32{sum32, Fnv32(data)}
+
+	sum32, err = Fnv32aReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fnv32Sums["fnv32a"] = [2]uint32{sum32, Fnv32a(data)}
+
+	for name, sums := range fnv32Sums {
+		if sums[0] != sums[1] {
+			t.Fatalf("%s: got %d != expect %d", name, sums[0], sums[1])
+		}
+	}
+
+	fnv64Sums := map[string][2]uint64{}
+
+	sum64, err := Fnv64Reader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fnv64Sums["fnv64"] = [2]uint64{sum64, Fnv64(data)}
+
+	sum64, err = Fnv64aReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fnv64Sums["fnv64a"] = [2]uint64{sum64, Fnv64a(data)}
+
+	for name, sums := range fnv64Sums {
+		if sums[0] != sums[1] {
+			t.Fatalf("%s: got %d != expect %d", name, sums[0], sums[1])
+		}
+	}
+
+	if _, err = CRC32Reader(testErrorReader{}, nil); err == nil {
+		t.Fatal("crc32 reader with error reader should fail")
+	}
+
+	if _, err = CRC64Reader(testErrorReader{}, nil); err == nil {
+		t.Fatal("crc64 reader with error reader should fail")
+	}
+}
+
+// go test -v -cover -run=^TestMultiReader$
+func TestMultiReader(t *testing.T) {
+	data := bytes.Repeat([]byte("你好，世界"), 1024)
+	algorithms := []Algorithm{AlgorithmMD5, AlgorithmSHA256, AlgorithmCRC32}
+
+	sums, err := MultiReader(bytes.NewReader(data), algorithms, WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sums) != len(algorithms) {
+		t.Fatalf("len(sums) %d != len(algorithms) %d", len(sums), len(algorithms))
+	}
+
+	if !slices.Equal(sums[AlgorithmMD5], MD5(data, WithHex())) {
+		t.Fatalf("got %s != expect %s", sums[AlgorithmMD5], MD5(data, WithHex()))
+	}
+
+	if !slices.Equal(sums[AlgorithmSHA256], SHA256(data, WithHex())) {
+		t.Fatalf("got %s != expect %s", sums[AlgorithmSHA256], SHA256(data, WithHex()))
+	}
+
+	crc := binary.BigEndian.AppendUint32(nil, CRC32IEEE(data))
+	if !slices.Equal(sums[AlgorithmCRC32], encoding.Hex{}.Encode(crc)) {
+		t.Fatalf("got %s != expect %s", sums[AlgorithmCRC32], encoding.Hex{}.Encode(crc))
+	}
+
+	if _, err = MultiReader(bytes.NewReader(data), nil); err == nil {
+		t.Fatal("multi reader without algorithms should fail")
+	}
+
+	if _, err = MultiReader(bytes.NewReader(data), []Algorithm{"unknown"}); err == nil {
+		t.Fatal("multi reader with unknown algorithm should fail")
+	}
+
+	if _, err = MultiReader(testErrorReader{}, algorithms); err == nil {
+		t.Fatal("multi reader with error reader should fail")
+	}
+}
+
+// go test -v -cover -run=^TestFile$
+func TestFile(t *testing.T) {
+	data := bytes.Repeat([]byte("你好，世界"), 64*1024)
+	path := filepath.Join(t.TempDir(), "test.txt")
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var reads []int64
+	progress := func(read int64, total int64) {
+		if total != int64(len(data)) {
+			t.Fatalf("total %d != len(data) %d", total, len(data))
+		}
+
+		reads = append(reads, read)
+	}
+
+	got, err := File(path, AlgorithmSHA256, WithBase64(), WithProgress(progress), WithBufferSize(4096))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := SHA256(data, WithBase64())
+	if !slices.Equal(got, expect) {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if len(reads) < len(data)/4096 || reads[len(reads)-1] != int64(len(data)) {
+		t.Fatalf("reads %d or last read is wrong", len(reads))
+	}
+
+	if !slices.IsSorted(reads) {
+		t.Fatal("reads should be increasing")
+	}
+
+	sums, err := MultiFile(path, []Algorithm{AlgorithmMD5, AlgorithmSHA512})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(sums[AlgorithmMD5], MD5(data)) {
+		t.Fatalf("got %+v != expect %+v", sums[AlgorithmMD5], MD5(data))
+	}
+
+	if !slices.Equal(sums[AlgorithmSHA512], SHA512(data)) {
+		t.Fatalf("got %+v != expect %+v", sums[AlgorithmSHA512], SHA512(data))
+	}
+
+	notExist := filepath.Join(t.TempDir(), "not_exist.txt")
+
+	if _, err = File(notExist, AlgorithmSHA256); err == nil {
+		t.Fatal("file not exist should fail")
+	}
+
+	if _, err = File(path, "unknown"); err == nil {
+		t.Fatal("file with unknown algorithm should fail")
+	}
+
+	if _, err = MultiFile(notExist, []Algorithm{AlgorithmMD5}); err == nil {
+		t.Fatal("multi file not exist should fail")
+	}
+}
+
+// go test -v -cover -run=^TestReaderProgress$
+func TestReaderProgress(t *testing.T) {
+	data := bytes.Repeat([]byte("你好，世界"), 1024)
+
+	var last int64
+	progress := func(read int64, total int64) {
+		if total != -1 {
+			t.Fatalf("total %d != -1", total)
+		}
+
+		last = read
+	}
+
+	if _, err := MD5Reader(bytes.NewReader(data), WithProgress(progress)); err != nil {
+		t.Fatal(err)
+	}
+
+	if last != int64(len(data)) {
+		t.Fatalf("last %d != len(data) %d", last, len(data))
+	}
+}
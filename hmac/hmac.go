@@ -9,8 +9,11 @@ import (
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha256"
+	"crypto/sha3"
 	"crypto/sha512"
 	stdhash "hash"
+
+	cxhash "github.com/FishGoddess/cryptox/hash"
 )
 
 type hashFunc = func() stdhash.Hash
@@ -55,3 +58,28 @@ func SHA384(data []byte, key []byte, opts ...Option) []byte {
 func SHA512(data []byte, key []byte, opts ...Option) []byte {
 	return hash(sha512.New, data, key, opts...)
 }
+
+// SHA3_224 uses hmac-sha3-224 to hash data.
+func SHA3_224(data []byte, key []byte, opts ...Option) []byte {
+	return hash(func() stdhash.Hash { return sha3.New224() }, data, key, opts...)
+}
+
+// SHA3_256 uses hmac-sha3-256 to hash data.
+func SHA3_256(data []byte, key []byte, opts ...Option) []byte {
+	return hash(func() stdhash.Hash { return sha3.New256() }, data, key, opts...)
+}
+
+// SHA3_384 uses hmac-sha3-384 to hash data.
+func SHA3_384(data []byte, key []byte, opts ...Option) []byte {
+	return hash(func() stdhash.Hash { return sha3.New384() }, data, key, opts...)
+}
+
+// SHA3_512 uses hmac-sha3-512 to hash data.
+func SHA3_512(data []byte, key []byte, opts ...Option) []byte {
+	return hash(func() stdhash.Hash { return sha3.New512() }, data, key, opts...)
+}
+
+// SM3 uses hmac-sm3 to hash data.
+func SM3(data []byte, key []byte, opts ...Option) []byte {
+	return hash(cxhash.NewSM3, data, key, opts...)
+}
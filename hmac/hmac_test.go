@@ -199,3 +199,143 @@ func TestSHA512(t *testing.T) {
 
 	testHash(t.Name(), SHA512, testCases)
 }
+
+// go test -v -cover -run=^TestSHA3_224$
+func TestSHA3_224(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{143, 72, 30, 16, 170, 26, 176, 84, 249, 134, 45, 155, 44, 46, 194, 190, 81, 94, 200, 53, 94, 96, 196, 82, 239, 248, 62, 252},
+			HashDataHex:    []byte("8f481e10aa1ab054f9862d9b2c2ec2be515ec8355e60c452eff83efc"),
+			HashDataBase64: []byte("j0geEKoasFT5hi2bLC7CvlFeyDVeYMRS7/g+/A=="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{107, 26, 117, 254, 127, 48, 31, 60, 144, 42, 177, 133, 122, 7, 63, 48, 165, 210, 174, 45, 106, 46, 245, 156, 189, 191, 66, 213},
+			HashDataHex:    []byte("6b1a75fe7f301f3c902ab1857a073f30a5d2ae2d6a2ef59cbdbf42d5"),
+			HashDataBase64: []byte("axp1/n8wHzyQKrGFegc/MKXSri1qLvWcvb9C1Q=="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{57, 90, 41, 142, 43, 107, 253, 140, 162, 186, 132, 36, 18, 103, 120, 68, 185, 222, 151, 26, 233, 59, 13, 76, 133, 185, 232, 253},
+			HashDataHex:    []byte("395a298e2b6bfd8ca2ba842412677844b9de971ae93b0d4c85b9e8fd"),
+			HashDataBase64: []byte("OVopjitr/YyiuoQkEmd4RLnelxrpOw1Mhbno/Q=="),
+		},
+	}
+
+	if err := testHash(t.Name(), SHA3_224, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSHA3_256$
+func TestSHA3_256(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{116, 243, 192, 48, 236, 195, 106, 24, 53, 208, 74, 51, 62, 187, 127, 206, 38, 136, 192, 199, 143, 176, 188, 249, 89, 34, 19, 51, 28, 136, 76, 117},
+			HashDataHex:    []byte("74f3c030ecc36a1835d04a333ebb7fce2688c0c78fb0bcf9592213331c884c75"),
+			HashDataBase64: []byte("dPPAMOzDahg10EozPrt/ziaIwMePsLz5WSITMxyITHU="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{24, 94, 195, 137, 130, 197, 241, 35, 42, 227, 211, 130, 16, 139, 105, 9, 224, 90, 241, 197, 128, 238, 209, 18, 206, 245, 83, 67, 22, 24, 86, 22},
+			HashDataHex:    []byte("185ec38982c5f1232ae3d382108b6909e05af1c580eed112cef5534316185616"),
+			HashDataBase64: []byte("GF7DiYLF8SMq49OCEItpCeBa8cWA7tESzvVTQxYYVhY="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{218, 232, 7, 55, 210, 39, 7, 46, 221, 132, 146, 89, 27, 88, 186, 183, 55, 165, 167, 129, 139, 59, 221, 13, 142, 123, 75, 97, 114, 153, 52, 22},
+			HashDataHex:    []byte("dae80737d227072edd8492591b58bab737a5a7818b3bdd0d8e7b4b6172993416"),
+			HashDataBase64: []byte("2ugHN9InBy7dhJJZG1i6tzelp4GLO90NjntLYXKZNBY="),
+		},
+	}
+
+	if err := testHash(t.Name(), SHA3_256, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSHA3_384$
+func TestSHA3_384(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{145, 57, 186, 98, 60, 140, 82, 29, 10, 16, 59, 207, 134, 128, 65, 199, 63, 163, 10, 158, 137, 210, 165, 252, 169, 16, 42, 116, 139, 232, 109, 193, 88, 83, 182, 181, 12, 206, 58, 36, 192, 8, 188, 232, 129, 130, 0, 109},
+			HashDataHex:    []byte("9139ba623c8c521d0a103bcf868041c73fa30a9e89d2a5fca9102a748be86dc15853b6b50cce3a24c008bce88182006d"),
+			HashDataBase64: []byte("kTm6YjyMUh0KEDvPhoBBxz+jCp6J0qX8qRAqdIvobcFYU7a1DM46JMAIvOiBggBt"),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{103, 77, 206, 126, 176, 153, 236, 147, 168, 142, 202, 95, 78, 229, 237, 142, 32, 78, 172, 171, 10, 50, 49, 41, 132, 255, 224, 34, 187, 46, 48, 184, 212, 78, 72, 2, 116, 83, 4, 49, 74, 21, 191, 162, 108, 153, 122, 120},
+			HashDataHex:    []byte("674dce7eb099ec93a88eca5f4ee5ed8e204eacab0a32312984ffe022bb2e30b8d44e4802745304314a15bfa26c997a78"),
+			HashDataBase64: []byte("Z03OfrCZ7JOojspfTuXtjiBOrKsKMjEphP/gIrsuMLjUTkgCdFMEMUoVv6JsmXp4"),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{249, 200, 53, 160, 33, 112, 160, 135, 163, 121, 65, 117, 211, 223, 175, 132, 156, 237, 82, 64, 128, 41, 14, 110, 225, 182, 180, 91, 28, 98, 66, 114, 61, 63, 45, 128, 109, 75, 205, 111, 36, 43, 220, 249, 41, 248, 178, 167},
+			HashDataHex:    []byte("f9c835a02170a087a3794175d3dfaf849ced524080290e6ee1b6b45b1c6242723d3f2d806d4bcd6f242bdcf929f8b2a7"),
+			HashDataBase64: []byte("+cg1oCFwoIejeUF109+vhJztUkCAKQ5u4ba0WxxiQnI9Py2AbUvNbyQr3Pkp+LKn"),
+		},
+	}
+
+	if err := testHash(t.Name(), SHA3_384, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSHA3_512$
+func TestSHA3_512(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{117, 57, 17, 155, 99, 103, 170, 144, 43, 220, 111, 85, 141, 32, 201, 6, 214, 172, 189, 74, 186, 63, 211, 68, 235, 8, 176, 32, 1, 68, 161, 250, 69, 63, 246, 231, 145, 153, 98, 53, 139, 229, 63, 109, 178, 163, 32, 209, 133, 44, 82, 163, 222, 163, 233, 7, 7, 7, 117, 247, 169, 31, 18, 130},
+			HashDataHex:    []byte("7539119b6367aa902bdc6f558d20c906d6acbd4aba3fd344eb08b0200144a1fa453ff6e7919962358be53f6db2a320d1852c52a3dea3e907070775f7a91f1282"),
+			HashDataBase64: []byte("dTkRm2NnqpAr3G9VjSDJBtasvUq6P9NE6wiwIAFEofpFP/bnkZliNYvlP22yoyDRhSxSo96j6QcHB3X3qR8Sgg=="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{91, 117, 174, 83, 236, 77, 139, 152, 186, 129, 147, 219, 212, 19, 116, 133, 71, 131, 5, 241, 32, 189, 209, 168, 192, 133, 36, 83, 202, 55, 209, 246, 28, 235, 197, 116, 90, 229, 25, 126, 214, 64, 90, 61, 172, 173, 249, 93, 138, 152, 160, 146, 83, 150, 1, 9, 82, 114, 49, 101, 118, 144, 20, 31},
+			HashDataHex:    []byte("5b75ae53ec4d8b98ba8193dbd4137485478305f120bdd1a8c0852453ca37d1f61cebc5745ae5197ed6405a3dacadf95d8a98a09253960109527231657690141f"),
+			HashDataBase64: []byte("W3WuU+xNi5i6gZPb1BN0hUeDBfEgvdGowIUkU8o30fYc68V0WuUZftZAWj2srfldipigklOWAQlScjFldpAUHw=="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{156, 111, 251, 213, 100, 211, 36, 98, 245, 176, 207, 155, 72, 126, 119, 61, 0, 53, 179, 139, 28, 46, 233, 9, 189, 239, 233, 252, 177, 38, 81, 51, 224, 211, 227, 67, 38, 22, 225, 55, 94, 26, 129, 138, 194, 113, 86, 255, 42, 237, 100, 54, 69, 175, 52, 95, 46, 36, 208, 233, 93, 242, 232, 184},
+			HashDataHex:    []byte("9c6ffbd564d32462f5b0cf9b487e773d0035b38b1c2ee909bdefe9fcb1265133e0d3e3432616e1375e1a818ac27156ff2aed643645af345f2e24d0e95df2e8b8"),
+			HashDataBase64: []byte("nG/71WTTJGL1sM+bSH53PQA1s4scLukJve/p/LEmUTPg0+NDJhbhN14agYrCcVb/Ku1kNkWvNF8uJNDpXfLouA=="),
+		},
+	}
+
+	if err := testHash(t.Name(), SHA3_512, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSM3$
+func TestSM3(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:           []byte(""),
+			HashData:       []byte{77, 235, 41, 185, 190, 23, 189, 79, 210, 172, 162, 31, 144, 136, 133, 185, 248, 73, 188, 97, 232, 251, 209, 1, 224, 79, 217, 152, 117, 40, 212, 223},
+			HashDataHex:    []byte("4deb29b9be17bd4fd2aca21f908885b9f849bc61e8fbd101e04fd9987528d4df"),
+			HashDataBase64: []byte("Tespub4XvU/SrKIfkIiFufhJvGHo+9EB4E/ZmHUo1N8="),
+		},
+		{
+			Data:           []byte("123"),
+			HashData:       []byte{249, 9, 186, 31, 183, 4, 128, 36, 9, 49, 87, 182, 44, 156, 78, 18, 29, 118, 189, 141, 146, 103, 188, 30, 107, 166, 201, 98, 198, 157, 105, 56},
+			HashDataHex:    []byte("f909ba1fb7048024093157b62c9c4e121d76bd8d9267bc1e6ba6c962c69d6938"),
+			HashDataBase64: []byte("+Qm6H7cEgCQJMVe2LJxOEh12vY2SZ7wea6bJYsadaTg="),
+		},
+		{
+			Data:           []byte("你好，世界"),
+			HashData:       []byte{76, 234, 8, 38, 7, 30, 198, 111, 92, 54, 193, 190, 74, 180, 26, 158, 241, 7, 182, 57, 24, 241, 128, 92, 100, 221, 49, 185, 65, 68, 61, 219},
+			HashDataHex:    []byte("4cea0826071ec66f5c36c1be4ab41a9ef107b63918f1805c64dd31b941443ddb"),
+			HashDataBase64: []byte("TOoIJgcexm9cNsG+SrQanvEHtjkY8YBcZN0xuUFEPds="),
+		},
+	}
+
+	if err := testHash(t.Name(), SM3, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hmac
+
+import (
+	"crypto/sha3"
+	"encoding/binary"
+)
+
+const (
+	kmac128Rate         = 168
+	kmac256Rate         = 136
+	kmac128OutputLength = 32
+	kmac256OutputLength = 64
+)
+
+var kmacFunctionName = []byte("KMAC")
+
+// encodeInteger encodes x in the fewest bytes in big endian.
+func encodeInteger(x uint64) []byte {
+	var buffer [8]byte
+	binary.BigEndian.PutUint64(buffer[:], x)
+
+	i := 0
+	for i < len(buffer)-1 && buffer[i] == 0 {
+		i++
+	}
+
+	return buffer[i:]
+}
+
+// leftEncode is left_encode in SP 800-185 2.3.1.
+func leftEncode(x uint64) []byte {
+	encoded := encodeInteger(x)
+	return append([]byte{byte(len(encoded))}, encoded...)
+}
+
+// rightEncode is right_encode in SP 800-185 2.3.1.
+func rightEncode(x uint64) []byte {
+	encoded := encodeInteger(x)
+	return append(encoded, byte(len(encoded)))
+}
+
+// bytepadKey is bytepad(encode_string(key), rate) in SP 800-185 2.3.3.
+func bytepadKey(key []byte, rate int) []byte {
+	padded := leftEncode(uint64(rate))
+	padded = append(padded, leftEncode(uint64(len(key))*8)...)
+	padded = append(padded, key...)
+
+	if remainder := len(padded) % rate; remainder != 0 {
+		padded = append(padded, make([]byte, rate-remainder)...)
+	}
+
+	return padded
+}
+
+func kmac(shake *sha3.SHAKE, rate int, defaultLength int, data []byte, key []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	outputLength := conf.outputLength
+	if outputLength <= 0 {
+		outputLength = defaultLength
+	}
+
+	shake.Write(bytepadKey(key, rate))
+	shake.Write(data)
+	shake.Write(rightEncode(uint64(outputLength) * 8))
+
+	sum := make([]byte, outputLength)
+	shake.Read(sum)
+	return conf.encoding.Encode(sum)
+}
+
+// KMAC128 uses kmac128 in SP 800-185 to hash data.
+// The output length is 32 bytes by default, and you can use WithOutputLength to change it.
+// Use WithCustomization to set the customization string.
+func KMAC128(data []byte, key []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	shake := sha3.NewCSHAKE128(kmacFunctionName, conf.customization)
+	return kmac(shake, kmac128Rate, kmac128OutputLength, data, key, opts...)
+}
+
+// KMAC256 uses kmac256 in SP 800-185 to hash data.
+// The output length is 64 bytes by default, and you can use WithOutputLength to change it.
+// Use WithCustomization to set the customization string.
+func KMAC256(data []byte, key []byte, opts ...Option) []byte {
+	conf := newConfig().Apply(opts...)
+
+	shake := sha3.NewCSHAKE256(kmacFunctionName, conf.customization)
+	return kmac(shake, kmac256Rate, kmac256OutputLength, data, key, opts...)
+}
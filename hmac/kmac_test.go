@@ -0,0 +1,134 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package hmac
+
+import (
+	"slices"
+	"testing"
+)
+
+type kmacTestCase struct {
+	Data          []byte
+	Customization []byte
+	OutputLength  int
+	HashDataHex   []byte
+}
+
+func newKMACTestData() (key []byte, data []byte, data200 []byte) {
+	key = make([]byte, 32)
+	for i := range key {
+		key[i] = 0x40 + byte(i)
+	}
+
+	data200 = make([]byte, 200)
+	for i := range data200 {
+		data200[i] = byte(i)
+	}
+
+	return key, data200[:4], data200
+}
+
+// go test -v -cover -run=^TestKMAC128$
+func TestKMAC128(t *testing.T) {
+	key, data, data200 := newKMACTestData()
+
+	// The vectors come from kmac samples of SP 800-185.
+	testCases := []kmacTestCase{
+		{
+			Data:          data,
+			Customization: nil,
+			OutputLength:  32,
+			HashDataHex:   []byte("e5780b0d3ea6f7d3a429c5706aa43a00fadbd7d49628839e3187243f456ee14e"),
+		},
+		{
+			Data:          data,
+			Customization: []byte("My Tagged Application"),
+			OutputLength:  32,
+			HashDataHex:   []byte("3b1fba963cd8b0b59e8c1a6d71888b7143651af8ba0a7070c0979e2811324aa5"),
+		},
+		{
+			Data:          data200,
+			Customization: []byte("My Tagged Application"),
+			OutputLength:  0,
+			HashDataHex:   []byte("1f5b4e6cca02209e0dcb5ca635b89a15e271ecc760071dfd805faa38f9729230"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		got := KMAC128(testCase.Data, key, WithCustomization(testCase.Customization), WithOutputLength(testCase.OutputLength), WithHex())
+		if !slices.Equal(got, testCase.HashDataHex) {
+			t.Fatalf("got %s != expect %s", got, testCase.HashDataHex)
+		}
+	}
+
+	// The output length is a part of input so a shorter output isn't a prefix of a longer one.
+	short := KMAC128(data, key, WithOutputLength(16))
+	long := KMAC128(data, key, WithOutputLength(32))
+	if slices.Equal(short, long[:16]) {
+		t.Fatalf("short %+v is a prefix of long %+v", short, long)
+	}
+}
+
+// go test -v -cover -run=^TestKMAC256$
+func TestKMAC256(t *testing.T) {
+	key, data, data200 := newKMACTestData()
+
+	// The vectors come from kmac samples of SP 800-185.
+	testCases := []kmacTestCase{
+		{
+			Data:          data,
+			Customization: []byte("My Tagged Application"),
+			OutputLength:  64,
+			HashDataHex:   []byte("20c570c31346f703c9ac36c61c03cb64c3970d0cfc787e9b79599d273a68d2f7f69d4cc3de9d104a351689f27cf6f5951f0103f33f4f24871024d9c27773a8dd"),
+		},
+		{
+			Data:          data200,
+			Customization: nil,
+			OutputLength:  0,
+			HashDataHex:   []byte("75358cf39e41494e949707927cee0af20a3ff553904c86b08f21cc414bcfd691589d27cf5e15369cbbff8b9a4c2eb17800855d0235ff635da82533ec6b759b69"),
+		},
+		{
+			Data:          data200,
+			Customization: []byte("My Tagged Application"),
+			OutputLength:  64,
+			HashDataHex:   []byte("b58618f71f92e1d56c1b8c55ddd7cd188b97b4ca4d99831eb2699a837da2e4d970fbacfde50033aea585f1a2708510c32d07880801bd182898fe476876fc8965"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		got := KMAC256(testCase.Data, key, WithCustomization(testCase.Customization), WithOutputLength(testCase.OutputLength), WithHex())
+		if !slices.Equal(got, testCase.HashDataHex) {
+			t.Fatalf("got %s != expect %s", got, testCase.HashDataHex)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestEncode$
+func TestEncode(t *testing.T) {
+	testCases := []struct {
+		x           uint64
+		leftEncode  []byte
+		rightEncode []byte
+	}{
+		{x: 0, leftEncode: []byte{1, 0}, rightEncode: []byte{0, 1}},
+		{x: 168, leftEncode: []byte{1, 168}, rightEncode: []byte{168, 1}},
+		{x: 256, leftEncode: []byte{2, 1, 0}, rightEncode: []byte{1, 0, 2}},
+	}
+
+	for _, testCase := range testCases {
+		if got := leftEncode(testCase.x); !slices.Equal(got, testCase.leftEncode) {
+			t.Fatalf("got %+v != expect %+v", got, testCase.leftEncode)
+		}
+
+		if got := rightEncode(testCase.x); !slices.Equal(got, testCase.rightEncode) {
+			t.Fatalf("got %+v != expect %+v", got, testCase.rightEncode)
+		}
+	}
+
+	padded := bytepadKey([]byte("key"), kmac128Rate)
+	if len(padded) != kmac128Rate {
+		t.Fatalf("len(padded) %d != %d", len(padded), kmac128Rate)
+	}
+}
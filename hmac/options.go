@@ -9,12 +9,16 @@ import (
 )
 
 type Config struct {
-	encoding encoding.Encoding
+	encoding      encoding.Encoding
+	customization []byte
+	outputLength  int
 }
 
 func newConfig() *Config {
 	conf := &Config{
-		encoding: encoding.None{},
+		encoding:      encoding.None{},
+		customization: nil,
+		outputLength:  0,
 	}
 
 	return conf
@@ -43,3 +47,19 @@ func WithBase64() Option {
 		conf.encoding = encoding.Base64{}
 	}
 }
+
+// WithCustomization sets customization to config.
+// It's used by kmac to separate different usages of the same key.
+func WithCustomization(customization []byte) Option {
+	return func(conf *Config) {
+		conf.customization = customization
+	}
+}
+
+// WithOutputLength sets output length in bytes to config.
+// It's used by kmac.
+func WithOutputLength(outputLength int) Option {
+	return func(conf *Config) {
+		conf.outputLength = outputLength
+	}
+}
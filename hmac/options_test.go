@@ -6,6 +6,7 @@ package hmac
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/FishGoddess/cryptox/bytes/encoding"
@@ -13,8 +14,12 @@ import (
 
 // go test -v -cover -run=^TestConfig$
 func TestConfig(t *testing.T) {
+	customization := []byte("customization")
+
 	opts := []Option{
 		WithHex(),
+		WithCustomization(customization),
+		WithOutputLength(64),
 	}
 
 	conf := newConfig().Apply(opts...)
@@ -25,6 +30,14 @@ func TestConfig(t *testing.T) {
 		t.Fatalf("got %s != expect %s", got, expect)
 	}
 
+	if !slices.Equal(conf.customization, customization) {
+		t.Fatalf("got %s != expect %s", conf.customization, customization)
+	}
+
+	if conf.outputLength != 64 {
+		t.Fatalf("got %d != expect %d", conf.outputLength, 64)
+	}
+
 	conf.Apply(WithBase64())
 
 	got = fmt.Sprintf("%T", conf.encoding)
@@ -0,0 +1,90 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"crypto/cipher"
+
+	"golang.org/x/crypto/blowfish"
+)
+
+func newBlockBlowfish(key []byte) (cipher.Block, error) {
+	return blowfish.NewCipher(key)
+}
+
+// DecryptBlowfishECB uses ecb mode to decrypt data in blowfish.
+// It must specify a padding.
+//
+// Deprecated: Blowfish has a 64-bit block which is vulnerable to birthday attacks, so only use it to migrate the legacy data.
+func DecryptBlowfishECB(data []byte, key []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	block, err := newBlockBlowfish(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptECB(block, data, conf)
+}
+
+// DecryptBlowfishCBC uses cbc mode to decrypt data in blowfish.
+// It must specify a padding.
+//
+// Deprecated: Blowfish has a 64-bit block which is vulnerable to birthday attacks, so only use it to migrate the legacy data.
+func DecryptBlowfishCBC(data []byte, key []byte, iv []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	block, err := newBlockBlowfish(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptCBC(block, data, iv, conf)
+}
+
+// DecryptBlowfishCFB uses cfb mode to decrypt data in blowfish.
+// There is no need to specify a padding.
+//
+// Deprecated: Blowfish has a 64-bit block which is vulnerable to birthday attacks, so only use it to migrate the legacy data.
+func DecryptBlowfishCFB(data []byte, key []byte, iv []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	block, err := newBlockBlowfish(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptCFB(block, data, iv, conf)
+}
+
+// DecryptBlowfishOFB uses ofb mode to decrypt data in blowfish.
+// There is no need to specify a padding.
+//
+// Deprecated: Blowfish has a 64-bit block which is vulnerable to birthday attacks, so only use it to migrate the legacy data.
+func DecryptBlowfishOFB(data []byte, key []byte, iv []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	block, err := newBlockBlowfish(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptOFB(block, data, iv, conf)
+}
+
+// DecryptBlowfishCTR uses ctr mode to decrypt data in blowfish.
+// There is no need to specify a padding.
+//
+// Deprecated: Blowfish has a 64-bit block which is vulnerable to birthday attacks, so only use it to migrate the legacy data.
+func DecryptBlowfishCTR(data []byte, key []byte, iv []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	block, err := newBlockBlowfish(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptCTR(block, data, iv, conf)
+}
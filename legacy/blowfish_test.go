@@ -0,0 +1,101 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/blowfish"
+)
+
+// go test -v -cover -run=^TestDecryptBlowfish$
+func TestDecryptBlowfish(t *testing.T) {
+	// openssl enc -bf-xxx -provider legacy -provider default -K 000102030405060708090a0b0c0d0e0f -iv 0001020304050607
+	decryptECB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptBlowfishECB(data, testKey, append(opts, WithPKCS7())...)
+	}
+
+	decryptCBC := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptBlowfishCBC(data, testKey, testIV, append(opts, WithPKCS7())...)
+	}
+
+	decryptCFB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptBlowfishCFB(data, testKey, testIV, opts...)
+	}
+
+	decryptOFB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptBlowfishOFB(data, testKey, testIV, opts...)
+	}
+
+	testDecrypt(t, "ecb", decryptECB, "83e44c4988bcd11a3b3b2e449006a1d3", testData)
+	testDecrypt(t, "cbc", decryptCBC, "3cf57bde0ab8e1f41e2d0d045813b045", testData)
+	testDecrypt(t, "cfb", decryptCFB, "62d2fe974027f6ed48c0e25ec2c6b6", testData)
+	testDecrypt(t, "ofb", decryptOFB, "62d2fe974027f6ed3f266ba86acf68", testData)
+}
+
+// go test -v -cover -run=^TestDecryptBlowfishCTR$
+func TestDecryptBlowfishCTR(t *testing.T) {
+	block, err := blowfish.NewCipher(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted := make([]byte, len(testData))
+	cipher.NewCTR(block, testIV).XORKeyStream(encrypted, testData)
+
+	decryptCTR := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptBlowfishCTR(data, testKey, testIV, opts...)
+	}
+
+	testDecrypt(t, "ctr", decryptCTR, hex.EncodeToString(encrypted), testData)
+}
+
+// go test -v -cover -run=^TestDecryptBlowfishVectors$
+func TestDecryptBlowfishVectors(t *testing.T) {
+	// The vectors come from the blowfish test vectors by eric young.
+	decryptECB := func(key string) testDecryptFunc {
+		return func(data []byte, opts ...Option) ([]byte, error) {
+			keyBytes, err := hex.DecodeString(key)
+			if err != nil {
+				return nil, err
+			}
+
+			return DecryptBlowfishECB(data, keyBytes, opts...)
+		}
+	}
+
+	testDecrypt(t, "ecb 1", decryptECB("0000000000000000"), "4ef997456198dd78", make([]byte, 8))
+	testDecrypt(t, "ecb 2", decryptECB("ffffffffffffffff"), "51866fd5b85ecb8a", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+
+	key, err := hex.DecodeString("0123456789abcdeff0e1d2c3b4a59687")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	iv, err := hex.DecodeString("fedcba9876543210")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decryptCBC := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptBlowfishCBC(data, key, iv, append(opts, WithZero())...)
+	}
+
+	decryptCFB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptBlowfishCFB(data, key, iv, opts...)
+	}
+
+	decryptOFB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptBlowfishOFB(data, key, iv, opts...)
+	}
+
+	data := []byte("7654321 Now is the time for \x00")
+
+	testDecrypt(t, "cbc", decryptCBC, "6b77b4d63006dee605b156e27403979358deb9e7154616d959f1652bd5ff92cc", data[:len(data)-1])
+	testDecrypt(t, "cfb", decryptCFB, "e73214a2822139caf26ecf6d2eb9e76e3da3de04d1517200519d57a6c3", data)
+	testDecrypt(t, "ofb", decryptOFB, "e73214a2822139ca62b343cc5b65587310dd908d0c241b2263c2cf80da", data)
+}
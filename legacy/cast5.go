@@ -0,0 +1,90 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"crypto/cipher"
+
+	"golang.org/x/crypto/cast5"
+)
+
+func newBlockCAST5(key []byte) (cipher.Block, error) {
+	return cast5.NewCipher(key)
+}
+
+// DecryptCAST5ECB uses ecb mode to decrypt data in cast5.
+// It must specify a padding.
+//
+// Deprecated: CAST5 has a 64-bit block which is vulnerable to birthday attacks, so only use it to migrate the legacy data.
+func DecryptCAST5ECB(data []byte, key []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	block, err := newBlockCAST5(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptECB(block, data, conf)
+}
+
+// DecryptCAST5CBC uses cbc mode to decrypt data in cast5.
+// It must specify a padding.
+//
+// Deprecated: CAST5 has a 64-bit block which is vulnerable to birthday attacks, so only use it to migrate the legacy data.
+func DecryptCAST5CBC(data []byte, key []byte, iv []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	block, err := newBlockCAST5(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptCBC(block, data, iv, conf)
+}
+
+// DecryptCAST5CFB uses cfb mode to decrypt data in cast5.
+// There is no need to specify a padding.
+//
+// Deprecated: CAST5 has a 64-bit block which is vulnerable to birthday attacks, so only use it to migrate the legacy data.
+func DecryptCAST5CFB(data []byte, key []byte, iv []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	block, err := newBlockCAST5(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptCFB(block, data, iv, conf)
+}
+
+// DecryptCAST5OFB uses ofb mode to decrypt data in cast5.
+// There is no need to specify a padding.
+//
+// Deprecated: CAST5 has a 64-bit block which is vulnerable to birthday attacks, so only use it to migrate the legacy data.
+func DecryptCAST5OFB(data []byte, key []byte, iv []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	block, err := newBlockCAST5(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptOFB(block, data, iv, conf)
+}
+
+// DecryptCAST5CTR uses ctr mode to decrypt data in cast5.
+// There is no need to specify a padding.
+//
+// Deprecated: CAST5 has a 64-bit block which is vulnerable to birthday attacks, so only use it to migrate the legacy data.
+func DecryptCAST5CTR(data []byte, key []byte, iv []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	block, err := newBlockCAST5(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptCTR(block, data, iv, conf)
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/cast5"
+)
+
+// go test -v -cover -run=^TestDecryptCAST5$
+func TestDecryptCAST5(t *testing.T) {
+	// openssl enc -cast5-xxx -provider legacy -provider default -K 000102030405060708090a0b0c0d0e0f -iv 0001020304050607
+	decryptECB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptCAST5ECB(data, testKey, append(opts, WithPKCS7())...)
+	}
+
+	decryptCBC := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptCAST5CBC(data, testKey, testIV, append(opts, WithPKCS7())...)
+	}
+
+	decryptCFB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptCAST5CFB(data, testKey, testIV, opts...)
+	}
+
+	decryptOFB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptCAST5OFB(data, testKey, testIV, opts...)
+	}
+
+	testDecrypt(t, "ecb", decryptECB, "69bf0bdab8e4aa0ecd4a041922ea3ae9", testData)
+	testDecrypt(t, "cbc", decryptCBC, "57065244d2b8ad296eaa5d2f622c09a8", testData)
+	testDecrypt(t, "cfb", decryptCFB, "c4098d9202235559961ac75b4475c4", testData)
+	testDecrypt(t, "ofb", decryptOFB, "c4098d9202235559d14b4c58e548c7", testData)
+}
+
+// go test -v -cover -run=^TestDecryptCAST5CTR$
+func TestDecryptCAST5CTR(t *testing.T) {
+	block, err := cast5.NewCipher(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted := make([]byte, len(testData))
+	cipher.NewCTR(block, testIV).XORKeyStream(encrypted, testData)
+
+	decryptCTR := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptCAST5CTR(data, testKey, testIV, opts...)
+	}
+
+	testDecrypt(t, "ctr", decryptCTR, hex.EncodeToString(encrypted), testData)
+}
+
+// go test -v -cover -run=^TestDecryptCAST5Vectors$
+func TestDecryptCAST5Vectors(t *testing.T) {
+	// The vector comes from the appendix b.1 of rfc 2144.
+	key, err := hex.DecodeString("0123456712345678234567893456789a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decryptECB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptCAST5ECB(data, key, opts...)
+	}
+
+	expect := []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+	testDecrypt(t, "ecb", decryptECB, "238b4fe5847e44b2", expect)
+
+	_, err = DecryptCAST5ECB(make([]byte, 8), key[:15])
+	if err == nil {
+		t.Fatal("decrypt with wrong key size should be failed")
+	}
+}
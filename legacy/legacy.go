@@ -0,0 +1,124 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+// Package legacy decrypts data encrypted by the broken or weak ciphers, such as blowfish, cast5, rc2 and rc4.
+//
+// It only provides decryption so the old data can be decrypted once and re-encrypted by aes, see MigrateGCM.
+// Never use these ciphers to encrypt new data.
+//
+// Deprecated: The ciphers are insecure and only for migrating the legacy data.
+package legacy
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"fmt"
+)
+
+// DecryptFunc decrypts the legacy data.
+type DecryptFunc func(data []byte) ([]byte, error)
+
+func checkIV(iv []byte, blockSize int) error {
+	if len(iv) != blockSize {
+		return fmt.Errorf("cryptox/legacy: len(iv) %d != blockSize %d", len(iv), blockSize)
+	}
+
+	return nil
+}
+
+func decryptECB(block cipher.Block, data []byte, conf *Config) ([]byte, error) {
+	blockSize := block.BlockSize()
+
+	src, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := bytes.Clone(src)
+
+	if len(src)%blockSize != 0 {
+		return nil, fmt.Errorf("cryptox/legacy: decrypt ecb len(src) %d %% blockSize %d != 0", len(src), blockSize)
+	}
+
+	start := 0
+	end := blockSize
+
+	for end <= len(src) {
+		block.Decrypt(dst[start:end], src[start:end])
+
+		start += blockSize
+		end += blockSize
+	}
+
+	return conf.padding.Unpad(dst, blockSize)
+}
+
+func decryptCBC(block cipher.Block, data []byte, iv []byte, conf *Config) ([]byte, error) {
+	blockSize := block.BlockSize()
+	if err := checkIV(iv, blockSize); err != nil {
+		return nil, err
+	}
+
+	src, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := bytes.Clone(src)
+
+	if len(src)%blockSize != 0 {
+		return nil, fmt.Errorf("cryptox/legacy: decrypt cbc len(src) %d %% blockSize %d != 0", len(src), blockSize)
+	}
+
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(dst, src)
+	return conf.padding.Unpad(dst, blockSize)
+}
+
+func decryptCFB(block cipher.Block, data []byte, iv []byte, conf *Config) ([]byte, error) {
+	if err := checkIV(iv, block.BlockSize()); err != nil {
+		return nil, err
+	}
+
+	src, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := bytes.Clone(src)
+
+	cipher.NewCFBDecrypter(block, iv).XORKeyStream(dst, src)
+	return dst, nil
+}
+
+func decryptOFB(block cipher.Block, data []byte, iv []byte, conf *Config) ([]byte, error) {
+	if err := checkIV(iv, block.BlockSize()); err != nil {
+		return nil, err
+	}
+
+	src, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := bytes.Clone(src)
+
+	cipher.NewOFB(block, iv).XORKeyStream(dst, src)
+	return dst, nil
+}
+
+func decryptCTR(block cipher.Block, data []byte, iv []byte, conf *Config) ([]byte, error) {
+	if err := checkIV(iv, block.BlockSize()); err != nil {
+		return nil, err
+	}
+
+	src, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := bytes.Clone(src)
+
+	cipher.NewCTR(block, iv).XORKeyStream(dst, src)
+	return dst, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+	"testing"
+)
+
+var (
+	testKey  = []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+	testIV   = []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}
+	testData = []byte("你好，世界")
+)
+
+type testDecryptFunc func(data []byte, opts ...Option) ([]byte, error)
+
+// testDecrypt decrypts the encrypted hex in none, hex and base64 encodings.
+func testDecrypt(t *testing.T, name string, decrypt testDecryptFunc, encryptedHex string, expect []byte, opts ...Option) {
+	encrypted, err := hex.DecodeString(encryptedHex)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encodings := map[string]struct {
+		data []byte
+		opt  Option
+	}{
+		"none":   {data: encrypted, opt: func(conf *Config) {}},
+		"hex":    {data: []byte(encryptedHex), opt: WithHex()},
+		"base64": {data: []byte(base64.StdEncoding.EncodeToString(encrypted)), opt: WithBase64()},
+	}
+
+	for encodingName, encoding := range encodings {
+		decrypted, err := decrypt(encoding.data, append(slices.Clone(opts), encoding.opt)...)
+		if err != nil {
+			t.Fatalf("%s %s: %+v", name, encodingName, err)
+		}
+
+		if !slices.Equal(decrypted, expect) {
+			t.Fatalf("%s %s: got %x != expect %x", name, encodingName, decrypted, expect)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestDecryptInvalid$
+func TestDecryptInvalid(t *testing.T) {
+	encrypted := make([]byte, 15)
+
+	_, err := DecryptBlowfishECB(encrypted, testKey)
+	if err == nil {
+		t.Fatal("decrypt ecb with wrong data size should be failed")
+	}
+
+	_, err = DecryptBlowfishCBC(encrypted, testKey, testIV)
+	if err == nil {
+		t.Fatal("decrypt cbc with wrong data size should be failed")
+	}
+
+	ivs := [][]byte{nil, testIV[1:], testKey}
+
+	for _, iv := range ivs {
+		_, err = DecryptCAST5CBC(encrypted[:8], testKey, iv)
+		if err == nil {
+			t.Fatalf("decrypt cbc with iv %x should be failed", iv)
+		}
+
+		_, err = DecryptCAST5CFB(encrypted, testKey, iv)
+		if err == nil {
+			t.Fatalf("decrypt cfb with iv %x should be failed", iv)
+		}
+
+		_, err = DecryptCAST5OFB(encrypted, testKey, iv)
+		if err == nil {
+			t.Fatalf("decrypt ofb with iv %x should be failed", iv)
+		}
+
+		_, err = DecryptCAST5CTR(encrypted, testKey, iv)
+		if err == nil {
+			t.Fatalf("decrypt ctr with iv %x should be failed", iv)
+		}
+	}
+
+	_, err = DecryptRC2CBC(encrypted, testKey, testIV, WithHex())
+	if err == nil {
+		t.Fatal("decrypt with wrong hex should be failed")
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"github.com/FishGoddess/cryptox/aes"
+)
+
+// MigrateGCM decrypts data by the legacy decrypt and re-encrypts the plain data by aes-gcm.
+// The plain data is cleared after re-encrypting so it won't stay in memory any longer.
+// Use aes.DecryptGCM with the same key, nonce and options to decrypt the migrated data.
+func MigrateGCM(data []byte, decrypt DecryptFunc, key []byte, nonce []byte, opts ...aes.Option) ([]byte, error) {
+	plain, err := decrypt(data)
+	if err != nil {
+		return nil, err
+	}
+
+	defer clear(plain)
+	return aes.EncryptGCM(plain, key, nonce, opts...)
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"encoding/hex"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/aes"
+)
+
+// go test -v -cover -run=^TestMigrateGCM$
+func TestMigrateGCM(t *testing.T) {
+	encrypted, err := hex.DecodeString("3cf57bde0ab8e1f41e2d0d045813b045")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypt := func(data []byte) ([]byte, error) {
+		return DecryptBlowfishCBC(data, testKey, testIV, WithPKCS7())
+	}
+
+	key := []byte("12345678876543211234567887654321")
+	nonce := aes.Nonce()
+
+	migrated, err := MigrateGCM(encrypted, decrypt, key, nonce, aes.WithBase64())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err := aes.DecryptGCM(migrated, key, nonce, aes.WithBase64())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, testData) {
+		t.Fatalf("got %s != expect %s", decrypted, testData)
+	}
+
+	errDecrypt := errors.New("decrypt failed")
+	decrypt = func(data []byte) ([]byte, error) {
+		return nil, errDecrypt
+	}
+
+	_, err = MigrateGCM(encrypted, decrypt, key, nonce)
+	if err != errDecrypt {
+		t.Fatalf("got %+v != expect %+v", err, errDecrypt)
+	}
+
+	decrypt = func(data []byte) ([]byte, error) {
+		return slices.Clone(data), nil
+	}
+
+	_, err = MigrateGCM(encrypted, decrypt, key[:7], nonce)
+	if err == nil {
+		t.Fatal("migrate with wrong aes key size should be failed")
+	}
+}
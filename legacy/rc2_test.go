@@ -0,0 +1,167 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"slices"
+	"testing"
+)
+
+// go test -v -cover -run=^TestRC2Cipher$
+func TestRC2Cipher(t *testing.T) {
+	type testCase struct {
+		key           string
+		effectiveBits int
+		data          string
+		encrypted     string
+	}
+
+	// The vectors come from the section 5 of rfc 2268.
+	testCases := []testCase{
+		{key: "0000000000000000", effectiveBits: 63, data: "0000000000000000", encrypted: "ebb773f993278eff"},
+		{key: "ffffffffffffffff", effectiveBits: 64, data: "ffffffffffffffff", encrypted: "278b27e42e2f0d49"},
+		{key: "3000000000000000", effectiveBits: 64, data: "1000000000000001", encrypted: "30649edf9be7d2c2"},
+		{key: "88", effectiveBits: 64, data: "0000000000000000", encrypted: "61a8a244adacccf0"},
+		{key: "88bca90e90875a", effectiveBits: 64, data: "0000000000000000", encrypted: "6ccf4308974c267f"},
+		{key: "88bca90e90875a7f0f79c384627bafb2", effectiveBits: 64, data: "0000000000000000", encrypted: "1a807d272bbe5db1"},
+		{key: "88bca90e90875a7f0f79c384627bafb2", effectiveBits: 128, data: "0000000000000000", encrypted: "2269552ab0f85ca6"},
+		{key: "88bca90e90875a7f0f79c384627bafb216f80a6f85920584c42fceb0be255daf1e", effectiveBits: 129, data: "0000000000000000", encrypted: "5b78d3a43dfff1f1"},
+	}
+
+	for _, testCase := range testCases {
+		key, err := hex.DecodeString(testCase.key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		block, err := newRC2Cipher(key, testCase.effectiveBits)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := hex.DecodeString(testCase.data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		encrypted := make([]byte, rc2BlockSize)
+		block.Encrypt(encrypted, data)
+
+		if hex.EncodeToString(encrypted) != testCase.encrypted {
+			t.Fatalf("got %x != expect %s", encrypted, testCase.encrypted)
+		}
+
+		decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+			return DecryptRC2ECB(data, key, append(opts, WithRC2EffectiveBits(testCase.effectiveBits))...)
+		}
+
+		testDecrypt(t, testCase.key, decrypt, testCase.encrypted, data)
+	}
+}
+
+// go test -v -cover -run=^TestDecryptRC2$
+func TestDecryptRC2(t *testing.T) {
+	// openssl enc -rc2-xxx -provider legacy -provider default -K 000102030405060708090a0b0c0d0e0f -iv 0001020304050607
+	decryptECB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptRC2ECB(data, testKey, append(opts, WithPKCS7())...)
+	}
+
+	decryptCBC := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptRC2CBC(data, testKey, testIV, append(opts, WithPKCS7())...)
+	}
+
+	decryptCFB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptRC2CFB(data, testKey, testIV, opts...)
+	}
+
+	decryptOFB := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptRC2OFB(data, testKey, testIV, opts...)
+	}
+
+	testDecrypt(t, "ecb", decryptECB, "6d3a5435f8b3682b226d60dfc4bbaf2c", testData)
+	testDecrypt(t, "cbc", decryptCBC, "9e38fd8156e7c3b9928d7fb48cf16d3e", testData)
+	testDecrypt(t, "cfb", decryptCFB, "6faca8f9551d69553bfc5ba25632c3", testData)
+	testDecrypt(t, "ofb", decryptOFB, "6faca8f9551d69557ef0a8e1590b82", testData)
+
+	// openssl enc -rc2-40-cbc -provider legacy -provider default -K 0001020304 -iv 0001020304050607
+	decryptCBC40 := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptRC2CBC(data, testKey[:5], testIV, append(opts, WithPKCS7())...)
+	}
+
+	testDecrypt(t, "cbc 40", decryptCBC40, "41012ee3ac8c10083533bf6d6284636f", testData)
+}
+
+// go test -v -cover -run=^TestDecryptRC2CTR$
+func TestDecryptRC2CTR(t *testing.T) {
+	block, err := newRC2Cipher(testKey, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted := make([]byte, len(testData))
+	cipher.NewCTR(block, testIV).XORKeyStream(encrypted, testData)
+
+	decryptCTR := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptRC2CTR(data, testKey, testIV, opts...)
+	}
+
+	testDecrypt(t, "ctr", decryptCTR, hex.EncodeToString(encrypted), testData)
+}
+
+// go test -v -cover -run=^TestRC2CipherRoundTrip$
+func TestRC2CipherRoundTrip(t *testing.T) {
+	for _, effectiveBits := range []int{1, 40, 64, 128, 1024} {
+		block, err := newRC2Cipher(testKey, effectiveBits)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := []byte("12345678")
+		encrypted := make([]byte, rc2BlockSize)
+		block.Encrypt(encrypted, data)
+
+		decrypted := make([]byte, rc2BlockSize)
+		block.Decrypt(decrypted, encrypted)
+
+		if !slices.Equal(decrypted, data) {
+			t.Fatalf("got %x != expect %x", decrypted, data)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestRC2CipherInvalid$
+func TestRC2CipherInvalid(t *testing.T) {
+	_, err := newRC2Cipher(nil, 0)
+	if err == nil {
+		t.Fatal("new rc2 cipher with empty key should be failed")
+	}
+
+	_, err = newRC2Cipher(make([]byte, 129), 0)
+	if err == nil {
+		t.Fatal("new rc2 cipher with too long key should be failed")
+	}
+
+	for _, effectiveBits := range []int{-1, 1025} {
+		_, err = newRC2Cipher(testKey, effectiveBits)
+		if err == nil {
+			t.Fatalf("new rc2 cipher with effective bits %d should be failed", effectiveBits)
+		}
+	}
+
+	for _, decrypt := range []func() ([]byte, error){
+		func() ([]byte, error) { return DecryptRC2ECB(make([]byte, 8), nil) },
+		func() ([]byte, error) { return DecryptRC2CBC(make([]byte, 8), nil, testIV) },
+		func() ([]byte, error) { return DecryptRC2CFB(make([]byte, 8), nil, testIV) },
+		func() ([]byte, error) { return DecryptRC2OFB(make([]byte, 8), nil, testIV) },
+		func() ([]byte, error) { return DecryptRC2CTR(make([]byte, 8), nil, testIV) },
+	} {
+		_, err = decrypt()
+		if err == nil {
+			t.Fatal("decrypt with empty key should be failed")
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"bytes"
+	"crypto/rc4"
+)
+
+// DecryptRC4 uses rc4 to decrypt data.
+// There is no need to specify a padding and an iv.
+//
+// Deprecated: RC4 has biased key streams which leak the plain data, so only use it to migrate the legacy data.
+func DecryptRC4(data []byte, key []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	cipher, err := rc4.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := bytes.Clone(src)
+
+	cipher.XORKeyStream(dst, src)
+	return dst, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"testing"
+)
+
+// go test -v -cover -run=^TestDecryptRC4$
+func TestDecryptRC4(t *testing.T) {
+	type testCase struct {
+		key          string
+		encryptedHex string
+		data         []byte
+	}
+
+	// The vectors come from the wikipedia of rc4 and openssl enc -rc4.
+	testCases := []testCase{
+		{key: "Key", encryptedHex: "bbf316e8d940af0ad3", data: []byte("Plaintext")},
+		{key: "Wiki", encryptedHex: "1021bf0420", data: []byte("pedia")},
+		{key: "Secret", encryptedHex: "45a01f645fc35b383552544b9bf5", data: []byte("Attack at dawn")},
+		{key: string(testKey), encryptedHex: "0d21e01ce25ff6708a3f2f50e948a6", data: testData},
+	}
+
+	for _, testCase := range testCases {
+		decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+			return DecryptRC4(data, []byte(testCase.key), opts...)
+		}
+
+		testDecrypt(t, testCase.key, decrypt, testCase.encryptedHex, testCase.data)
+	}
+
+	_, err := DecryptRC4(testData, nil)
+	if err == nil {
+		t.Fatal("decrypt with empty key should be failed")
+	}
+
+	_, err = DecryptRC4([]byte("xx"), testKey, WithBase64())
+	if err == nil {
+		t.Fatal("decrypt with wrong base64 should be failed")
+	}
+}
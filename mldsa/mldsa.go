@@ -0,0 +1,247 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package mldsa
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	circlsign "github.com/cloudflare/circl/sign"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa44"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa87"
+)
+
+const (
+	// SeedSize is the size of ml-dsa private key seed.
+	SeedSize = mldsa44.SeedSize
+
+	// contextMaxSize is the max size of context in fips 204.
+	contextMaxSize = 255
+)
+
+var (
+	errUnknownParameterSet = errors.New("cryptox/mldsa: unknown parameter set")
+	errVerifyFailed        = errors.New("cryptox/mldsa: verify failed")
+)
+
+// ParameterSet is the parameter set of ml-dsa in fips 204.
+type ParameterSet int
+
+const (
+	MLDSA44 ParameterSet = 44
+	MLDSA65 ParameterSet = 65
+	MLDSA87 ParameterSet = 87
+)
+
+// String returns the name of the parameter set.
+func (ps ParameterSet) String() string {
+	return fmt.Sprintf("ML-DSA-%d", int(ps))
+}
+
+// scheme returns the scheme of the parameter set or nil if it's unknown.
+func (ps ParameterSet) scheme() circlsign.Scheme {
+	switch ps {
+	case MLDSA44:
+		return mldsa44.Scheme()
+	case MLDSA65:
+		return mldsa65.Scheme()
+	case MLDSA87:
+		return mldsa87.Scheme()
+	default:
+		return nil
+	}
+}
+
+// PublicKeySize returns the size of the public key.
+func (ps ParameterSet) PublicKeySize() int {
+	if scheme := ps.scheme(); scheme != nil {
+		return scheme.PublicKeySize()
+	}
+
+	return 0
+}
+
+// SignatureSize returns the size of the signature.
+func (ps ParameterSet) SignatureSize() int {
+	if scheme := ps.scheme(); scheme != nil {
+		return scheme.SignatureSize()
+	}
+
+	return 0
+}
+
+func checkContext(context string) error {
+	if len(context) > contextMaxSize {
+		return fmt.Errorf("cryptox/mldsa: bad context length %d", len(context))
+	}
+
+	return nil
+}
+
+type PrivateKey struct {
+	params    ParameterSet
+	seed      []byte
+	key       circlsign.PrivateKey
+	publicKey PublicKey
+}
+
+// NewPrivateKey returns a private key of the 32 bytes seed.
+func NewPrivateKey(params ParameterSet, seed []byte) (PrivateKey, error) {
+	if len(seed) != SeedSize {
+		return PrivateKey{}, fmt.Errorf("cryptox/mldsa: invalid private key size %d", len(seed))
+	}
+
+	scheme := params.scheme()
+	if scheme == nil {
+		return PrivateKey{}, errUnknownParameterSet
+	}
+
+	pkey, key := scheme.DeriveKey(seed)
+
+	publicKey := PublicKey{params: params, key: pkey}
+	privateKey := PrivateKey{params: params, seed: bytes.Clone(seed), key: key, publicKey: publicKey}
+	return privateKey, nil
+}
+
+// ParameterSet returns the parameter set of the private key.
+func (pk PrivateKey) ParameterSet() ParameterSet {
+	return pk.params
+}
+
+// Bytes returns the private key in 32 bytes seed.
+func (pk PrivateKey) Bytes() []byte {
+	return bytes.Clone(pk.seed)
+}
+
+// PublicKey returns the public key of the private key.
+func (pk PrivateKey) PublicKey() PublicKey {
+	return pk.publicKey
+}
+
+// Equal returns if the private key equals to the key.
+func (pk PrivateKey) Equal(key PrivateKey) bool {
+	if pk.key == nil || key.key == nil || pk.params != key.params {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(pk.seed, key.seed) == 1
+}
+
+// signTo signs data to sign with the key of the parameter set.
+func signTo(params ParameterSet, key circlsign.PrivateKey, data []byte, context []byte, randomized bool, sign []byte) error {
+	switch params {
+	case MLDSA44:
+		return mldsa44.SignTo(key.(*mldsa44.PrivateKey), data, context, randomized, sign)
+	case MLDSA65:
+		return mldsa65.SignTo(key.(*mldsa65.PrivateKey), data, context, randomized, sign)
+	case MLDSA87:
+		return mldsa87.SignTo(key.(*mldsa87.PrivateKey), data, context, randomized, sign)
+	default:
+		return errUnknownParameterSet
+	}
+}
+
+// Sign signs data.
+// It uses the hedged variant by default which mixes fresh randomness into the signature.
+// It uses the deterministic variant if WithDeterministic is set, so the same data always has the same signature.
+func (pk PrivateKey) Sign(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	if pk.key == nil {
+		return nil, errUnknownParameterSet
+	}
+
+	if err := checkContext(conf.context); err != nil {
+		return nil, err
+	}
+
+	sign := make([]byte, pk.params.SignatureSize())
+
+	err := signTo(pk.params, pk.key, data, []byte(conf.context), !conf.deterministic, sign)
+	if err != nil {
+		return nil, err
+	}
+
+	sign = conf.encoding.Encode(sign)
+	return sign, nil
+}
+
+type PublicKey struct {
+	params ParameterSet
+	key    circlsign.PublicKey
+}
+
+// NewPublicKey returns a public key of the bytes.
+func NewPublicKey(params ParameterSet, data []byte) (PublicKey, error) {
+	scheme := params.scheme()
+	if scheme == nil {
+		return PublicKey{}, errUnknownParameterSet
+	}
+
+	if len(data) != scheme.PublicKeySize() {
+		return PublicKey{}, fmt.Errorf("cryptox/mldsa: invalid public key size %d", len(data))
+	}
+
+	key, err := scheme.UnmarshalBinaryPublicKey(data)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	publicKey := PublicKey{params: params, key: key}
+	return publicKey, nil
+}
+
+// ParameterSet returns the parameter set of the public key.
+func (pk PublicKey) ParameterSet() ParameterSet {
+	return pk.params
+}
+
+// Bytes returns the public key in bytes.
+func (pk PublicKey) Bytes() []byte {
+	if pk.key == nil {
+		return nil
+	}
+
+	data, _ := pk.key.MarshalBinary()
+	return data
+}
+
+// Equal returns if the public key equals to the key.
+func (pk PublicKey) Equal(key PublicKey) bool {
+	if pk.key == nil || key.key == nil || pk.params != key.params {
+		return false
+	}
+
+	return pk.key.Equal(key.key)
+}
+
+// Verify verifies data with sign.
+// The context must be the same as the one used in signing.
+func (pk PublicKey) Verify(data []byte, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	if pk.key == nil {
+		return errUnknownParameterSet
+	}
+
+	if err := checkContext(conf.context); err != nil {
+		return err
+	}
+
+	sign, err := conf.encoding.Decode(sign)
+	if err != nil {
+		return err
+	}
+
+	scheme := pk.params.scheme()
+	if !scheme.Verify(pk.key, data, sign, &circlsign.SignatureOpts{Context: conf.context}) {
+		return errVerifyFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,475 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package mldsa
+
+import (
+	"crypto/sha3"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"slices"
+	"testing"
+
+	circlsign "github.com/cloudflare/circl/sign"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa44"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa87"
+)
+
+var testParameters = []ParameterSet{MLDSA44, MLDSA65, MLDSA87}
+
+type acvpTestVectors struct {
+	KeyGen []struct {
+		TcID         int    `json:"tcId"`
+		ParameterSet string `json:"parameterSet"`
+		Seed         string `json:"seed"`
+		PublicKey    string `json:"pk"`
+	} `json:"keyGen"`
+	SigGen []struct {
+		TcID         int    `json:"tcId"`
+		ParameterSet string `json:"parameterSet"`
+		PrivateKey   string `json:"sk"`
+		PublicKey    string `json:"pk"`
+		Message      string `json:"message"`
+		Context      string `json:"context"`
+		Signature    string `json:"signature"`
+	} `json:"sigGen"`
+	SigVer []struct {
+		TcID         int    `json:"tcId"`
+		ParameterSet string `json:"parameterSet"`
+		PublicKey    string `json:"pk"`
+		Message      string `json:"message"`
+		Context      string `json:"context"`
+		Signature    string `json:"signature"`
+		TestPassed   bool   `json:"testPassed"`
+	} `json:"sigVer"`
+}
+
+func loadACVPTestVectors(t *testing.T) *acvpTestVectors {
+	data, err := os.ReadFile("testdata/acvp_vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vectors := new(acvpTestVectors)
+	if err = json.Unmarshal(data, vectors); err != nil {
+		t.Fatal(err)
+	}
+
+	return vectors
+}
+
+func parameterSetOf(t *testing.T, name string) ParameterSet {
+	for _, params := range testParameters {
+		if params.String() == name {
+			return params
+		}
+	}
+
+	t.Fatalf("unknown parameter set %s", name)
+	return 0
+}
+
+// circlScheme returns the scheme of circl which can load the expanded private keys of acvp.
+func circlScheme(t *testing.T, name string) circlsign.Scheme {
+	schemes := map[string]circlsign.Scheme{
+		"ML-DSA-44": mldsa44.Scheme(),
+		"ML-DSA-65": mldsa65.Scheme(),
+		"ML-DSA-87": mldsa87.Scheme(),
+	}
+
+	scheme, ok := schemes[name]
+	if !ok {
+		t.Fatalf("unknown parameter set %s", name)
+	}
+
+	return scheme
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+// go test -v -cover -run=^TestSignVerify$
+func TestSignVerify(t *testing.T) {
+	data := []byte("你好，世界")
+
+	for _, params := range testParameters {
+		privateKey, publicKey, err := GenerateKeys(params)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		optsList := [][]Option{
+			nil,
+			{WithHex()},
+			{WithBase64()},
+			{WithContext("firmware")},
+			{WithDeterministic()},
+			{WithDeterministic(), WithContext("firmware"), WithBase64()},
+		}
+
+		for _, opts := range optsList {
+			sign, err := privateKey.Sign(data, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = publicKey.Verify(data, sign, opts...)
+			if err != nil {
+				t.Fatalf("%s: %+v", params, err)
+			}
+		}
+
+		sign, err := privateKey.Sign(data, WithContext("firmware"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(sign) != params.SignatureSize() {
+			t.Fatalf("got %d != expect %d", len(sign), params.SignatureSize())
+		}
+
+		err = publicKey.Verify(data, sign)
+		if err == nil {
+			t.Fatal("verify with wrong context should be failed")
+		}
+
+		err = publicKey.Verify([]byte("你好，世界！"), sign, WithContext("firmware"))
+		if err == nil {
+			t.Fatal("verify with wrong data should be failed")
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSignDeterministic$
+func TestSignDeterministic(t *testing.T) {
+	privateKey, _, err := GenerateKeys(MLDSA44)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("你好，世界")
+
+	sign1, err := privateKey.Sign(data, WithDeterministic())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign2, err := privateKey.Sign(data, WithDeterministic())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(sign1, sign2) {
+		t.Fatalf("got %x != expect %x", sign1, sign2)
+	}
+
+	// The hedged signatures are different each time.
+	sign1, err = privateKey.Sign(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign2, err = privateKey.Sign(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if slices.Equal(sign1, sign2) {
+		t.Fatalf("got %x == expect %x", sign1, sign2)
+	}
+}
+
+// go test -v -cover -run=^TestACVPKeyGen$
+func TestACVPKeyGen(t *testing.T) {
+	// The vectors come from the ML-DSA keyGen sample of NIST ACVP server.
+	vectors := loadACVPTestVectors(t)
+
+	for _, testCase := range vectors.KeyGen {
+		params := parameterSetOf(t, testCase.ParameterSet)
+		seed := decodeHex(t, testCase.Seed)
+		expect := decodeHex(t, testCase.PublicKey)
+
+		_, publicKey, err := GenerateKeys(params, WithKeySeed(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.Equal(publicKey.Bytes(), expect) {
+			t.Fatalf("tcId %d: got %x != expect %x", testCase.TcID, publicKey.Bytes(), expect)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestACVPSigGen$
+func TestACVPSigGen(t *testing.T) {
+	// The vectors come from the ML-DSA sigGen sample of NIST ACVP server in the deterministic external pure interface.
+	// The private keys of sigGen are expanded which can't be loaded from a seed, so the circl scheme signs them directly.
+	// The scheme must reproduce the acvp signatures, and then our signatures of the keyGen seeds must be the same as the scheme.
+	vectors := loadACVPTestVectors(t)
+
+	for _, testCase := range vectors.SigGen {
+		params := parameterSetOf(t, testCase.ParameterSet)
+		scheme := circlScheme(t, testCase.ParameterSet)
+
+		data := decodeHex(t, testCase.Message)
+		context := string(decodeHex(t, testCase.Context))
+		expect := decodeHex(t, testCase.Signature)
+		signOpts := &circlsign.SignatureOpts{Context: context}
+
+		circlPrivateKey, err := scheme.UnmarshalBinaryPrivateKey(decodeHex(t, testCase.PrivateKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sign := scheme.Sign(circlPrivateKey, data, signOpts)
+		if !slices.Equal(sign, expect) {
+			t.Fatalf("tcId %d: got %x != expect %x", testCase.TcID, sign, expect)
+		}
+
+		publicKey, err := NewPublicKey(params, decodeHex(t, testCase.PublicKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = publicKey.Verify(data, expect, WithContext(context))
+		if err != nil {
+			t.Fatalf("tcId %d: %+v", testCase.TcID, err)
+		}
+
+		err = publicKey.Verify(data, expect)
+		if err == nil {
+			t.Fatalf("tcId %d: verify with wrong context should be failed", testCase.TcID)
+		}
+
+		for _, keyGen := range vectors.KeyGen {
+			if keyGen.ParameterSet != testCase.ParameterSet {
+				continue
+			}
+
+			seed := decodeHex(t, keyGen.Seed)
+
+			privateKey, err := NewPrivateKey(params, seed)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, circlPrivateKey := scheme.DeriveKey(seed)
+			expect := scheme.Sign(circlPrivateKey, data, signOpts)
+
+			sign, err := privateKey.Sign(data, WithDeterministic(), WithContext(context))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(sign, expect) {
+				t.Fatalf("tcId %d: got %x != expect %x", testCase.TcID, sign, expect)
+			}
+		}
+	}
+}
+
+// go test -v -cover -run=^TestACVPSigVer$
+func TestACVPSigVer(t *testing.T) {
+	// The vectors come from the ML-DSA sigVer sample of NIST ACVP server in the external pure interface.
+	vectors := loadACVPTestVectors(t)
+
+	for _, testCase := range vectors.SigVer {
+		params := parameterSetOf(t, testCase.ParameterSet)
+
+		publicKey, err := NewPublicKey(params, decodeHex(t, testCase.PublicKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := decodeHex(t, testCase.Message)
+		context := string(decodeHex(t, testCase.Context))
+
+		err = publicKey.Verify(data, []byte(testCase.Signature), WithHex(), WithContext(context))
+		if passed := err == nil; passed != testCase.TestPassed {
+			t.Fatalf("tcId %d: got %+v != expect %+v", testCase.TcID, passed, testCase.TestPassed)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestAccumulated$
+func TestAccumulated(t *testing.T) {
+	// The keys are generated from a shake128 stream, and the public keys and deterministic signatures are hashed in shake128.
+	// The expected values are the same as the accumulated tests of crypto/mldsa.
+	testCases := map[string]string{
+		"ML-DSA-44": "d51148e1f9f4fa1a723a6cf42e25f2a99eb5c1b378b3d2dbbd561b1203beeae4",
+		"ML-DSA-65": "8358a1843220194417cadbc2651295cd8fc65125b5a5c1a239a16dc8b57ca199",
+		"ML-DSA-87": "8c3ad714777622b8f21ce31bb35f71394f23bc0fcf3c78ace5d608990f3b061b",
+	}
+
+	for name, expect := range testCases {
+		params := parameterSetOf(t, name)
+		seeds := sha3.NewSHAKE128()
+		output := sha3.NewSHAKE128()
+
+		for i := 0; i < 100; i++ {
+			privateKey, publicKey, err := GenerateKeys(params, WithKeyRandom(seeds))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sign, err := privateKey.Sign(nil, WithDeterministic())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = publicKey.Verify(nil, sign)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			output.Write(publicKey.Bytes())
+			output.Write(sign)
+		}
+
+		sum := make([]byte, 32)
+		output.Read(sum)
+
+		got := hex.EncodeToString(sum)
+		if got != expect {
+			t.Fatalf("%s: got %s != expect %s", name, got, expect)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSignInvalidOptions$
+func TestSignInvalidOptions(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeys(MLDSA44)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("你好，世界")
+	context := string(make([]byte, 256))
+
+	_, err = privateKey.Sign(data, WithContext(context))
+	if err == nil {
+		t.Fatal("sign with too long context should be failed")
+	}
+
+	_, err = privateKey.Sign(data, WithDeterministic(), WithContext(context))
+	if err == nil {
+		t.Fatal("sign with too long context should be failed")
+	}
+
+	sign, err := privateKey.Sign(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.Verify(data, sign, WithContext(context))
+	if err == nil {
+		t.Fatal("verify with too long context should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestNewKey$
+func TestNewKey(t *testing.T) {
+	seed := make([]byte, SeedSize)
+
+	privateKey, err := NewPrivateKey(MLDSA65, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(privateKey.Bytes(), seed) {
+		t.Fatalf("got %x != expect %x", privateKey.Bytes(), seed)
+	}
+
+	publicKey, err := NewPublicKey(MLDSA65, privateKey.PublicKey().Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !publicKey.Equal(privateKey.PublicKey()) {
+		t.Fatalf("got %x != expect %x", publicKey.Bytes(), privateKey.PublicKey().Bytes())
+	}
+
+	// The same seed generates different keys in different parameters.
+	privateKey87, err := NewPrivateKey(MLDSA87, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if privateKey87.Equal(privateKey) {
+		t.Fatal("private keys in different parameters should not be equal")
+	}
+
+	if privateKey.Equal(PrivateKey{}) || publicKey.Equal(PublicKey{}) {
+		t.Fatal("keys should not equal to empty keys")
+	}
+
+	_, err = NewPrivateKey(MLDSA65, seed[1:])
+	if err == nil {
+		t.Fatal("new private key with wrong seed size should be failed")
+	}
+
+	_, err = NewPublicKey(MLDSA44, publicKey.Bytes())
+	if err == nil {
+		t.Fatal("new public key with wrong parameters should be failed")
+	}
+
+	_, err = NewPrivateKey(ParameterSet(128), seed)
+	if err != errUnknownParameterSet {
+		t.Fatalf("got %+v != expect %+v", err, errUnknownParameterSet)
+	}
+
+	_, err = NewPublicKey(ParameterSet(128), publicKey.Bytes())
+	if err != errUnknownParameterSet {
+		t.Fatalf("got %+v != expect %+v", err, errUnknownParameterSet)
+	}
+
+	_, err = PrivateKey{}.Sign(nil)
+	if err != errUnknownParameterSet {
+		t.Fatalf("got %+v != expect %+v", err, errUnknownParameterSet)
+	}
+
+	err = PublicKey{}.Verify(nil, nil)
+	if err != errUnknownParameterSet {
+		t.Fatalf("got %+v != expect %+v", err, errUnknownParameterSet)
+	}
+}
+
+// go test -v -cover -run=^TestParameterSet$
+func TestParameterSet(t *testing.T) {
+	type testCase struct {
+		params        ParameterSet
+		name          string
+		publicKeySize int
+		signatureSize int
+	}
+
+	testCases := []testCase{
+		{params: MLDSA44, name: "ML-DSA-44", publicKeySize: 1312, signatureSize: 2420},
+		{params: MLDSA65, name: "ML-DSA-65", publicKeySize: 1952, signatureSize: 3309},
+		{params: MLDSA87, name: "ML-DSA-87", publicKeySize: 2592, signatureSize: 4627},
+		{params: 128, name: "ML-DSA-128", publicKeySize: 0, signatureSize: 0},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.params.String() != testCase.name {
+			t.Fatalf("got %s != expect %s", testCase.params.String(), testCase.name)
+		}
+
+		if testCase.params.PublicKeySize() != testCase.publicKeySize {
+			t.Fatalf("got %d != expect %d", testCase.params.PublicKeySize(), testCase.publicKeySize)
+		}
+
+		if testCase.params.SignatureSize() != testCase.signatureSize {
+			t.Fatalf("got %d != expect %d", testCase.params.SignatureSize(), testCase.signatureSize)
+		}
+	}
+}
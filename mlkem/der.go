@@ -0,0 +1,147 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package mlkem
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+)
+
+var (
+	// oidPublicKeyMLKEM768 and oidPublicKeyMLKEM1024 are the algorithms of ml-kem in draft-ietf-lamps-kyber-certificates.
+	oidPublicKeyMLKEM768  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 4, 2}
+	oidPublicKeyMLKEM1024 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 4, 3}
+)
+
+var errInvalidDER = errors.New("cryptox/mlkem: invalid der")
+
+// tagSeed is the context specific tag of the seed choice in the private key.
+const tagSeed = 0
+
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+type publicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+func parameterSetOID(params ParameterSet) (asn1.ObjectIdentifier, error) {
+	switch params {
+	case MLKEM768:
+		return oidPublicKeyMLKEM768, nil
+	case MLKEM1024:
+		return oidPublicKeyMLKEM1024, nil
+	default:
+		return nil, errUnknownParameterSet
+	}
+}
+
+func parseParameterSet(algo pkix.AlgorithmIdentifier) (ParameterSet, error) {
+	if len(algo.Parameters.FullBytes) > 0 {
+		return 0, errInvalidDER
+	}
+
+	switch {
+	case algo.Algorithm.Equal(oidPublicKeyMLKEM768):
+		return MLKEM768, nil
+	case algo.Algorithm.Equal(oidPublicKeyMLKEM1024):
+		return MLKEM1024, nil
+	default:
+		return 0, errInvalidDER
+	}
+}
+
+// MarshalPKCS8PrivateKey marshals the private key to pkcs8 der.
+// The private key is encoded in the seed form which is [0] IMPLICIT OCTET STRING.
+func (pk PrivateKey) MarshalPKCS8PrivateKey() ([]byte, error) {
+	oid, err := parameterSetOID(pk.params)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: tagSeed, Bytes: pk.Bytes()}
+
+	keyBytes, err := asn1.Marshal(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	algo := pkix.AlgorithmIdentifier{Algorithm: oid}
+	return asn1.Marshal(pkcs8{Version: 0, Algo: algo, PrivateKey: keyBytes})
+}
+
+// UnmarshalPKCS8PrivateKey unmarshals the private key from pkcs8 der.
+// Only the seed form is supported because the expanded key can't be checked without the seed.
+func (pk *PrivateKey) UnmarshalPKCS8PrivateKey(der []byte) error {
+	var info pkcs8
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	params, err := parseParameterSet(info.Algo)
+	if err != nil {
+		return err
+	}
+
+	var seed asn1.RawValue
+	if rest, err := asn1.Unmarshal(info.PrivateKey, &seed); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	if seed.Class != asn1.ClassContextSpecific || seed.Tag != tagSeed || seed.IsCompound {
+		return errInvalidDER
+	}
+
+	privateKey, err := NewPrivateKey(params, seed.Bytes)
+	if err != nil {
+		return err
+	}
+
+	*pk = privateKey
+	return nil
+}
+
+// MarshalPKIXPublicKey marshals the public key to pkix der.
+func (pk PublicKey) MarshalPKIXPublicKey() ([]byte, error) {
+	oid, err := parameterSetOID(pk.params)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := pk.Bytes()
+
+	info := publicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oid},
+		PublicKey: asn1.BitString{Bytes: publicKey, BitLength: 8 * len(publicKey)},
+	}
+
+	return asn1.Marshal(info)
+}
+
+// UnmarshalPKIXPublicKey unmarshals the public key from pkix der.
+func (pk *PublicKey) UnmarshalPKIXPublicKey(der []byte) error {
+	var info publicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	params, err := parseParameterSet(info.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	publicKey, err := NewPublicKey(params, info.PublicKey.RightAlign())
+	if err != nil {
+		return err
+	}
+
+	*pk = publicKey
+	return nil
+}
@@ -0,0 +1,239 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package mlkem
+
+import (
+	"bytes"
+	"crypto/hkdf"
+	"crypto/mlkem"
+	"crypto/sha3"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/FishGoddess/cryptox/x25519"
+)
+
+const (
+	// HybridSeedSize is the size of hybrid private key seed in x-wing.
+	// The seed is expanded to the ml-kem-768 seed and the x25519 private key by shake256.
+	HybridSeedSize = 32
+
+	// HybridPublicKeySize is the size of hybrid public key, which is ml-kem-768 public key || x25519 public key.
+	HybridPublicKeySize = mlkem.EncapsulationKeySize768 + x25519.KeySize
+
+	// HybridCiphertextSize is the size of hybrid ciphertext, which is ml-kem-768 ciphertext || x25519 ephemeral public key.
+	HybridCiphertextSize = mlkem.CiphertextSize768 + x25519.KeySize
+)
+
+// xwingLabel is the label of x-wing combiner which is 5c2e2f2f5e5c in hex.
+const xwingLabel = "\\.//^\\"
+
+// combineKey combines the shared secrets of ml-kem-768 and x25519 to a key in x-wing.
+// The key is sha3-256(ss_M || ss_X || ct_X || pk_X || label) so it's bound to the x25519 ciphertext and public key.
+func combineKey(mlkemSecret []byte, x25519Secret []byte, x25519Ciphertext []byte, x25519PublicKey []byte) []byte {
+	h := sha3.New256()
+	h.Write(mlkemSecret)
+	h.Write(x25519Secret)
+	h.Write(x25519Ciphertext)
+	h.Write(x25519PublicKey)
+	h.Write([]byte(xwingLabel))
+	return h.Sum(nil)
+}
+
+// deriveKey derives the x-wing key by hkdf if WithHKDF is set.
+// It's a separate step on top of x-wing so the x-wing key keeps interoperable.
+func deriveKey(conf *Config, key []byte) ([]byte, error) {
+	if conf.hkdfHash == 0 {
+		return key, nil
+	}
+
+	if !conf.hkdfHash.Available() {
+		return nil, errors.New("cryptox/mlkem: hkdf hash is unavailable")
+	}
+
+	return hkdf.Key(conf.hkdfHash.New, key, nil, conf.hkdfInfo, conf.hkdfKeySize)
+}
+
+type HybridPrivateKey struct {
+	seed      []byte
+	mlkemKey  PrivateKey
+	x25519Key x25519.PrivateKey
+}
+
+// NewHybridPrivateKey returns a hybrid private key of the 32 bytes seed in x-wing.
+func NewHybridPrivateKey(seed []byte) (HybridPrivateKey, error) {
+	if len(seed) != HybridSeedSize {
+		return HybridPrivateKey{}, fmt.Errorf("cryptox/mlkem: invalid hybrid private key size %d", len(seed))
+	}
+
+	expanded := sha3.SumSHAKE256(seed, SeedSize+x25519.KeySize)
+
+	mlkemKey, err := NewPrivateKey(MLKEM768, expanded[:SeedSize])
+	if err != nil {
+		return HybridPrivateKey{}, err
+	}
+
+	x25519Key, err := x25519.NewPrivateKey(expanded[SeedSize:])
+	if err != nil {
+		return HybridPrivateKey{}, err
+	}
+
+	privateKey := HybridPrivateKey{seed: bytes.Clone(seed), mlkemKey: mlkemKey, x25519Key: x25519Key}
+	return privateKey, nil
+}
+
+// Bytes returns the hybrid private key in 32 bytes seed.
+func (hpk HybridPrivateKey) Bytes() []byte {
+	return bytes.Clone(hpk.seed)
+}
+
+// PublicKey returns the hybrid public key of the hybrid private key.
+func (hpk HybridPrivateKey) PublicKey() HybridPublicKey {
+	return HybridPublicKey{mlkemKey: hpk.mlkemKey.PublicKey(), x25519Key: hpk.x25519Key.PublicKey()}
+}
+
+// Equal returns if the hybrid private key equals to the key.
+func (hpk HybridPrivateKey) Equal(key HybridPrivateKey) bool {
+	return hpk.mlkemKey.Equal(key.mlkemKey) && hpk.x25519Key.Equal(key.x25519Key)
+}
+
+// Decapsulate decapsulates the hybrid key from the ciphertext in x-wing.
+// The ciphertext will be decoded by the encoding, and the 32 bytes key can be used as an aes-256 key directly.
+// The key will be derived by hkdf if WithHKDF is set.
+func (hpk HybridPrivateKey) Decapsulate(ciphertext []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	ciphertext, err := conf.encoding.Decode(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) != HybridCiphertextSize {
+		return nil, fmt.Errorf("cryptox/mlkem: invalid hybrid ciphertext size %d", len(ciphertext))
+	}
+
+	mlkemCiphertext := ciphertext[:MLKEM768.CiphertextSize()]
+	x25519Ciphertext := ciphertext[MLKEM768.CiphertextSize():]
+
+	mlkemSecret, err := hpk.mlkemKey.key.Decapsulate(mlkemCiphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	ephemeralKey, err := x25519.NewPublicKey(x25519Ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	x25519Secret, err := hpk.x25519Key.SharedSecret(ephemeralKey)
+	if err != nil {
+		return nil, err
+	}
+
+	x25519PublicKey := hpk.x25519Key.PublicKey().Bytes()
+
+	key := combineKey(mlkemSecret, x25519Secret, x25519Ciphertext, x25519PublicKey)
+	return deriveKey(conf, key)
+}
+
+type HybridPublicKey struct {
+	mlkemKey  PublicKey
+	x25519Key x25519.PublicKey
+}
+
+// NewHybridPublicKey returns a hybrid public key of the bytes.
+func NewHybridPublicKey(data []byte) (HybridPublicKey, error) {
+	if len(data) != HybridPublicKeySize {
+		return HybridPublicKey{}, fmt.Errorf("cryptox/mlkem: invalid hybrid public key size %d", len(data))
+	}
+
+	mlkemKey, err := NewPublicKey(MLKEM768, data[:MLKEM768.PublicKeySize()])
+	if err != nil {
+		return HybridPublicKey{}, err
+	}
+
+	x25519Key, err := x25519.NewPublicKey(data[MLKEM768.PublicKeySize():])
+	if err != nil {
+		return HybridPublicKey{}, err
+	}
+
+	publicKey := HybridPublicKey{mlkemKey: mlkemKey, x25519Key: x25519Key}
+	return publicKey, nil
+}
+
+// Bytes returns the hybrid public key in bytes.
+func (hpk HybridPublicKey) Bytes() []byte {
+	data := make([]byte, 0, HybridPublicKeySize)
+	data = append(data, hpk.mlkemKey.Bytes()...)
+	data = append(data, hpk.x25519Key.Bytes()...)
+
+	return data
+}
+
+// Equal returns if the hybrid public key equals to the key.
+func (hpk HybridPublicKey) Equal(key HybridPublicKey) bool {
+	return hpk.mlkemKey.Equal(key.mlkemKey) && hpk.x25519Key.Equal(key.x25519Key)
+}
+
+// Encapsulate generates a hybrid key and the ciphertext of it in x-wing.
+// The key is combined from the ml-kem-768 and x25519 shared secrets, so it keeps safe if either of them is safe.
+// The ciphertext will be encoded by the encoding, and the 32 bytes key can be used as an aes-256 key directly.
+// The key will be derived by hkdf if WithHKDF is set.
+func (hpk HybridPublicKey) Encapsulate(opts ...Option) (key []byte, ciphertext []byte, err error) {
+	conf := newConfig().Apply(opts...)
+
+	mlkemSecret, mlkemCiphertext := hpk.mlkemKey.key.Encapsulate()
+
+	ephemeralKey, _, err := x25519.GenerateKeys(x25519.WithKeyRandom(conf.random))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	x25519Secret, err := ephemeralKey.SharedSecret(hpk.x25519Key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	x25519Ciphertext := ephemeralKey.PublicKey().Bytes()
+	x25519PublicKey := hpk.x25519Key.Bytes()
+
+	key = combineKey(mlkemSecret, x25519Secret, x25519Ciphertext, x25519PublicKey)
+
+	key, err = deriveKey(conf, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ciphertext = make([]byte, 0, HybridCiphertextSize)
+	ciphertext = append(ciphertext, mlkemCiphertext...)
+	ciphertext = append(ciphertext, x25519Ciphertext...)
+	ciphertext = conf.encoding.Encode(ciphertext)
+
+	return key, ciphertext, nil
+}
+
+// GenerateHybridKeys generates a hybrid private key and a hybrid public key of ml-kem-768 and x25519 in x-wing.
+// The seed will be used as the hybrid private key directly so it must be 32 bytes.
+func GenerateHybridKeys(opts ...KeyOption) (HybridPrivateKey, HybridPublicKey, error) {
+	conf := newKeyConfig().Apply(opts...)
+
+	seed := conf.seed
+	if len(seed) <= 0 {
+		seed = make([]byte, HybridSeedSize)
+
+		if _, err := io.ReadFull(conf.random, seed); err != nil {
+			return HybridPrivateKey{}, HybridPublicKey{}, err
+		}
+	}
+
+	privateKey, err := NewHybridPrivateKey(seed)
+	if err != nil {
+		return HybridPrivateKey{}, HybridPublicKey{}, err
+	}
+
+	publicKey := privateKey.PublicKey()
+	return privateKey, publicKey, nil
+}
@@ -0,0 +1,303 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package mlkem
+
+import (
+	"crypto"
+	"crypto/hkdf"
+	"crypto/sha256"
+	"crypto/sha3"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/aes"
+	"github.com/FishGoddess/cryptox/x25519"
+)
+
+// go test -v -cover -run=^TestHybridEncapsulate$
+func TestHybridEncapsulate(t *testing.T) {
+	privateKey, publicKey, err := GenerateHybridKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		opts    []Option
+		keySize int
+	}
+
+	testCases := []testCase{
+		{opts: nil, keySize: 32},
+		{opts: []Option{WithHex()}, keySize: 32},
+		{opts: []Option{WithBase64(), WithHKDF(crypto.SHA256, 32), WithHKDFInfo("aes-gcm key")}, keySize: 32},
+		{opts: []Option{WithHKDF(crypto.SHA512, 16)}, keySize: 16},
+	}
+
+	for _, testCase := range testCases {
+		key, ciphertext, err := publicKey.Encapsulate(testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(key) != testCase.keySize {
+			t.Fatalf("got %d != expect %d", len(key), testCase.keySize)
+		}
+
+		decapsulated, err := privateKey.Decapsulate(ciphertext, testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.Equal(decapsulated, key) {
+			t.Fatalf("got %x != expect %x", decapsulated, key)
+		}
+	}
+
+	key, ciphertext, err := publicKey.Encapsulate(WithHKDF(crypto.SHA256, 32), WithHKDFInfo("aes-gcm key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ciphertext) != HybridCiphertextSize {
+		t.Fatalf("got %d != expect %d", len(ciphertext), HybridCiphertextSize)
+	}
+
+	// The key is bound to the hkdf info.
+	decapsulated, err := privateKey.Decapsulate(ciphertext, WithHKDF(crypto.SHA256, 32), WithHKDFInfo("another key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if slices.Equal(decapsulated, key) {
+		t.Fatalf("got %x == expect %x", decapsulated, key)
+	}
+
+	// The hkdf is a separate step on top of the x-wing key.
+	xwingKey, err := privateKey.Decapsulate(ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect, err := hkdf.Key(sha256.New, xwingKey, nil, "aes-gcm key", 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(key, expect) {
+		t.Fatalf("got %x != expect %x", key, expect)
+	}
+
+	_, _, err = publicKey.Encapsulate(WithHKDF(crypto.Hash(999), 32))
+	if err == nil {
+		t.Fatal("encapsulate with unavailable hash should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestHybridAESGCM$
+func TestHybridAESGCM(t *testing.T) {
+	privateKey, publicKey, err := GenerateHybridKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, ciphertext, err := publicKey.Encapsulate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("你好，世界")
+	nonce := aes.Nonce()
+
+	encrypted, err := aes.EncryptGCM(data, key, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decapsulated, err := privateKey.Decapsulate(ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err := aes.DecryptGCM(encrypted, decapsulated, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+}
+
+// go test -v -cover -run=^TestHybridDecapsulateInvalid$
+func TestHybridDecapsulateInvalid(t *testing.T) {
+	privateKey, publicKey, err := GenerateHybridKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, ciphertext, err := publicKey.Encapsulate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = privateKey.Decapsulate(ciphertext[1:])
+	if err == nil {
+		t.Fatal("decapsulate with wrong ciphertext size should be failed")
+	}
+
+	// Tampering either part of the ciphertext changes the key.
+	for _, i := range []int{0, HybridCiphertextSize - 1} {
+		tampered := slices.Clone(ciphertext)
+		tampered[i] ^= 1
+
+		decapsulated, err := privateKey.Decapsulate(tampered)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if slices.Equal(decapsulated, key) {
+			t.Fatalf("got %x == expect %x", decapsulated, key)
+		}
+	}
+
+	// The x25519 part of the ciphertext is a low order point.
+	lowOrder := slices.Clone(ciphertext)
+	clear(lowOrder[MLKEM768.CiphertextSize():])
+
+	_, err = privateKey.Decapsulate(lowOrder)
+	if err == nil {
+		t.Fatal("decapsulate with low order point should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestNewHybridKey$
+func TestNewHybridKey(t *testing.T) {
+	seed := make([]byte, HybridSeedSize)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+
+	privateKey, publicKey, err := GenerateHybridKeys(WithKeySeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(privateKey.Bytes(), seed) {
+		t.Fatalf("got %x != expect %x", privateKey.Bytes(), seed)
+	}
+
+	// The seed is expanded by shake256 in x-wing.
+	expanded := sha3.SumSHAKE256(seed, SeedSize+x25519.KeySize)
+
+	mlkemKey, err := NewPrivateKey(MLKEM768, expanded[:SeedSize])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x25519Key, err := x25519.NewPrivateKey(expanded[SeedSize:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := append(mlkemKey.PublicKey().Bytes(), x25519Key.PublicKey().Bytes()...)
+	if !slices.Equal(publicKey.Bytes(), expect) {
+		t.Fatalf("got %x != expect %x", publicKey.Bytes(), expect)
+	}
+
+	newPrivateKey, err := NewHybridPrivateKey(privateKey.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !newPrivateKey.Equal(privateKey) {
+		t.Fatalf("got %x != expect %x", newPrivateKey.Bytes(), privateKey.Bytes())
+	}
+
+	newPublicKey, err := NewHybridPublicKey(publicKey.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !newPublicKey.Equal(publicKey) {
+		t.Fatalf("got %x != expect %x", newPublicKey.Bytes(), publicKey.Bytes())
+	}
+
+	_, err = NewHybridPrivateKey(seed[1:])
+	if err == nil {
+		t.Fatal("new hybrid private key with wrong seed size should be failed")
+	}
+
+	_, err = NewHybridPublicKey(publicKey.Bytes()[1:])
+	if err == nil {
+		t.Fatal("new hybrid public key with wrong size should be failed")
+	}
+
+	_, _, err = GenerateHybridKeys(WithKeySeed(append(seed, 0)))
+	if err == nil {
+		t.Fatal("generate hybrid keys with wrong seed size should be failed")
+	}
+}
+
+type xwingTestVectors struct {
+	XWing []struct {
+		Source       string `json:"source"`
+		Seed         string `json:"seed"`
+		PublicKey    string `json:"pk"`
+		Ciphertext   string `json:"ciphertext"`
+		SharedSecret string `json:"sharedSecret"`
+	} `json:"xwing"`
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+// go test -v -cover -run=^TestHybridXWingVectors$
+func TestHybridXWingVectors(t *testing.T) {
+	// The vectors are from the x-wing draft and the hpke pq draft.
+	data, err := os.ReadFile("testdata/xwing_vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vectors := new(xwingTestVectors)
+	if err = json.Unmarshal(data, vectors); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(vectors.XWing) == 0 {
+		t.Fatal("no x-wing vectors")
+	}
+
+	for i, vector := range vectors.XWing {
+		privateKey, err := NewHybridPrivateKey(decodeHex(t, vector.Seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		publicKey := decodeHex(t, vector.PublicKey)
+		if !slices.Equal(privateKey.PublicKey().Bytes(), publicKey) {
+			t.Fatalf("%d %s: got %x != expect %x", i, vector.Source, privateKey.PublicKey().Bytes(), publicKey)
+		}
+
+		key, err := privateKey.Decapsulate(decodeHex(t, vector.Ciphertext))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sharedSecret := decodeHex(t, vector.SharedSecret)
+		if !slices.Equal(key, sharedSecret) {
+			t.Fatalf("%d %s: got %x != expect %x", i, vector.Source, key, sharedSecret)
+		}
+	}
+}
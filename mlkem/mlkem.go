@@ -0,0 +1,210 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package mlkem
+
+import (
+	"crypto/mlkem"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+)
+
+const (
+	// SeedSize is the size of ml-kem private key seed.
+	SeedSize = mlkem.SeedSize
+
+	// SharedKeySize is the size of ml-kem shared key.
+	SharedKeySize = mlkem.SharedKeySize
+)
+
+var errUnknownParameterSet = errors.New("cryptox/mlkem: unknown parameter set")
+
+// ParameterSet is the parameter set of ml-kem in fips 203.
+type ParameterSet int
+
+const (
+	MLKEM768  ParameterSet = 768
+	MLKEM1024 ParameterSet = 1024
+)
+
+// String returns the name of the parameter set.
+func (ps ParameterSet) String() string {
+	switch ps {
+	case MLKEM768:
+		return "ML-KEM-768"
+	case MLKEM1024:
+		return "ML-KEM-1024"
+	default:
+		return fmt.Sprintf("ML-KEM-%d", int(ps))
+	}
+}
+
+// PublicKeySize returns the size of the public key.
+func (ps ParameterSet) PublicKeySize() int {
+	switch ps {
+	case MLKEM768:
+		return mlkem.EncapsulationKeySize768
+	case MLKEM1024:
+		return mlkem.EncapsulationKeySize1024
+	default:
+		return 0
+	}
+}
+
+// CiphertextSize returns the size of the ciphertext.
+func (ps ParameterSet) CiphertextSize() int {
+	switch ps {
+	case MLKEM768:
+		return mlkem.CiphertextSize768
+	case MLKEM1024:
+		return mlkem.CiphertextSize1024
+	default:
+		return 0
+	}
+}
+
+// decapsulationKey is implemented by *mlkem.DecapsulationKey768 and *mlkem.DecapsulationKey1024.
+type decapsulationKey interface {
+	Bytes() []byte
+	Decapsulate(ciphertext []byte) ([]byte, error)
+}
+
+// encapsulationKey is implemented by *mlkem.EncapsulationKey768 and *mlkem.EncapsulationKey1024.
+type encapsulationKey interface {
+	Bytes() []byte
+	Encapsulate() (sharedKey []byte, ciphertext []byte)
+}
+
+type PrivateKey struct {
+	params    ParameterSet
+	key       decapsulationKey
+	publicKey PublicKey
+}
+
+// NewPrivateKey returns a private key of the 64 bytes seed.
+func NewPrivateKey(params ParameterSet, seed []byte) (PrivateKey, error) {
+	if len(seed) != SeedSize {
+		return PrivateKey{}, fmt.Errorf("cryptox/mlkem: invalid private key size %d", len(seed))
+	}
+
+	switch params {
+	case MLKEM768:
+		key, err := mlkem.NewDecapsulationKey768(seed)
+		if err != nil {
+			return PrivateKey{}, err
+		}
+
+		publicKey := PublicKey{params: params, key: key.EncapsulationKey()}
+		return PrivateKey{params: params, key: key, publicKey: publicKey}, nil
+	case MLKEM1024:
+		key, err := mlkem.NewDecapsulationKey1024(seed)
+		if err != nil {
+			return PrivateKey{}, err
+		}
+
+		publicKey := PublicKey{params: params, key: key.EncapsulationKey()}
+		return PrivateKey{params: params, key: key, publicKey: publicKey}, nil
+	default:
+		return PrivateKey{}, errUnknownParameterSet
+	}
+}
+
+// ParameterSet returns the parameter set of the private key.
+func (pk PrivateKey) ParameterSet() ParameterSet {
+	return pk.params
+}
+
+// Bytes returns the private key in 64 bytes seed.
+func (pk PrivateKey) Bytes() []byte {
+	return pk.key.Bytes()
+}
+
+// PublicKey returns the public key of the private key.
+func (pk PrivateKey) PublicKey() PublicKey {
+	return pk.publicKey
+}
+
+// Equal returns if the private key equals to the key.
+func (pk PrivateKey) Equal(key PrivateKey) bool {
+	if pk.key == nil || key.key == nil || pk.params != key.params {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(pk.key.Bytes(), key.key.Bytes()) == 1
+}
+
+// Decapsulate decapsulates the shared key from the ciphertext.
+// The ciphertext will be decoded by the encoding, and the shared key is 32 bytes which can be used as an aes key directly.
+func (pk PrivateKey) Decapsulate(ciphertext []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	ciphertext, err := conf.encoding.Decode(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) != pk.params.CiphertextSize() {
+		return nil, fmt.Errorf("cryptox/mlkem: invalid ciphertext size %d", len(ciphertext))
+	}
+
+	return pk.key.Decapsulate(ciphertext)
+}
+
+type PublicKey struct {
+	params ParameterSet
+	key    encapsulationKey
+}
+
+// NewPublicKey returns a public key of the bytes.
+func NewPublicKey(params ParameterSet, data []byte) (PublicKey, error) {
+	switch params {
+	case MLKEM768:
+		key, err := mlkem.NewEncapsulationKey768(data)
+		if err != nil {
+			return PublicKey{}, err
+		}
+
+		return PublicKey{params: params, key: key}, nil
+	case MLKEM1024:
+		key, err := mlkem.NewEncapsulationKey1024(data)
+		if err != nil {
+			return PublicKey{}, err
+		}
+
+		return PublicKey{params: params, key: key}, nil
+	default:
+		return PublicKey{}, errUnknownParameterSet
+	}
+}
+
+// ParameterSet returns the parameter set of the public key.
+func (pk PublicKey) ParameterSet() ParameterSet {
+	return pk.params
+}
+
+// Bytes returns the public key in bytes.
+func (pk PublicKey) Bytes() []byte {
+	return pk.key.Bytes()
+}
+
+// Equal returns if the public key equals to the key.
+func (pk PublicKey) Equal(key PublicKey) bool {
+	if pk.key == nil || key.key == nil || pk.params != key.params {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(pk.key.Bytes(), key.key.Bytes()) == 1
+}
+
+// Encapsulate generates a shared key and the ciphertext of it.
+// The ciphertext will be encoded by the encoding, and the shared key is 32 bytes which can be used as an aes key directly.
+func (pk PublicKey) Encapsulate(opts ...Option) (sharedKey []byte, ciphertext []byte, err error) {
+	conf := newConfig().Apply(opts...)
+
+	sharedKey, ciphertext = pk.key.Encapsulate()
+	ciphertext = conf.encoding.Encode(ciphertext)
+
+	return sharedKey, ciphertext, nil
+}
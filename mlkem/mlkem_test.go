@@ -0,0 +1,188 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package mlkem
+
+import (
+	"slices"
+	"testing"
+)
+
+// go test -v -cover -run=^TestEncapsulate$
+func TestEncapsulate(t *testing.T) {
+	type testCase struct {
+		params ParameterSet
+		opts   []Option
+	}
+
+	testCases := []testCase{
+		{params: MLKEM768, opts: nil},
+		{params: MLKEM768, opts: []Option{WithHex()}},
+		{params: MLKEM768, opts: []Option{WithBase64()}},
+		{params: MLKEM1024, opts: nil},
+		{params: MLKEM1024, opts: []Option{WithHex()}},
+		{params: MLKEM1024, opts: []Option{WithBase64()}},
+	}
+
+	for _, testCase := range testCases {
+		privateKey, publicKey, err := GenerateKeys(testCase.params)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sharedKey, ciphertext, err := publicKey.Encapsulate(testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(sharedKey) != SharedKeySize {
+			t.Fatalf("got %d != expect %d", len(sharedKey), SharedKeySize)
+		}
+
+		decapsulated, err := privateKey.Decapsulate(ciphertext, testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.Equal(decapsulated, sharedKey) {
+			t.Fatalf("%s: got %x != expect %x", testCase.params, decapsulated, sharedKey)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestDecapsulateInvalid$
+func TestDecapsulateInvalid(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeys(MLKEM768)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sharedKey, ciphertext, err := publicKey.Encapsulate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = privateKey.Decapsulate(ciphertext[1:])
+	if err == nil {
+		t.Fatal("decapsulate with wrong ciphertext size should be failed")
+	}
+
+	// The tampered ciphertext is implicitly rejected with a pseudorandom key.
+	ciphertext[0] ^= 1
+
+	decapsulated, err := privateKey.Decapsulate(ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if slices.Equal(decapsulated, sharedKey) {
+		t.Fatalf("got %x == expect %x", decapsulated, sharedKey)
+	}
+
+	// The ciphertext of ml-kem-768 can't be decapsulated by ml-kem-1024.
+	privateKey1024, _, err := GenerateKeys(MLKEM1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = privateKey1024.Decapsulate(ciphertext)
+	if err == nil {
+		t.Fatal("decapsulate with wrong parameter set should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestParameterSet$
+func TestParameterSet(t *testing.T) {
+	type testCase struct {
+		params         ParameterSet
+		name           string
+		publicKeySize  int
+		ciphertextSize int
+	}
+
+	testCases := []testCase{
+		{params: MLKEM768, name: "ML-KEM-768", publicKeySize: 1184, ciphertextSize: 1088},
+		{params: MLKEM1024, name: "ML-KEM-1024", publicKeySize: 1568, ciphertextSize: 1568},
+		{params: 512, name: "ML-KEM-512", publicKeySize: 0, ciphertextSize: 0},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.params.String() != testCase.name {
+			t.Fatalf("got %s != expect %s", testCase.params.String(), testCase.name)
+		}
+
+		if testCase.params.PublicKeySize() != testCase.publicKeySize {
+			t.Fatalf("got %d != expect %d", testCase.params.PublicKeySize(), testCase.publicKeySize)
+		}
+
+		if testCase.params.CiphertextSize() != testCase.ciphertextSize {
+			t.Fatalf("got %d != expect %d", testCase.params.CiphertextSize(), testCase.ciphertextSize)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestNewKey$
+func TestNewKey(t *testing.T) {
+	seed := make([]byte, SeedSize)
+
+	privateKey, err := NewPrivateKey(MLKEM768, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if privateKey.ParameterSet() != MLKEM768 {
+		t.Fatalf("got %s != expect %s", privateKey.ParameterSet(), MLKEM768)
+	}
+
+	publicKey, err := NewPublicKey(MLKEM768, privateKey.PublicKey().Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if publicKey.ParameterSet() != MLKEM768 {
+		t.Fatalf("got %s != expect %s", publicKey.ParameterSet(), MLKEM768)
+	}
+
+	if !publicKey.Equal(privateKey.PublicKey()) {
+		t.Fatalf("got %x != expect %x", publicKey.Bytes(), privateKey.PublicKey().Bytes())
+	}
+
+	// The same seed generates different keys in different parameter sets.
+	privateKey1024, err := NewPrivateKey(MLKEM1024, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if privateKey1024.Equal(privateKey) {
+		t.Fatal("private keys in different parameter sets should not be equal")
+	}
+
+	if privateKey1024.PublicKey().Equal(publicKey) {
+		t.Fatal("public keys in different parameter sets should not be equal")
+	}
+
+	if privateKey.Equal(PrivateKey{}) || publicKey.Equal(PublicKey{}) {
+		t.Fatal("keys should not equal to empty keys")
+	}
+
+	_, err = NewPrivateKey(MLKEM768, seed[1:])
+	if err == nil {
+		t.Fatal("new private key with wrong seed size should be failed")
+	}
+
+	_, err = NewPrivateKey(512, seed)
+	if err != errUnknownParameterSet {
+		t.Fatalf("got %+v != expect %+v", err, errUnknownParameterSet)
+	}
+
+	_, err = NewPublicKey(MLKEM1024, publicKey.Bytes())
+	if err == nil {
+		t.Fatal("new public key with wrong parameter set should be failed")
+	}
+
+	_, err = NewPublicKey(512, publicKey.Bytes())
+	if err != errUnknownParameterSet {
+		t.Fatalf("got %+v != expect %+v", err, errUnknownParameterSet)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package mlkem
+
+import (
+	"crypto"
+	"crypto/rand"
+	"io"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+	"github.com/FishGoddess/cryptox/x509"
+)
+
+type KeyConfig struct {
+	seed             []byte
+	random           io.Reader
+	encodePrivateKey func(key PrivateKey) ([]byte, error)
+	encodePublicKey  func(key PublicKey) ([]byte, error)
+	decodePrivateKey func(data []byte) (PrivateKey, error)
+	decodePublicKey  func(data []byte) (PublicKey, error)
+}
+
+func newKeyConfig() *KeyConfig {
+	conf := &KeyConfig{
+		seed:             nil,
+		random:           rand.Reader,
+		encodePrivateKey: x509.EncodePrivateKeyPKCS8[PrivateKey],
+		encodePublicKey:  x509.EncodePublicKeyPKIX[PublicKey],
+		decodePrivateKey: x509.DecodePrivateKeyPKCS8[PrivateKey],
+		decodePublicKey:  x509.DecodePublicKeyPKIX[PublicKey],
+	}
+
+	return conf
+}
+
+func (kc *KeyConfig) Apply(opts ...KeyOption) *KeyConfig {
+	for _, opt := range opts {
+		opt(kc)
+	}
+
+	return kc
+}
+
+type KeyOption func(conf *KeyConfig)
+
+// WithKeySeed sets seed to key config.
+func WithKeySeed(seed []byte) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.seed = seed
+	}
+}
+
+// WithKeyRandom sets random to key config.
+func WithKeyRandom(random io.Reader) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.random = random
+	}
+}
+
+// WithKeyEncodePrivate sets encode to key config.
+func WithKeyEncodePrivate(encode func(key PrivateKey) ([]byte, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.encodePrivateKey = encode
+	}
+}
+
+// WithKeyEncodePublic sets encode to key config.
+func WithKeyEncodePublic(encode func(key PublicKey) ([]byte, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.encodePublicKey = encode
+	}
+}
+
+// WithKeyDecodePrivate sets decode to key config.
+func WithKeyDecodePrivate(decode func(data []byte) (PrivateKey, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.decodePrivateKey = decode
+	}
+}
+
+// WithKeyDecodePublic sets decode to key config.
+func WithKeyDecodePublic(decode func(data []byte) (PublicKey, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.decodePublicKey = decode
+	}
+}
+
+type Config struct {
+	encoding    encoding.Encoding
+	random      io.Reader
+	hkdfHash    crypto.Hash
+	hkdfKeySize int
+	hkdfInfo    string
+}
+
+func newConfig() *Config {
+	conf := &Config{
+		encoding:    encoding.None{},
+		random:      rand.Reader,
+		hkdfHash:    crypto.Hash(0),
+		hkdfKeySize: 0,
+		hkdfInfo:    "",
+	}
+
+	return conf
+}
+
+func (c *Config) Apply(opts ...Option) *Config {
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+type Option func(conf *Config)
+
+// WithHex sets hex encoding to config.
+func WithHex() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Hex{}
+	}
+}
+
+// WithBase64 sets base64 encoding to config.
+func WithBase64() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Base64{}
+	}
+}
+
+// WithRandom sets random to config.
+// It's used to generate the ephemeral x25519 key of the hybrid kem.
+func WithRandom(random io.Reader) Option {
+	return func(conf *Config) {
+		conf.random = random
+	}
+}
+
+// WithHKDF sets hkdf to config.
+// The x-wing key of the hybrid kem will be derived to a key of keySize bytes, such as 16/24/32 for aes.
+func WithHKDF(hash crypto.Hash, keySize int) Option {
+	return func(conf *Config) {
+		conf.hkdfHash = hash
+		conf.hkdfKeySize = keySize
+	}
+}
+
+// WithHKDFInfo sets hkdf info to config.
+// It's only used with WithHKDF, and it's recommended to bind the derived key to its usage like "aes-gcm key".
+func WithHKDFInfo(info string) Option {
+	return func(conf *Config) {
+		conf.hkdfInfo = info
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package mlkem
+
+import (
+	"crypto"
+	"crypto/rand"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+	"github.com/FishGoddess/cryptox/x509"
+)
+
+// go test -v -cover -run=^TestKeyConfig$
+func TestKeyConfig(t *testing.T) {
+	seed := make([]byte, SeedSize)
+
+	opts := []KeyOption{
+		WithKeySeed(seed),
+		WithKeyRandom(rand.Reader),
+		WithKeyEncodePrivate(x509.EncodePrivateKeyPKCS8),
+		WithKeyEncodePublic(x509.EncodePublicKeyPKIX),
+		WithKeyDecodePrivate(x509.DecodePrivateKeyPKCS8),
+		WithKeyDecodePublic(x509.DecodePublicKeyPKIX),
+	}
+
+	conf := newKeyConfig().Apply(opts...)
+
+	if !slices.Equal(conf.seed, seed) {
+		t.Fatalf("got %s != expect %s", conf.seed, seed)
+	}
+
+	got := fmt.Sprintf("%p", conf.random)
+	expect := fmt.Sprintf("%p", rand.Reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.encodePrivateKey)
+	expect = fmt.Sprintf("%p", x509.EncodePrivateKeyPKCS8[PrivateKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.encodePublicKey)
+	expect = fmt.Sprintf("%p", x509.EncodePublicKeyPKIX[PublicKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.decodePrivateKey)
+	expect = fmt.Sprintf("%p", x509.DecodePrivateKeyPKCS8[PrivateKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.decodePublicKey)
+	expect = fmt.Sprintf("%p", x509.DecodePublicKeyPKIX[PublicKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestConfig$
+func TestConfig(t *testing.T) {
+	info := "info"
+
+	conf := newConfig()
+
+	if conf.hkdfHash != crypto.Hash(0) {
+		t.Fatalf("got %d != expect %d", conf.hkdfHash, crypto.Hash(0))
+	}
+
+	if conf.hkdfKeySize != 0 {
+		t.Fatalf("got %d != expect %d", conf.hkdfKeySize, 0)
+	}
+
+	opts := []Option{
+		WithHex(),
+		WithRandom(rand.Reader),
+		WithHKDF(crypto.SHA512, 16),
+		WithHKDFInfo(info),
+	}
+
+	conf.Apply(opts...)
+
+	got := fmt.Sprintf("%T", conf.encoding)
+	expect := fmt.Sprintf("%T", encoding.Hex{})
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	conf.Apply(WithBase64())
+
+	got = fmt.Sprintf("%T", conf.encoding)
+	expect = fmt.Sprintf("%T", encoding.Base64{})
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.random)
+	expect = fmt.Sprintf("%p", rand.Reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if conf.hkdfHash != crypto.SHA512 {
+		t.Fatalf("got %d != expect %d", conf.hkdfHash, crypto.SHA512)
+	}
+
+	if conf.hkdfKeySize != 16 {
+		t.Fatalf("got %d != expect %d", conf.hkdfKeySize, 16)
+	}
+
+	if conf.hkdfInfo != info {
+		t.Fatalf("got %s != expect %s", conf.hkdfInfo, info)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+// Encrypter encrypts data with the public key and the options bound to it.
+// The options are applied only once and the config is never modified, so it's goroutine-safe and can be reused.
+// Don't bind WithHash to it because the hash will be shared by all goroutines.
+type Encrypter struct {
+	key  PublicKey
+	conf *Config
+}
+
+// NewEncrypter returns a new encrypter of the public key and the options.
+func NewEncrypter(key PublicKey, opts ...Option) *Encrypter {
+	conf := newConfig().Apply(opts...)
+	return &Encrypter{key: key, conf: conf}
+}
+
+// EncryptPKCS1v15 encrypts data with pkcs1 v15.
+func (e *Encrypter) EncryptPKCS1v15(data []byte) ([]byte, error) {
+	return e.key.encryptPKCS1v15(e.conf, data)
+}
+
+// EncryptOAEP encrypts data with oaep.
+func (e *Encrypter) EncryptOAEP(data []byte, label []byte) ([]byte, error) {
+	return e.key.encryptOAEP(e.conf, data, label)
+}
+
+// EncryptPKCS1v15Segmented encrypts data with pkcs1 v15 in segments.
+func (e *Encrypter) EncryptPKCS1v15Segmented(data []byte) ([]byte, error) {
+	return e.key.encryptPKCS1v15Segmented(e.conf, data)
+}
+
+// EncryptOAEPSegmented encrypts data with oaep in segments.
+func (e *Encrypter) EncryptOAEPSegmented(data []byte, label []byte) ([]byte, error) {
+	return e.key.encryptOAEPSegmented(e.conf, data, label)
+}
+
+// Decrypter decrypts data with the private key and the options bound to it.
+// The options are applied only once and the config is never modified, so it's goroutine-safe and can be reused.
+// Don't bind WithHash to it because the hash will be shared by all goroutines.
+type Decrypter struct {
+	key  PrivateKey
+	conf *Config
+}
+
+// NewDecrypter returns a new decrypter of the private key and the options.
+func NewDecrypter(key PrivateKey, opts ...Option) *Decrypter {
+	conf := newConfig().Apply(opts...)
+	return &Decrypter{key: key, conf: conf}
+}
+
+// DecryptPKCS1v15 decrypts data with pkcs1 v15.
+func (d *Decrypter) DecryptPKCS1v15(data []byte) ([]byte, error) {
+	return d.key.decryptPKCS1v15(d.conf, data)
+}
+
+// DecryptPKCS1v15SessionKey decrypts data using a session key with pkcs1 v15.
+func (d *Decrypter) DecryptPKCS1v15SessionKey(data []byte, sessionKey []byte) error {
+	return d.key.decryptPKCS1v15SessionKey(d.conf, data, sessionKey)
+}
+
+// DecryptOAEP decrypts data with oaep.
+func (d *Decrypter) DecryptOAEP(data []byte, label []byte) ([]byte, error) {
+	return d.key.decryptOAEP(d.conf, data, label)
+}
+
+// DecryptPKCS1v15Segmented decrypts data with pkcs1 v15 in segments.
+func (d *Decrypter) DecryptPKCS1v15Segmented(data []byte) ([]byte, error) {
+	return d.key.decryptPKCS1v15Segmented(d.conf, data)
+}
+
+// DecryptOAEPSegmented decrypts data with oaep in segments.
+func (d *Decrypter) DecryptOAEPSegmented(data []byte, label []byte) ([]byte, error) {
+	return d.key.decryptOAEPSegmented(d.conf, data, label)
+}
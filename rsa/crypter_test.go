@@ -0,0 +1,156 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+import (
+	"crypto"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// go test -v -cover -run=^TestEncrypterDecrypter$
+func TestEncrypterDecrypter(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	opts := []Option{WithBase64(), WithOAEPHash(crypto.SHA512)}
+	encrypter := NewEncrypter(publicKey, opts...)
+	decrypter := NewDecrypter(privateKey, opts...)
+
+	data := []byte("你好，世界")
+	label := []byte("label")
+
+	encrypted, err := encrypter.EncryptOAEP(data, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err := privateKey.DecryptOAEP(encrypted, label, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+
+	encrypted, err = publicKey.EncryptOAEP(data, label, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err = decrypter.DecryptOAEP(encrypted, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+
+	encrypted, err = encrypter.EncryptPKCS1v15(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err = decrypter.DecryptPKCS1v15(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+
+	sessionKey := make([]byte, 16)
+
+	err = decrypter.DecryptPKCS1v15SessionKey(encrypted, sessionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestEncrypterDecrypterSegmented$
+func TestEncrypterDecrypterSegmented(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	opts := []Option{WithBase64(), WithOAEPHash(crypto.SHA1)}
+	encrypter := NewEncrypter(publicKey, opts...)
+	decrypter := NewDecrypter(privateKey, opts...)
+
+	data := []byte(strings.Repeat("你好，世界", 40))
+
+	encrypted, err := encrypter.EncryptPKCS1v15Segmented(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err := decrypter.DecryptPKCS1v15Segmented(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+
+	encrypted, err = encrypter.EncryptOAEPSegmented(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err = decrypter.DecryptOAEPSegmented(encrypted, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+}
+
+// go test -v -cover -run=^TestEncrypterDecrypterConcurrently$
+func TestEncrypterDecrypterConcurrently(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	encrypter := NewEncrypter(publicKey, WithHex())
+	decrypter := NewDecrypter(privateKey, WithHex())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 16)
+
+	for i := 0; i < 16; i++ {
+		wg.Go(func() {
+			data := []byte{byte(i), byte(i + 1), byte(i + 2)}
+
+			encrypted, err := encrypter.EncryptOAEP(data, nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			decrypted, err := decrypter.DecryptOAEP(encrypted, nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			if !slices.Equal(decrypted, data) {
+				t.Errorf("got %x != expect %x", decrypted, data)
+			}
+		})
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
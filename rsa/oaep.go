@@ -0,0 +1,63 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+)
+
+var errMGFHashWithHashFunc = errors.New("cryptox/rsa: oaep mgf1 hash can't be used with oaep hash func")
+
+// encryptOAEP encrypts data with oaep in the hashes of config.
+// The hash func of config is preferred, and the mgf1 hash is the same as the oaep hash if it's 0.
+func encryptOAEP(conf *Config, key *rsa.PublicKey, data []byte, label []byte) ([]byte, error) {
+	if conf.newHash != nil {
+		if conf.mgfHash != 0 {
+			return nil, errMGFHashWithHashFunc
+		}
+
+		return rsa.EncryptOAEP(conf.newHash(), conf.random, key, data, label)
+	}
+
+	if !conf.hash.Available() {
+		return nil, fmt.Errorf("cryptox/rsa: oaep hash %+v isn't available", conf.hash)
+	}
+
+	if conf.mgfHash == 0 || conf.mgfHash == conf.hash {
+		return rsa.EncryptOAEP(conf.hash.New(), conf.random, key, data, label)
+	}
+
+	if !conf.mgfHash.Available() {
+		return nil, fmt.Errorf("cryptox/rsa: oaep mgf1 hash %+v isn't available", conf.mgfHash)
+	}
+
+	opts := &rsa.OAEPOptions{Hash: conf.hash, MGFHash: conf.mgfHash, Label: label}
+	return encryptOAEPWithOptions(conf.random, key, data, opts)
+}
+
+// decryptOAEP decrypts data with oaep in the hashes of config.
+// The hash func of config is preferred, and the mgf1 hash is the same as the oaep hash if it's 0.
+func decryptOAEP(conf *Config, key *rsa.PrivateKey, data []byte, label []byte) ([]byte, error) {
+	if conf.newHash != nil {
+		if conf.mgfHash != 0 {
+			return nil, errMGFHashWithHashFunc
+		}
+
+		return rsa.DecryptOAEP(conf.newHash(), conf.random, key, data, label)
+	}
+
+	if !conf.hash.Available() {
+		return nil, fmt.Errorf("cryptox/rsa: oaep hash %+v isn't available", conf.hash)
+	}
+
+	if conf.mgfHash != 0 && !conf.mgfHash.Available() {
+		return nil, fmt.Errorf("cryptox/rsa: oaep mgf1 hash %+v isn't available", conf.mgfHash)
+	}
+
+	opts := &rsa.OAEPOptions{Hash: conf.hash, MGFHash: conf.mgfHash, Label: label}
+	return key.Decrypt(conf.random, data, opts)
+}
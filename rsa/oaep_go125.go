@@ -0,0 +1,19 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build !go1.26
+
+package rsa
+
+import (
+	"crypto/rsa"
+	"errors"
+	"io"
+)
+
+// encryptOAEPWithOptions returns an error because the stdlib can't encrypt with a different mgf1 hash before go1.26.
+// Decrypting with a different mgf1 hash is still supported.
+func encryptOAEPWithOptions(random io.Reader, key *rsa.PublicKey, data []byte, opts *rsa.OAEPOptions) ([]byte, error) {
+	return nil, errors.New("cryptox/rsa: encrypt with a different oaep mgf1 hash needs go1.26 or later")
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build !go1.26
+
+package rsa
+
+import (
+	"crypto"
+	"testing"
+)
+
+// go test -v -cover -run=^TestEncryptOAEPWithMGFHash$
+func TestEncryptOAEPWithMGFHash(t *testing.T) {
+	publicKey := newTestPublicKey()
+
+	data := []byte("你好，世界")
+	opts := []Option{WithOAEPHash(crypto.SHA256), WithOAEPMGFHash(crypto.SHA1)}
+
+	_, err := publicKey.EncryptOAEP(data, nil, opts...)
+	if err == nil {
+		t.Fatal("encrypt with a different mgf1 hash should be failed before go1.26")
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build go1.26
+
+package rsa
+
+import (
+	"crypto/rsa"
+	"io"
+)
+
+// encryptOAEPWithOptions encrypts data with oaep in the options which allows a different mgf1 hash.
+func encryptOAEPWithOptions(random io.Reader, key *rsa.PublicKey, data []byte, opts *rsa.OAEPOptions) ([]byte, error) {
+	return rsa.EncryptOAEPWithOptions(random, key, data, opts)
+}
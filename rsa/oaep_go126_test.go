@@ -0,0 +1,42 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build go1.26
+
+package rsa
+
+import (
+	"crypto"
+	"slices"
+	"testing"
+)
+
+// go test -v -cover -run=^TestEncryptOAEPWithMGFHash$
+func TestEncryptOAEPWithMGFHash(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	data := []byte("你好，世界")
+	label := []byte("label")
+	opts := []Option{WithHex(), WithOAEPHash(crypto.SHA256), WithOAEPMGFHash(crypto.SHA1)}
+
+	encrypted, err := publicKey.EncryptOAEP(data, label, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err := privateKey.DecryptOAEP(encrypted, label, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+
+	_, err = privateKey.DecryptOAEP(encrypted, label, WithHex())
+	if err == nil {
+		t.Fatal("decrypt with wrong mgf1 hash should be failed")
+	}
+}
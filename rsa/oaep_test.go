@@ -0,0 +1,152 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/sha512"
+	"slices"
+	"testing"
+)
+
+// go test -v -cover -run=^TestDecryptOAEPOpenSSL$
+func TestDecryptOAEPOpenSSL(t *testing.T) {
+	privateKey := newTestPrivateKey()
+
+	type testCase struct {
+		opts      []Option
+		label     []byte
+		encrypted string
+	}
+
+	// openssl pkeyutl -encrypt -pkeyopt rsa_padding_mode:oaep -pkeyopt rsa_oaep_md:xxx -pkeyopt rsa_mgf1_md:xxx -pkeyopt rsa_oaep_label:xxx
+	testCases := []testCase{
+		{
+			opts:      []Option{WithBase64(), WithOAEPMGFHash(crypto.SHA1)},
+			label:     []byte("label"),
+			encrypted: "XBfWgBCvLIZYGXKhipWoJb2cNDbgtOD3ShjIxHDE4v5lm0ukXDHVCpq7Bf4P/UjnTV0ys7JZqDNRXtnpkUPuG8iAzayxsnEv0EzqT60zr9noY6DnCrn1jUbtL5/vadJysA1CNBmW9zXoDuwLjluN4rP6HSUMqe/BLPqTWjS9G+o3PhGLQsU/nH+dNFReRmOeB2hQArlyHTukB3Km5Ad50eZBDtkwdA8VV9mTp0ytAEw93YTBCDlD2hRsuchIJbn4675dS2xyOx0VC6uhPrsIqG2xfvW3vuFMGh+9V3WwjrK45fe0aDjMGA0IF3ZdJmGomThJ3A9ixcb0m12wRVQXhQ==",
+		},
+		{
+			opts:      []Option{WithBase64(), WithOAEPHash(crypto.SHA512)},
+			label:     nil,
+			encrypted: "lcaVrfIwOKMy7N92JDHjR4eB17+Q5dh367HcvPS0qzrbNDeSbxt/TdQrqzhQdLOVvnsoBdBBOgpd/tQW6jQgOzQQ2kCVQ1r6xGKsto8aRDIP7gtDeBJPhV5oIBZzwfzT3c/UzhFHCV8X6QZOSQYflnzn4pdh9IDAUJ83J+fKP35ajpCLlM7RUxuga7AAXLirCqpGaHhNxpUpSeJvFkkKjdrRwOyJpO80RlS8DZhlrc17oKpgVl4Ffz2ZhswASWu2jNmxKgxCNxOR5CtVhd/sjYt6nsF/4oKoK2Ps5Uib2DbEsxqRLYzpkunvk28Qi7pxB7fY4qIyUphlEYKhIUC9VA==",
+		},
+		{
+			opts:      []Option{WithBase64(), WithOAEPHashFunc(sha512.New)},
+			label:     nil,
+			encrypted: "lcaVrfIwOKMy7N92JDHjR4eB17+Q5dh367HcvPS0qzrbNDeSbxt/TdQrqzhQdLOVvnsoBdBBOgpd/tQW6jQgOzQQ2kCVQ1r6xGKsto8aRDIP7gtDeBJPhV5oIBZzwfzT3c/UzhFHCV8X6QZOSQYflnzn4pdh9IDAUJ83J+fKP35ajpCLlM7RUxuga7AAXLirCqpGaHhNxpUpSeJvFkkKjdrRwOyJpO80RlS8DZhlrc17oKpgVl4Ffz2ZhswASWu2jNmxKgxCNxOR5CtVhd/sjYt6nsF/4oKoK2Ps5Uib2DbEsxqRLYzpkunvk28Qi7pxB7fY4qIyUphlEYKhIUC9VA==",
+		},
+	}
+
+	data := []byte("你好，世界")
+
+	for _, testCase := range testCases {
+		decrypted, err := privateKey.DecryptOAEP([]byte(testCase.encrypted), testCase.label, testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.Equal(decrypted, data) {
+			t.Fatalf("got %s != expect %s", decrypted, data)
+		}
+	}
+
+	// The mgf1 hash must be the same as encrypting.
+	_, err := privateKey.DecryptOAEP([]byte(testCases[0].encrypted), testCases[0].label, WithBase64())
+	if err == nil {
+		t.Fatal("decrypt with wrong mgf1 hash should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestEncryptDecryptOAEPHash$
+func TestEncryptDecryptOAEPHash(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	optsList := [][]Option{
+		{WithOAEPHash(crypto.SHA1)},
+		{WithOAEPHash(crypto.SHA384), WithOAEPMGFHash(crypto.SHA384)},
+		{WithOAEPHashFunc(sha256.New224)},
+		{WithHash(sha512.New())},
+	}
+
+	data := []byte("你好，世界")
+	label := []byte("label")
+
+	for _, opts := range optsList {
+		encrypted, err := publicKey.EncryptOAEP(data, label, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decrypted, err := privateKey.DecryptOAEP(encrypted, label, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.Equal(decrypted, data) {
+			t.Fatalf("got %s != expect %s", decrypted, data)
+		}
+
+		// The default hash is sha256 so decrypting should be failed.
+		_, err = privateKey.DecryptOAEP(encrypted, label)
+		if err == nil {
+			t.Fatal("decrypt with wrong hash should be failed")
+		}
+	}
+
+	// The hash func equals to the crypto hash.
+	encrypted, err := publicKey.EncryptOAEP(data, label, WithOAEPHashFunc(sha256.New))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err := privateKey.DecryptOAEP(encrypted, label, WithOAEPHash(crypto.SHA256))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+}
+
+// go test -v -cover -run=^TestOAEPInvalidHash$
+func TestOAEPInvalidHash(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	data := []byte("你好，世界")
+	encrypted := make([]byte, 256)
+
+	optsList := [][]Option{
+		{WithOAEPHash(crypto.Hash(999))},
+		{WithOAEPHash(0)},
+		{WithOAEPHashFunc(sha256.New), WithOAEPMGFHash(crypto.SHA1)},
+	}
+
+	for _, opts := range optsList {
+		_, err := publicKey.EncryptOAEP(data, nil, opts...)
+		if err == nil {
+			t.Fatal("encrypt with invalid hash should be failed")
+		}
+
+		_, err = privateKey.DecryptOAEP(encrypted, nil, opts...)
+		if err == nil {
+			t.Fatal("decrypt with invalid hash should be failed")
+		}
+	}
+
+	_, err := publicKey.EncryptOAEP(data, nil, WithOAEPMGFHash(crypto.Hash(999)))
+	if err == nil {
+		t.Fatal("encrypt with invalid mgf1 hash should be failed")
+	}
+
+	_, err = privateKey.DecryptOAEP(encrypted, nil, WithOAEPMGFHash(crypto.Hash(999)))
+	if err == nil {
+		t.Fatal("decrypt with invalid mgf1 hash should be failed")
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
-	"crypto/sha256"
 	"hash"
 	"io"
 
@@ -47,6 +46,7 @@ func (kc *KeyConfig) Apply(opts ...KeyOption) *KeyConfig {
 type KeyOption func(conf *KeyConfig)
 
 // WithKeyRandom sets random to key config.
+// The random is ignored by crypto/rsa since go 1.26 unless GODEBUG=cryptocustomrand=1 is set.
 func WithKeyRandom(random io.Reader) KeyOption {
 	return func(conf *KeyConfig) {
 		conf.random = random
@@ -84,7 +84,9 @@ func WithKeyDecodePublic(decode func(data []byte) (*rsa.PublicKey, error)) KeyOp
 type Config struct {
 	encoding   encoding.Encoding
 	random     io.Reader
-	hash       hash.Hash
+	hash       crypto.Hash
+	newHash    func() hash.Hash
+	mgfHash    crypto.Hash
 	cryptoHash crypto.Hash
 	saltLength int
 }
@@ -93,7 +95,9 @@ func newConfig() *Config {
 	conf := &Config{
 		encoding:   encoding.None{},
 		random:     rand.Reader,
-		hash:       sha256.New(),
+		hash:       crypto.SHA256,
+		newHash:    nil,
+		mgfHash:    0,
 		cryptoHash: crypto.SHA256,
 		saltLength: rsa.PSSSaltLengthAuto,
 	}
@@ -126,6 +130,7 @@ func WithBase64() Option {
 }
 
 // WithRandom sets random to config.
+// The random of encrypting and signing is ignored since go 1.26 unless GODEBUG=cryptocustomrand=1 is set.
 func WithRandom(random io.Reader) Option {
 	return func(conf *Config) {
 		conf.random = random
@@ -133,13 +138,47 @@ func WithRandom(random io.Reader) Option {
 }
 
 // WithHash sets hash to config.
-func WithHash(hash hash.Hash) Option {
+// It's only used in oaep encryption and decryption.
+//
+// Deprecated: The hash is shared by all encryptions and decryptions using the option, so it isn't goroutine-safe.
+// Use WithOAEPHash or WithOAEPHashFunc instead.
+func WithHash(h hash.Hash) Option {
+	return func(conf *Config) {
+		conf.newHash = func() hash.Hash {
+			h.Reset()
+			return h
+		}
+	}
+}
+
+// WithOAEPHash sets oaep hash to config.
+// The hash is created for every encryption and decryption so it's goroutine-safe.
+func WithOAEPHash(hash crypto.Hash) Option {
 	return func(conf *Config) {
 		conf.hash = hash
+		conf.newHash = nil
+	}
+}
+
+// WithOAEPHashFunc sets oaep hash func to config.
+// The hash func is called for every encryption and decryption so it's goroutine-safe if the func returns a new hash each time.
+func WithOAEPHashFunc(newHash func() hash.Hash) Option {
+	return func(conf *Config) {
+		conf.newHash = newHash
+	}
+}
+
+// WithOAEPMGFHash sets oaep mgf1 hash to config.
+// The mgf1 hash is the same as the oaep hash by default, and some systems like java use sha1 in mgf1 with sha256 in oaep.
+// It can't be used with WithOAEPHashFunc, and encrypting with a different mgf1 hash needs go1.26 or later.
+func WithOAEPMGFHash(hash crypto.Hash) Option {
+	return func(conf *Config) {
+		conf.mgfHash = hash
 	}
 }
 
 // WithCryptoHash sets crypto hash to config.
+// It's used in pkcs1 v15 and pss signing, and the data will be hashed in it by the message and reader signing.
 func WithCryptoHash(hash crypto.Hash) Option {
 	return func(conf *Config) {
 		conf.cryptoHash = hash
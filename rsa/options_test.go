@@ -94,12 +94,34 @@ func TestConfig(t *testing.T) {
 		t.Fatalf("got %s != expect %s", got, expect)
 	}
 
-	got = fmt.Sprintf("%p", conf.hash)
+	got = fmt.Sprintf("%p", conf.newHash())
 	expect = fmt.Sprintf("%p", hash)
 	if got != expect {
 		t.Fatalf("got %s != expect %s", got, expect)
 	}
 
+	conf.Apply(WithOAEPHash(crypto.SHA512), WithOAEPMGFHash(crypto.SHA1))
+
+	if conf.hash != crypto.SHA512 {
+		t.Fatalf("got %d != expect %d", conf.hash, crypto.SHA512)
+	}
+
+	if conf.newHash != nil {
+		t.Fatalf("got %p != expect nil", conf.newHash)
+	}
+
+	if conf.mgfHash != crypto.SHA1 {
+		t.Fatalf("got %d != expect %d", conf.mgfHash, crypto.SHA1)
+	}
+
+	conf.Apply(WithOAEPHashFunc(sha256.New224))
+
+	got = fmt.Sprintf("%p", conf.newHash)
+	expect = fmt.Sprintf("%p", sha256.New224)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
 	if conf.cryptoHash != crypto.SHA256 {
 		t.Fatalf("got %d != expect %d", conf.cryptoHash, crypto.SHA256)
 	}
@@ -16,7 +16,10 @@ type PrivateKey struct {
 // DecryptPKCS1v15 decrypts data with pkcs1 v15.
 func (pk PrivateKey) DecryptPKCS1v15(data []byte, opts ...Option) ([]byte, error) {
 	conf := newConfig().Apply(opts...)
+	return pk.decryptPKCS1v15(conf, data)
+}
 
+func (pk PrivateKey) decryptPKCS1v15(conf *Config, data []byte) ([]byte, error) {
 	data, err := conf.encoding.Decode(data)
 	if err != nil {
 		return nil, err
@@ -28,7 +31,10 @@ func (pk PrivateKey) DecryptPKCS1v15(data []byte, opts ...Option) ([]byte, error
 // DecryptPKCS1v15SessionKey decrypts data using a session key with pkcs1 v15.
 func (pk PrivateKey) DecryptPKCS1v15SessionKey(data []byte, sessionKey []byte, opts ...Option) error {
 	conf := newConfig().Apply(opts...)
+	return pk.decryptPKCS1v15SessionKey(conf, data, sessionKey)
+}
 
+func (pk PrivateKey) decryptPKCS1v15SessionKey(conf *Config, data []byte, sessionKey []byte) error {
 	data, err := conf.encoding.Decode(data)
 	if err != nil {
 		return err
@@ -38,21 +44,30 @@ func (pk PrivateKey) DecryptPKCS1v15SessionKey(data []byte, sessionKey []byte, o
 }
 
 // DecryptOAEP decrypts data with oaep.
+// The oaep hash is sha256 by default, and the mgf1 hash is the same as the oaep hash.
 func (pk PrivateKey) DecryptOAEP(data []byte, label []byte, opts ...Option) ([]byte, error) {
 	conf := newConfig().Apply(opts...)
+	return pk.decryptOAEP(conf, data, label)
+}
 
+func (pk PrivateKey) decryptOAEP(conf *Config, data []byte, label []byte) ([]byte, error) {
 	data, err := conf.encoding.Decode(data)
 	if err != nil {
 		return nil, err
 	}
 
-	return rsa.DecryptOAEP(conf.hash, conf.random, pk.key, data, label)
+	return decryptOAEP(conf, pk.key, data, label)
 }
 
 // SignPKCS1v15 signs hashed with pkcs1 v15.
+// The hashed must be the digest in crypto hash, or use SignPKCS1v15Message to sign the data directly.
 func (pk PrivateKey) SignPKCS1v15(hashed []byte, opts ...Option) ([]byte, error) {
 	conf := newConfig().Apply(opts...)
 
+	if err := checkDigest(hashed, conf.cryptoHash); err != nil {
+		return nil, err
+	}
+
 	sign, err := rsa.SignPKCS1v15(conf.random, pk.key, conf.cryptoHash, hashed)
 	if err != nil {
 		return nil, err
@@ -63,6 +78,7 @@ func (pk PrivateKey) SignPKCS1v15(hashed []byte, opts ...Option) ([]byte, error)
 }
 
 // SignPSS signs digest with pss.
+// The digest must be in crypto hash, or use SignPSSMessage to sign the data directly.
 func (pk PrivateKey) SignPSS(digest []byte, opts ...Option) ([]byte, error) {
 	conf := newConfig().Apply(opts...)
 
@@ -70,6 +86,10 @@ func (pk PrivateKey) SignPSS(digest []byte, opts ...Option) ([]byte, error) {
 		return nil, fmt.Errorf("cryptox/rsa: crypto hash %+v isn't available", conf.cryptoHash)
 	}
 
+	if err := checkDigest(digest, conf.cryptoHash); err != nil {
+		return nil, err
+	}
+
 	pssOpts := &rsa.PSSOptions{Hash: conf.cryptoHash, SaltLength: conf.saltLength}
 
 	sign, err := rsa.SignPSS(conf.random, pk.key, conf.cryptoHash, digest, pssOpts)
@@ -88,7 +108,10 @@ type PublicKey struct {
 // EncryptPKCS1v15 encrypts data with pkcs1 v15.
 func (pk PublicKey) EncryptPKCS1v15(data []byte, opts ...Option) ([]byte, error) {
 	conf := newConfig().Apply(opts...)
+	return pk.encryptPKCS1v15(conf, data)
+}
 
+func (pk PublicKey) encryptPKCS1v15(conf *Config, data []byte) ([]byte, error) {
 	data, err := rsa.EncryptPKCS1v15(conf.random, pk.key, data)
 	if err != nil {
 		return nil, err
@@ -99,10 +122,14 @@ func (pk PublicKey) EncryptPKCS1v15(data []byte, opts ...Option) ([]byte, error)
 }
 
 // EncryptOAEP encrypts data with oaep.
+// The oaep hash is sha256 by default, and the mgf1 hash is the same as the oaep hash.
 func (pk PublicKey) EncryptOAEP(data []byte, label []byte, opts ...Option) ([]byte, error) {
 	conf := newConfig().Apply(opts...)
+	return pk.encryptOAEP(conf, data, label)
+}
 
-	data, err := rsa.EncryptOAEP(conf.hash, conf.random, pk.key, data, label)
+func (pk PublicKey) encryptOAEP(conf *Config, data []byte, label []byte) ([]byte, error) {
+	data, err := encryptOAEP(conf, pk.key, data, label)
 	if err != nil {
 		return nil, err
 	}
@@ -112,9 +139,14 @@ func (pk PublicKey) EncryptOAEP(data []byte, label []byte, opts ...Option) ([]by
 }
 
 // VerifyPKCS1v15 verifies hashed with pkcs1 v15.
+// The hashed must be the digest in crypto hash, or use VerifyPKCS1v15Message to verify the data directly.
 func (pk PublicKey) VerifyPKCS1v15(hashed []byte, sign []byte, opts ...Option) error {
 	conf := newConfig().Apply(opts...)
 
+	if err := checkDigest(hashed, conf.cryptoHash); err != nil {
+		return err
+	}
+
 	sign, err := conf.encoding.Decode(sign)
 	if err != nil {
 		return err
@@ -124,6 +156,7 @@ func (pk PublicKey) VerifyPKCS1v15(hashed []byte, sign []byte, opts ...Option) e
 }
 
 // VerifyPSS verifies digest with pss.
+// The digest must be in crypto hash, or use VerifyPSSMessage to verify the data directly.
 func (pk PublicKey) VerifyPSS(digest []byte, sign []byte, opts ...Option) error {
 	conf := newConfig().Apply(opts...)
 
@@ -131,6 +164,10 @@ func (pk PublicKey) VerifyPSS(digest []byte, sign []byte, opts ...Option) error
 		return fmt.Errorf("cryptox/rsa: crypto hash %+v isn't available", conf.cryptoHash)
 	}
 
+	if err := checkDigest(digest, conf.cryptoHash); err != nil {
+		return err
+	}
+
 	sign, err := conf.encoding.Decode(sign)
 	if err != nil {
 		return err
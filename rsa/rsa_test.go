@@ -7,8 +7,11 @@ package rsa
 import (
 	"bytes"
 	"crypto/sha256"
+	"io"
 	"slices"
 	"testing"
+
+	"github.com/FishGoddess/cryptox/bytes/rand"
 )
 
 type encryptTestCase struct {
@@ -447,3 +450,52 @@ func TestSignVerifyPSS(t *testing.T) {
 		}
 	}
 }
+
+// go test -v -cover -run=^TestWithDRBG$
+func TestWithDRBG(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	seed := []byte("123456788765432112345678876543211234567887654321")
+
+	drbg1, err := rand.NewHMACDRBG(rand.WithDRBGSeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	drbg2, err := rand.NewCTRDRBG(rand.WithDRBGSeed(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("你好，世界")
+	label := []byte("label")
+
+	for _, drbg := range []io.Reader{drbg1, drbg2} {
+		encrypted, err := publicKey.EncryptOAEP(data, label, WithRandom(drbg))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decrypted, err := privateKey.DecryptOAEP(encrypted, label, WithRandom(drbg))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.Equal(decrypted, data) {
+			t.Fatalf("encrypted %q: got %+v != expect %+v", encrypted, decrypted, data)
+		}
+
+		digest := sha256.Sum256(data)
+
+		sign, err := privateKey.SignPSS(digest[:], WithRandom(drbg))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = publicKey.VerifyPSS(digest[:], sign)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+import (
+	"crypto/rsa"
+	"fmt"
+)
+
+// pkcs1v15Overhead is the padding size of pkcs1 v15 in every block.
+const pkcs1v15Overhead = 11
+
+// oaepHashSize returns the size of the oaep hash in config.
+func oaepHashSize(conf *Config) (int, error) {
+	if conf.newHash != nil {
+		return conf.newHash().Size(), nil
+	}
+
+	if !conf.hash.Available() {
+		return 0, fmt.Errorf("cryptox/rsa: oaep hash %+v isn't available", conf.hash)
+	}
+
+	return conf.hash.Size(), nil
+}
+
+// oaepBlockSize returns the max size of data block in oaep, which is k - 2 * hash size - 2.
+func oaepBlockSize(conf *Config, key *rsa.PublicKey) (int, error) {
+	hashSize, err := oaepHashSize(conf)
+	if err != nil {
+		return 0, err
+	}
+
+	blockSize := key.Size() - 2*hashSize - 2
+	if blockSize <= 0 {
+		return 0, fmt.Errorf("cryptox/rsa: key size %d is too small for oaep hash size %d", key.Size(), hashSize)
+	}
+
+	return blockSize, nil
+}
+
+// pkcs1v15BlockSize returns the max size of data block in pkcs1 v15, which is k - 11.
+func pkcs1v15BlockSize(key *rsa.PublicKey) (int, error) {
+	blockSize := key.Size() - pkcs1v15Overhead
+	if blockSize <= 0 {
+		return 0, fmt.Errorf("cryptox/rsa: key size %d is too small for pkcs1 v15 overhead %d", key.Size(), pkcs1v15Overhead)
+	}
+
+	return blockSize, nil
+}
+
+// encryptSegmented splits data into blocks of block size and concatenates the encrypted blocks.
+// It's the same as the common java implementations which encrypt data in a loop of cipher.doFinal.
+func encryptSegmented(data []byte, blockSize int, keySize int, encrypt func(block []byte) ([]byte, error)) ([]byte, error) {
+	blocks := (len(data) + blockSize - 1) / blockSize
+	encrypted := make([]byte, 0, blocks*keySize)
+
+	for len(data) > 0 {
+		n := min(len(data), blockSize)
+
+		block, err := encrypt(data[:n])
+		if err != nil {
+			return nil, err
+		}
+
+		encrypted = append(encrypted, block...)
+		data = data[n:]
+	}
+
+	return encrypted, nil
+}
+
+// decryptSegmented splits data into blocks of key size and concatenates the decrypted blocks.
+func decryptSegmented(data []byte, keySize int, decrypt func(block []byte) ([]byte, error)) ([]byte, error) {
+	if len(data)%keySize != 0 {
+		return nil, fmt.Errorf("cryptox/rsa: decrypt segmented len(data) %d %% keySize %d != 0", len(data), keySize)
+	}
+
+	decrypted := make([]byte, 0, len(data))
+
+	for len(data) > 0 {
+		block, err := decrypt(data[:keySize])
+		if err != nil {
+			return nil, err
+		}
+
+		decrypted = append(decrypted, block...)
+		data = data[keySize:]
+	}
+
+	return decrypted, nil
+}
+
+// EncryptPKCS1v15Segmented encrypts data with pkcs1 v15 in segments, which is known as 分段加密.
+// The data is split into blocks of k - 11 bytes where k is the key size, so it can be longer than the key.
+// The encrypted blocks of k bytes are concatenated and then encoded, which is the same as the common java implementations.
+func (pk PublicKey) EncryptPKCS1v15Segmented(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+	return pk.encryptPKCS1v15Segmented(conf, data)
+}
+
+func (pk PublicKey) encryptPKCS1v15Segmented(conf *Config, data []byte) ([]byte, error) {
+	blockSize, err := pkcs1v15BlockSize(pk.key)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypt := func(block []byte) ([]byte, error) {
+		return rsa.EncryptPKCS1v15(conf.random, pk.key, block)
+	}
+
+	data, err = encryptSegmented(data, blockSize, pk.key.Size(), encrypt)
+	if err != nil {
+		return nil, err
+	}
+
+	data = conf.encoding.Encode(data)
+	return data, nil
+}
+
+// EncryptOAEPSegmented encrypts data with oaep in segments, which is known as 分段加密.
+// The data is split into blocks of k - 2 * hash size - 2 bytes where k is the key size, so it can be longer than the key.
+// The encrypted blocks of k bytes are concatenated and then encoded, which is the same as the common java implementations.
+// Note that java uses sha1 in mgf1 by default even in OAEPWithSHA-256AndMGF1Padding, so use WithOAEPMGFHash to match it.
+func (pk PublicKey) EncryptOAEPSegmented(data []byte, label []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+	return pk.encryptOAEPSegmented(conf, data, label)
+}
+
+func (pk PublicKey) encryptOAEPSegmented(conf *Config, data []byte, label []byte) ([]byte, error) {
+	blockSize, err := oaepBlockSize(conf, pk.key)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypt := func(block []byte) ([]byte, error) {
+		return encryptOAEP(conf, pk.key, block, label)
+	}
+
+	data, err = encryptSegmented(data, blockSize, pk.key.Size(), encrypt)
+	if err != nil {
+		return nil, err
+	}
+
+	data = conf.encoding.Encode(data)
+	return data, nil
+}
+
+// DecryptPKCS1v15Segmented decrypts data with pkcs1 v15 in segments, which is known as 分段解密.
+// The data is decoded and then split into blocks of k bytes where k is the key size.
+func (pk PrivateKey) DecryptPKCS1v15Segmented(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+	return pk.decryptPKCS1v15Segmented(conf, data)
+}
+
+func (pk PrivateKey) decryptPKCS1v15Segmented(conf *Config, data []byte) ([]byte, error) {
+	data, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypt := func(block []byte) ([]byte, error) {
+		return rsa.DecryptPKCS1v15(conf.random, pk.key, block)
+	}
+
+	return decryptSegmented(data, pk.key.Size(), decrypt)
+}
+
+// DecryptOAEPSegmented decrypts data with oaep in segments, which is known as 分段解密.
+// The data is decoded and then split into blocks of k bytes where k is the key size.
+func (pk PrivateKey) DecryptOAEPSegmented(data []byte, label []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+	return pk.decryptOAEPSegmented(conf, data, label)
+}
+
+func (pk PrivateKey) decryptOAEPSegmented(conf *Config, data []byte, label []byte) ([]byte, error) {
+	data, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypt := func(block []byte) ([]byte, error) {
+		return decryptOAEP(conf, pk.key, block, label)
+	}
+
+	return decryptSegmented(data, pk.key.Size(), decrypt)
+}
@@ -0,0 +1,232 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// go test -v -cover -run=^TestDecryptSegmentedOpenSSL$
+func TestDecryptSegmentedOpenSSL(t *testing.T) {
+	privateKey := newTestPrivateKey()
+
+	// The data is split into blocks of 245 bytes in pkcs1 v15 and 190 bytes in oaep, which is the same as java.
+	// Every block is encrypted by openssl pkeyutl -encrypt and then the encrypted blocks are concatenated.
+	pkcs1v15Encrypted := "Ma8P9Dpo0t6UEtcvGXM/P1zlye5sCpniwQB2RqtBxmj11AqPuN44VTS/joyyFVQbcnqjgdu3y3KPCQZA08epdZBFQWDW22ucw+6zx+xCl3tqgO0AOpzapewsFKy0h53kSwiOYsnHSPoJtE0JloETW83jReDk6gPZzCAJo4v8aKaRlLsm7uLAAELu27mzrLojhPtu5xPzSx89w6uz0eL2LqXrDAwgKggId+6pJrIwGVxgw5bEmz2rvewsBQrMN+3tr/APzom66Lnm8YZVZje6mj4SJYtX9cmNN9bjFPTBoBDs3aUo8K3NDILv7MyqiNy1r7p0vLJIw5jzWC7pq5s0yRUJ6UkJAJDA2XMRuDJ6gAj+ou4sBtVsfiCA4S61lAf43xOL6+OwpvD7IBmCgzTZoSuFIbv4FaGgySoop2CJbVWiELJy29AzgKJsKwQWDLqRFcrs0o2QnufGscqx4/TkrWm3FrMQS+b4Bt4P55THyOMa6z30jcqIRsnKzOBDhm3GNuh2MsNiKbCiLgpUs5TVGBO8Bb/GMRhx2XBKk5zY86YSPWdpFDHiPwlNrUz1cSnmoUDbl0gIPR06iADaHs4pvg6CEceSwNI1WJGbVlHl3Wt6L6wcozPGIBz1AeGuE7SDoIh9bS+KdWabqBWqCR1773GvaME2Ly9iQURExbVGXNiAgqT4UoLLfCNe4fX70z6qpCk+exbbkF5sYRIQIuxjfo0WUUyjykDltVm2ccIe2bJZIqPpqjkamrq4Y3C7fUrnOSZgpeXbTepEwwFHyExNCyRYw9j8RSVJYNZBiJkfOldtiea9JpScJGK9m6O2TEuFLMRadXlllLv1Um8538VR/xCtFKzAPUG3TOCW/nPPbQ+WKKzrc3qIhfPw/03bXHWBPtRiWiqsChdFMXtXehZT5Vqn5VLLxPbfbrg97eoDvwi74zy4BmYHXj8PwUFbzZW6cBcy3AYEil1v5j0sFs+aVDdUxStsZk5RKHkK7CWwiZRnAl7fwhvXdYuGXKTkhHOz"
+	oaepEncrypted := "LqULUkZmkZJfgBaIxmN+bZAnY1HdjM6oufJi2ZEeXUy1RJaHc8C3AWIMgvTYRMp6bW2crFiXr+Sc2AK8Vbb3vqKSPxga98q3rtmicKgiOhPTo6biexfUxpUtPpWph469IRgxPzHN/dn8y4pEgKK7yFYAQexGv3tiuGiHOwVot+LrUFkgzes3rv1v+rkYeMJ4ZeJe15diVH1W7cLxeuLranA6QM1ZYLfo7Eqefo/MRA97hG9cmZBVzzEhpZqdMJiE9MSTLWBTYPxdgkbPKAhnMf/RzVw5r0Cuuc1Nw7t1B9dTMXnUkqXgFQ2gpXqsVX3JPvYw4Bnj9ySd1/7h8qpSh1TP2Lx8tYiu6BzFKVmu+ja3S+Tq6yZPvDD3rCt2sg65kciDpVSnI+lhk9bx+YKujsJa0VsTzL1EkNR9EEpnoOcir4diRDrB+rMeIFXWXEnEp1yJ2fUnf9vMEi7MluvzCmK11Gw+xln48hFkK5+NPGF4o9PLmCoGxICPHhz5uUTfxQ3EGG0tw3XlJuJ/Nc5fwScf0rEXVZ8+B7MoZBh3SeuNpzlYubnNpcoGjc9S7z1rUzrU0Mu93RPXV2WB7+AmxWXtbqwvWDdtBTkxPJpEbak9pUXuBY5zYGmTobA8BmYFgmxTCq506rYKzqyccGtFsKyXA4agGg70sZk/j8XTsSlvLzSYuelVUugK4LSVsOHZ06M/7/6ai2/agd8cAyFukpm4eUX7mhVWMlz6AYleK1I/q/GnTJ395VSGTMPxhD2di1L3Q6cQZOM9j+eYfpqLmR92HszM7eVU7AIYY/SQn9/9ZiCAg2JjEnu21vl+HlgmnWQ0Sj3pEfP1VwSKQP8O0e+qc6KL257KDHwjdsPJ1te3sYRroD1Ad/R/I8LiGj1vqew7YeRoWG1gG6y6ulV3t39D9D+r9rHyOcQnZY0OphOmXA5dBOUyU5+0Rz8bOpigwXxRKgI6ZPd/UcAHJnLEaqp6gWAqIzUTDoaPSp237qDKCVnUyRQD1JItq2tYG1FiMTWhl7c3c+VrpJcjhk0hr7NCPeOueVdRwEI7hrLirITPbKks1RuaglsERe/ci61cGC5Vrl9dyBBu7yyO12l4jBS65zp0ejFaX8qbHCyiUkjUFHUJ/VSXZcyYYEeKF1h6fMGv5Y3XRHeukfPGpVHbEJ3Wjcey1H/xzcJmZkU/N/zvGApsAjB4ww/jQGQqrjPYV1N8/AW4W+HoG/uTjltsZd6NGTxVKmnxuKnccyMNp9CSsHYgGoir+Cvg4ulodqfDC5W7LqcQmF7RPrkK2UhryvTuNzT4Ki/YJ2QgU5kBQUqQWsUMxg2snPAwvFL2LBF4YXDy1+fXbkHPqfVypyWqiA=="
+	data := []byte(strings.Repeat("你好，世界", 40))
+
+	decrypted, err := privateKey.DecryptPKCS1v15Segmented([]byte(pkcs1v15Encrypted), WithBase64())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+
+	// Java uses sha1 in mgf1 in OAEPWithSHA-256AndMGF1Padding by default.
+	decrypted, err = privateKey.DecryptOAEPSegmented([]byte(oaepEncrypted), nil, WithBase64(), WithOAEPHash(crypto.SHA256), WithOAEPMGFHash(crypto.SHA1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(decrypted, data) {
+		t.Fatalf("got %s != expect %s", decrypted, data)
+	}
+}
+
+// go test -v -cover -run=^TestEncryptDecryptSegmented$
+func TestEncryptDecryptSegmented(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	type testCase struct {
+		opts      []Option
+		blockSize int
+	}
+
+	testCases := []testCase{
+		{opts: nil, blockSize: 190},
+		{opts: []Option{WithHex()}, blockSize: 190},
+		{opts: []Option{WithBase64(), WithOAEPHash(crypto.SHA1)}, blockSize: 214},
+		{opts: []Option{WithOAEPHash(crypto.SHA512)}, blockSize: 126},
+		{opts: []Option{WithOAEPHashFunc(sha256.New224)}, blockSize: 198},
+	}
+
+	for _, testCase := range testCases {
+		for _, size := range []int{0, 1, 245, 246, 1000} {
+			data := []byte(strings.Repeat("a", size))
+
+			encrypted, err := publicKey.EncryptPKCS1v15Segmented(data, testCase.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			decrypted, err := privateKey.DecryptPKCS1v15Segmented(encrypted, testCase.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(decrypted, data) {
+				t.Fatalf("got %s != expect %s", decrypted, data)
+			}
+
+			encrypted, err = publicKey.EncryptOAEPSegmented(data, []byte("label"), testCase.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			decrypted, err = privateKey.DecryptOAEPSegmented(encrypted, []byte("label"), testCase.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(decrypted, data) {
+				t.Fatalf("got %s != expect %s", decrypted, data)
+			}
+		}
+
+		conf := newConfig().Apply(testCase.opts...)
+
+		blockSize, err := oaepBlockSize(conf, publicKey.key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if blockSize != testCase.blockSize {
+			t.Fatalf("got %d != expect %d", blockSize, testCase.blockSize)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestEncryptSegmentedBlocks$
+func TestEncryptSegmentedBlocks(t *testing.T) {
+	publicKey := newTestPublicKey()
+
+	type testCase struct {
+		size   int
+		blocks int
+	}
+
+	testCases := []testCase{
+		{size: 0, blocks: 0},
+		{size: 1, blocks: 1},
+		{size: 245, blocks: 1},
+		{size: 246, blocks: 2},
+		{size: 490, blocks: 2},
+		{size: 491, blocks: 3},
+	}
+
+	for _, testCase := range testCases {
+		data := make([]byte, testCase.size)
+
+		encrypted, err := publicKey.EncryptPKCS1v15Segmented(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(encrypted) != testCase.blocks*256 {
+			t.Fatalf("got %d != expect %d", len(encrypted), testCase.blocks*256)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSegmentedInvalid$
+func TestSegmentedInvalid(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	data := []byte(strings.Repeat("你好，世界", 40))
+
+	encrypted, err := publicKey.EncryptPKCS1v15Segmented(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = privateKey.DecryptPKCS1v15Segmented(encrypted[1:])
+	if err == nil {
+		t.Fatal("decrypt with wrong data size should be failed")
+	}
+
+	// The blocks can't be decrypted in another padding.
+	_, err = privateKey.DecryptOAEPSegmented(encrypted, nil)
+	if err == nil {
+		t.Fatal("decrypt with wrong padding should be failed")
+	}
+
+	encrypted[300] ^= 1
+
+	_, err = privateKey.DecryptPKCS1v15Segmented(encrypted)
+	if err == nil {
+		t.Fatal("decrypt with tampered data should be failed")
+	}
+
+	_, err = privateKey.DecryptPKCS1v15Segmented([]byte("xx"), WithHex())
+	if err == nil {
+		t.Fatal("decrypt with wrong hex should be failed")
+	}
+
+	_, err = privateKey.DecryptOAEPSegmented([]byte("xx"), nil, WithHex())
+	if err == nil {
+		t.Fatal("decrypt with wrong hex should be failed")
+	}
+
+	_, err = publicKey.EncryptOAEPSegmented(data, nil, WithOAEPHash(crypto.Hash(999)))
+	if err == nil {
+		t.Fatal("encrypt with unavailable hash should be failed")
+	}
+
+	_, err = publicKey.EncryptOAEPSegmented(data, nil, WithOAEPHashFunc(sha256.New), WithOAEPMGFHash(crypto.SHA1))
+	if err == nil {
+		t.Fatal("encrypt with mgf1 hash and hash func should be failed")
+	}
+
+	_, err = publicKey.EncryptPKCS1v15Segmented(data, WithRandom(testErrorReader{}))
+	if err == nil {
+		t.Fatal("encrypt with error random should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestOAEPBlockSizeTooSmall$
+func TestOAEPBlockSizeTooSmall(t *testing.T) {
+	_, publicKey, err := GenerateKeys(1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The block size of 1024 bits key in sha512 is 128 - 2 * 64 - 2 < 0.
+	_, err = publicKey.EncryptOAEPSegmented([]byte("你好，世界"), nil, WithOAEPHash(crypto.SHA512))
+	if err == nil {
+		t.Fatal("encrypt with too small key should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestPKCS1v15BlockSizeTooSmall$
+func TestPKCS1v15BlockSizeTooSmall(t *testing.T) {
+	// The block size of 88 bits key is 11 - 11 = 0, which will divide by zero in splitting.
+	key := &rsa.PublicKey{N: new(big.Int).Lsh(big.NewInt(1), 87), E: 65537}
+	publicKey := PublicKey{key: key}
+
+	_, err := publicKey.EncryptPKCS1v15Segmented([]byte("你好，世界"))
+	if err == nil {
+		t.Fatal("encrypt with too small key should be failed")
+	}
+
+	// The block size of 64 bits key is 8 - 11 < 0, which will slice with a negative index.
+	key = &rsa.PublicKey{N: new(big.Int).Lsh(big.NewInt(1), 63), E: 65537}
+	publicKey = PublicKey{key: key}
+
+	_, err = publicKey.EncryptPKCS1v15Segmented([]byte("你好，世界"))
+	if err == nil {
+		t.Fatal("encrypt with too small key should be failed")
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+import (
+	"crypto"
+	"fmt"
+	"io"
+)
+
+// checkDigest checks if the size of digest matches the crypto hash.
+// The digest is the raw data to be signed if the crypto hash is 0 in pkcs1 v15, so it won't be checked.
+func checkDigest(digest []byte, cryptoHash crypto.Hash) error {
+	if cryptoHash == 0 {
+		return nil
+	}
+
+	if !cryptoHash.Available() {
+		return fmt.Errorf("cryptox/rsa: crypto hash %+v isn't available", cryptoHash)
+	}
+
+	if len(digest) != cryptoHash.Size() {
+		return fmt.Errorf("cryptox/rsa: digest len %d != crypto hash %+v size %d", len(digest), cryptoHash, cryptoHash.Size())
+	}
+
+	return nil
+}
+
+// digestReader hashes the data read from reader in the crypto hash.
+func digestReader(reader io.Reader, cryptoHash crypto.Hash) ([]byte, error) {
+	if !cryptoHash.Available() {
+		return nil, fmt.Errorf("cryptox/rsa: crypto hash %+v isn't available", cryptoHash)
+	}
+
+	hash := cryptoHash.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return nil, err
+	}
+
+	return hash.Sum(nil), nil
+}
+
+// digest hashes data in the crypto hash.
+func digest(data []byte, cryptoHash crypto.Hash) ([]byte, error) {
+	if !cryptoHash.Available() {
+		return nil, fmt.Errorf("cryptox/rsa: crypto hash %+v isn't available", cryptoHash)
+	}
+
+	hash := cryptoHash.New()
+	hash.Write(data)
+	return hash.Sum(nil), nil
+}
+
+// SignPKCS1v15Message hashes data in the crypto hash and signs the digest with pkcs1 v15.
+func (pk PrivateKey) SignPKCS1v15Message(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	hashed, err := digest(data, conf.cryptoHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return pk.SignPKCS1v15(hashed, opts...)
+}
+
+// SignPKCS1v15Reader hashes the data read from reader in the crypto hash and signs the digest with pkcs1 v15.
+// The data is hashed in stream so it's suitable for large files.
+func (pk PrivateKey) SignPKCS1v15Reader(reader io.Reader, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	hashed, err := digestReader(reader, conf.cryptoHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return pk.SignPKCS1v15(hashed, opts...)
+}
+
+// SignPSSMessage hashes data in the crypto hash and signs the digest with pss.
+func (pk PrivateKey) SignPSSMessage(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	hashed, err := digest(data, conf.cryptoHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return pk.SignPSS(hashed, opts...)
+}
+
+// SignPSSReader hashes the data read from reader in the crypto hash and signs the digest with pss.
+// The data is hashed in stream so it's suitable for large files.
+func (pk PrivateKey) SignPSSReader(reader io.Reader, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	hashed, err := digestReader(reader, conf.cryptoHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return pk.SignPSS(hashed, opts...)
+}
+
+// VerifyPKCS1v15Message hashes data in the crypto hash and verifies the signature of the digest with pkcs1 v15.
+func (pk PublicKey) VerifyPKCS1v15Message(data []byte, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	hashed, err := digest(data, conf.cryptoHash)
+	if err != nil {
+		return err
+	}
+
+	return pk.VerifyPKCS1v15(hashed, sign, opts...)
+}
+
+// VerifyPKCS1v15Reader hashes the data read from reader in the crypto hash and verifies the signature of the digest with pkcs1 v15.
+// The data is hashed in stream so it's suitable for large files.
+func (pk PublicKey) VerifyPKCS1v15Reader(reader io.Reader, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	hashed, err := digestReader(reader, conf.cryptoHash)
+	if err != nil {
+		return err
+	}
+
+	return pk.VerifyPKCS1v15(hashed, sign, opts...)
+}
+
+// VerifyPSSMessage hashes data in the crypto hash and verifies the signature of the digest with pss.
+func (pk PublicKey) VerifyPSSMessage(data []byte, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	hashed, err := digest(data, conf.cryptoHash)
+	if err != nil {
+		return err
+	}
+
+	return pk.VerifyPSS(hashed, sign, opts...)
+}
+
+// VerifyPSSReader hashes the data read from reader in the crypto hash and verifies the signature of the digest with pss.
+// The data is hashed in stream so it's suitable for large files.
+func (pk PublicKey) VerifyPSSReader(reader io.Reader, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	hashed, err := digestReader(reader, conf.cryptoHash)
+	if err != nil {
+		return err
+	}
+
+	return pk.VerifyPSS(hashed, sign, opts...)
+}
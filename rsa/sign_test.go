@@ -0,0 +1,271 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package rsa
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type testErrorReader struct{}
+
+func (testErrorReader) Read(p []byte) (n int, err error) {
+	return 0, errors.New("read failed")
+}
+
+// go test -v -cover -run=^TestSignVerifyPKCS1v15Message$
+func TestSignVerifyPKCS1v15Message(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	type testCase struct {
+		opts []Option
+		sign string
+	}
+
+	// openssl dgst -sha256/-sha512 -sign private.key
+	testCases := []testCase{
+		{
+			opts: []Option{WithHex()},
+			sign: "5bab0a48a630c94a1f8e9073adf5866d362e68539689e58d7320cbdc697c3f8ef5fbe4caffaa2e3edbbfd19d1141100fc50ea9989d1429cad74072b4eb15149577443402a475af6e9a1dfdb672ba601b3e80b56817b6c7da763401e53e3a2e544b58beb76ef6a3ff648ca46df1ec8be6e97b9cac21bf7a1d657c2b2feb6015a5476eaa75b3fd3cd3f906c644b52afeb2deb69af9986c5b7f8c9fdbe99da752c473febe595b967b1046c6d60d58499043d08f6b3c33951101ecc49c8e3a6de7cf961a1d36b13c5ef7a494da338b4d7f145bae879a5ea9f6bfcdcb97b278cd9cc101d7762d3b4c737736123d52cc1df96b18525742c58778677dc09c32c4195e00",
+		},
+		{
+			opts: []Option{WithBase64(), WithCryptoHash(crypto.SHA512)},
+			sign: "MPFi4mfRQOlNaBdQWRMidkdkdmfdkfYWELfBLTXnzl0zKyhmVDsSleiFLqcZsjaQJUiXLqDlBQtPbUv+ICPLrNVeN3DfgmVoteYTXoADpy1066DUdID9FIOo1Z51acb4GRWbv6Z64hYC+B9d7E1E0b0Rg3x/5OZELoi4ZNCXU6zWyfYkphN+aJBP+nFbz6WVEn4O3opcjbXV1X9dGkj79FK+GbNjZjCnLSYPDMT9H7+VYy6zHBg0+lI74SD+d+TK5KBpyTUFlGenO7CS6OU3mjgxmobN7elywrKttYFalUWsf9uSYGz6nd+BRMVL59FTJRzIaAAqGg9HcRAChS7QWQ==",
+		},
+	}
+
+	data := []byte("你好，世界")
+
+	for _, testCase := range testCases {
+		sign, err := privateKey.SignPKCS1v15Message(data, testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(sign) != testCase.sign {
+			t.Fatalf("got %s != expect %s", sign, testCase.sign)
+		}
+
+		sign, err = privateKey.SignPKCS1v15Reader(bytes.NewReader(data), testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(sign) != testCase.sign {
+			t.Fatalf("got %s != expect %s", sign, testCase.sign)
+		}
+
+		err = publicKey.VerifyPKCS1v15Message(data, sign, testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = publicKey.VerifyPKCS1v15Reader(bytes.NewReader(data), sign, testCase.opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = publicKey.VerifyPKCS1v15Message([]byte("你好，世界！"), sign, testCase.opts...)
+		if err == nil {
+			t.Fatal("verify with wrong data should be failed")
+		}
+	}
+
+	// The signature of message is the same as the signature of digest.
+	sign, err := privateKey.SignPKCS1v15Message(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	digestSign, err := privateKey.SignPKCS1v15(sum[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(sign, digestSign) {
+		t.Fatalf("got %x != expect %x", sign, digestSign)
+	}
+}
+
+// go test -v -cover -run=^TestSignVerifyPSSMessage$
+func TestSignVerifyPSSMessage(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	// openssl dgst -sha256 -sigopt rsa_padding_mode:pss -sigopt rsa_pss_saltlen:32 -sign private.key
+	opensslSign := "l/+GRwYVtS3iaYOsfX/3JDYrPUxXx4HamQi7KDnaCx7d98ZsFUUuTc6YFhVNli/waOy+keMVMTGfj1f8fG8E0ypqpMsabxyAgpQLOFR4AnnxShh8hK32wshv76c9dI0I1bvz/03q9sexCohFPWVv2YAdBfi6/Z0b6OWsatOQaHrCX1tVf6zK7kgTVXLp7TzOECyDkA5RG8BjRyqbTkcBEjK095dOr0e+ZRBypZZxsyN1KH8w61FtCFyA//u4RnxX67XJ9iAxpk/VIWyzy9UWKz0CUIWb+6iSKJBxZi/ygcJzlVy8JWF1B9vA5n4WSIURywm36U6xi38EFlRXa9W3Mw=="
+	data := []byte("你好，世界")
+
+	err := publicKey.VerifyPSSMessage(data, []byte(opensslSign), WithBase64())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.VerifyPSSReader(bytes.NewReader(data), []byte(opensslSign), WithBase64(), WithSalt(32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	optsList := [][]Option{
+		nil,
+		{WithHex()},
+		{WithBase64(), WithCryptoHash(crypto.SHA384)},
+		{WithCryptoHash(crypto.SHA512), WithSalt(16)},
+	}
+
+	for _, opts := range optsList {
+		sign, err := privateKey.SignPSSMessage(data, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = publicKey.VerifyPSSMessage(data, sign, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = publicKey.VerifyPSSReader(bytes.NewReader(data), sign, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sign, err = privateKey.SignPSSReader(strings.NewReader(string(data)), opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = publicKey.VerifyPSSMessage(data, sign, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = publicKey.VerifyPSSMessage([]byte("你好，世界！"), sign, opts...)
+		if err == nil {
+			t.Fatal("verify with wrong data should be failed")
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSignInvalidDigest$
+func TestSignInvalidDigest(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	data := []byte("你好，世界")
+	sum := sha256.Sum256(data)
+
+	// The raw data or the digest in another hash isn't allowed.
+	for _, digest := range [][]byte{data, sum[:20]} {
+		_, err := privateKey.SignPKCS1v15(digest)
+		if err == nil {
+			t.Fatalf("sign pkcs1 v15 with digest %x should be failed", digest)
+		}
+
+		_, err = privateKey.SignPSS(digest)
+		if err == nil {
+			t.Fatalf("sign pss with digest %x should be failed", digest)
+		}
+
+		err = publicKey.VerifyPKCS1v15(digest, make([]byte, 256))
+		if err == nil {
+			t.Fatalf("verify pkcs1 v15 with digest %x should be failed", digest)
+		}
+
+		err = publicKey.VerifyPSS(digest, make([]byte, 256))
+		if err == nil {
+			t.Fatalf("verify pss with digest %x should be failed", digest)
+		}
+	}
+
+	// The raw data is signed directly in pkcs1 v15 if the crypto hash is 0.
+	sign, err := privateKey.SignPKCS1v15(data, WithCryptoHash(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.VerifyPKCS1v15(data, sign, WithCryptoHash(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestSignMessageInvalid$
+func TestSignMessageInvalid(t *testing.T) {
+	privateKey := newTestPrivateKey()
+	publicKey := newTestPublicKey()
+
+	data := []byte("你好，世界")
+	sign := make([]byte, 256)
+
+	for _, cryptoHash := range []crypto.Hash{0, crypto.Hash(999)} {
+		_, err := privateKey.SignPKCS1v15Message(data, WithCryptoHash(cryptoHash))
+		if err == nil {
+			t.Fatalf("sign pkcs1 v15 message with crypto hash %d should be failed", cryptoHash)
+		}
+
+		_, err = privateKey.SignPSSMessage(data, WithCryptoHash(cryptoHash))
+		if err == nil {
+			t.Fatalf("sign pss message with crypto hash %d should be failed", cryptoHash)
+		}
+
+		_, err = privateKey.SignPKCS1v15Reader(bytes.NewReader(data), WithCryptoHash(cryptoHash))
+		if err == nil {
+			t.Fatalf("sign pkcs1 v15 reader with crypto hash %d should be failed", cryptoHash)
+		}
+
+		_, err = privateKey.SignPSSReader(bytes.NewReader(data), WithCryptoHash(cryptoHash))
+		if err == nil {
+			t.Fatalf("sign pss reader with crypto hash %d should be failed", cryptoHash)
+		}
+
+		err = publicKey.VerifyPKCS1v15Message(data, sign, WithCryptoHash(cryptoHash))
+		if err == nil {
+			t.Fatalf("verify pkcs1 v15 message with crypto hash %d should be failed", cryptoHash)
+		}
+
+		err = publicKey.VerifyPSSMessage(data, sign, WithCryptoHash(cryptoHash))
+		if err == nil {
+			t.Fatalf("verify pss message with crypto hash %d should be failed", cryptoHash)
+		}
+
+		err = publicKey.VerifyPKCS1v15Reader(bytes.NewReader(data), sign, WithCryptoHash(cryptoHash))
+		if err == nil {
+			t.Fatalf("verify pkcs1 v15 reader with crypto hash %d should be failed", cryptoHash)
+		}
+
+		err = publicKey.VerifyPSSReader(bytes.NewReader(data), sign, WithCryptoHash(cryptoHash))
+		if err == nil {
+			t.Fatalf("verify pss reader with crypto hash %d should be failed", cryptoHash)
+		}
+	}
+
+	_, err := privateKey.SignPKCS1v15Reader(testErrorReader{})
+	if err == nil {
+		t.Fatal("sign with error reader should be failed")
+	}
+
+	_, err = privateKey.SignPSSReader(testErrorReader{})
+	if err == nil {
+		t.Fatal("sign with error reader should be failed")
+	}
+
+	err = publicKey.VerifyPKCS1v15Reader(testErrorReader{}, sign)
+	if err == nil {
+		t.Fatal("verify with error reader should be failed")
+	}
+
+	err = publicKey.VerifyPSSReader(testErrorReader{}, sign)
+	if err == nil {
+		t.Fatal("verify with error reader should be failed")
+	}
+}
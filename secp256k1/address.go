@@ -0,0 +1,41 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package secp256k1
+
+import (
+	"encoding/hex"
+
+	"github.com/FishGoddess/cryptox/hash"
+)
+
+// AddressSize is the size of ethereum address.
+const AddressSize = 20
+
+// AddressBytes returns the 20 bytes ethereum address of the public key.
+// The address is the last 20 bytes of keccak256 of the uncompressed point without the 0x04 prefix.
+func (pk PublicKey) AddressBytes() []byte {
+	sum := hash.Keccak256(pk.Bytes()[1:])
+	return sum[len(sum)-AddressSize:]
+}
+
+// Address returns the ethereum address of the public key in eip-55 checksum form like 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed.
+func (pk PublicKey) Address() string {
+	address := []byte(hex.EncodeToString(pk.AddressBytes()))
+	sum := hash.Keccak256(address)
+
+	// The letter is uppercase if the corresponding nibble of keccak256 of the lowercase address >= 8.
+	for i, c := range address {
+		nibble := sum[i/2] >> 4
+		if i%2 == 1 {
+			nibble = sum[i/2] & 0x0f
+		}
+
+		if c >= 'a' && nibble >= 8 {
+			address[i] = c - 'a' + 'A'
+		}
+	}
+
+	return "0x" + string(address)
+}
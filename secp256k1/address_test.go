@@ -0,0 +1,46 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package secp256k1
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// go test -v -cover -run=^TestAddress$
+func TestAddress(t *testing.T) {
+	testCases := []struct {
+		privateKey string
+		address    string
+	}{
+		{
+			privateKey: "0000000000000000000000000000000000000000000000000000000000000001",
+			address:    "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+		},
+		{
+			privateKey: "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318",
+			address:    "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23",
+		},
+	}
+
+	for _, testCase := range testCases {
+		privateKey, err := NewPrivateKey(decodeHex(t, testCase.privateKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		publicKey := privateKey.PublicKey()
+
+		if publicKey.Address() != testCase.address {
+			t.Fatalf("got %s != expect %s", publicKey.Address(), testCase.address)
+		}
+
+		addressBytes := "0x" + hex.EncodeToString(publicKey.AddressBytes())
+		if addressBytes != strings.ToLower(testCase.address) {
+			t.Fatalf("got %s != expect %s", addressBytes, strings.ToLower(testCase.address))
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package secp256k1
+
+import (
+	"crypto/subtle"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// curveB3 is 3 * b of the curve y^2 = x^3 + 7.
+const curveB3 = 21
+
+// point is a point on the curve in homogeneous projective coordinates.
+// The point at infinity is (0:1:0), and all coordinates are kept normalized.
+type point struct {
+	x secp256k1.FieldVal
+	y secp256k1.FieldVal
+	z secp256k1.FieldVal
+}
+
+func newIdentityPoint() *point {
+	p := new(point)
+	p.y.SetInt(1)
+	return p
+}
+
+func newGeneratorPoint() *point {
+	params := secp256k1.Params()
+
+	p := new(point)
+	p.x.SetByteSlice(params.Gx.Bytes())
+	p.y.SetByteSlice(params.Gy.Bytes())
+	p.z.SetInt(1)
+	return p
+}
+
+func fieldAdd(z *secp256k1.FieldVal, x *secp256k1.FieldVal, y *secp256k1.FieldVal) {
+	z.Add2(x, y).Normalize()
+}
+
+func fieldSub(z *secp256k1.FieldVal, x *secp256k1.FieldVal, y *secp256k1.FieldVal) {
+	var negY secp256k1.FieldVal
+	negY.NegateVal(y, 1)
+	z.Add2(x, &negY).Normalize()
+}
+
+func fieldMul(z *secp256k1.FieldVal, x *secp256k1.FieldVal, y *secp256k1.FieldVal) {
+	z.Mul2(x, y).Normalize()
+}
+
+// add sets q to p1 + p2 using the complete formulas of a = 0 in renes-costello-batina 2015.
+// The formulas have no exceptional cases so they also work for doubling and the point at infinity.
+func (q *point) add(p1 *point, p2 *point) *point {
+	var t0, t1, t2, t3, t4, x3, y3, z3 secp256k1.FieldVal
+
+	fieldMul(&t0, &p1.x, &p2.x)
+	fieldMul(&t1, &p1.y, &p2.y)
+	fieldMul(&t2, &p1.z, &p2.z)
+	fieldAdd(&t3, &p1.x, &p1.y)
+	fieldAdd(&t4, &p2.x, &p2.y)
+	fieldMul(&t3, &t3, &t4)
+	fieldAdd(&t4, &t0, &t1)
+	fieldSub(&t3, &t3, &t4)
+	fieldAdd(&t4, &p1.y, &p1.z)
+	fieldAdd(&x3, &p2.y, &p2.z)
+	fieldMul(&t4, &t4, &x3)
+	fieldAdd(&x3, &t1, &t2)
+	fieldSub(&t4, &t4, &x3)
+	fieldAdd(&x3, &p1.x, &p1.z)
+	fieldAdd(&y3, &p2.x, &p2.z)
+	fieldMul(&x3, &x3, &y3)
+	fieldAdd(&y3, &t0, &t2)
+	fieldSub(&y3, &x3, &y3)
+	fieldAdd(&x3, &t0, &t0)
+	fieldAdd(&t0, &x3, &t0)
+	t2.MulInt(curveB3).Normalize()
+	fieldAdd(&z3, &t1, &t2)
+	fieldSub(&t1, &t1, &t2)
+	y3.MulInt(curveB3).Normalize()
+	fieldMul(&x3, &t4, &y3)
+	fieldMul(&t2, &t3, &t1)
+	fieldSub(&x3, &t2, &x3)
+	fieldMul(&y3, &y3, &t0)
+	fieldMul(&t1, &t1, &z3)
+	fieldAdd(&y3, &t1, &y3)
+	fieldMul(&t0, &t0, &t3)
+	fieldMul(&z3, &z3, &t4)
+	fieldAdd(&z3, &z3, &t0)
+
+	q.x.Set(&x3)
+	q.y.Set(&y3)
+	q.z.Set(&z3)
+	return q
+}
+
+// selectFrom sets q to p if cond is 1 or keeps q if cond is 0.
+// It computes q + cond * (p - q) so it runs in constant time.
+func (q *point) selectFrom(p *point, cond uint8) *point {
+	for _, pair := range [3][2]*secp256k1.FieldVal{{&q.x, &p.x}, {&q.y, &p.y}, {&q.z, &p.z}} {
+		var diff secp256k1.FieldVal
+		fieldSub(&diff, pair[1], pair[0])
+		diff.MulInt(cond)
+		fieldAdd(pair[0], pair[0], &diff)
+	}
+
+	return q
+}
+
+// scalarBaseMult sets result to k * g in affine coordinates in constant time.
+// The ScalarBaseMultNonConst of secp256k1 leaks the scalar by timing, so it's only used to verify.
+// This one uses a fixed 4-bit window and selects from the table in constant time.
+func scalarBaseMult(k *secp256k1.ModNScalar, result *secp256k1.JacobianPoint) {
+	var table [16]point
+	table[0] = *newIdentityPoint()
+	table[1] = *newGeneratorPoint()
+
+	for i := 2; i < 16; i++ {
+		table[i].add(&table[i-1], &table[1])
+	}
+
+	q := newIdentityPoint()
+	selected := new(point)
+
+	scalar := k.Bytes()
+	for _, b := range scalar {
+		for _, window := range [2]byte{b >> 4, b & 0x0F} {
+			q.add(q, q)
+			q.add(q, q)
+			q.add(q, q)
+			q.add(q, q)
+
+			*selected = *newIdentityPoint()
+			for i := range table {
+				cond := uint8(subtle.ConstantTimeByteEq(window, byte(i)))
+				selected.selectFrom(&table[i], cond)
+			}
+
+			q.add(q, selected)
+		}
+	}
+
+	// The affine coordinates are x / z and y / z.
+	var zInv secp256k1.FieldVal
+	zInv.Set(&q.z).Inverse()
+
+	fieldMul(&result.X, &q.x, &zInv)
+	fieldMul(&result.Y, &q.y, &zInv)
+	result.Z.SetInt(1)
+}
@@ -0,0 +1,42 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package secp256k1
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// go test -v -cover -run=^TestScalarBaseMult$
+func TestScalarBaseMult(t *testing.T) {
+	var one, minusOne secp256k1.ModNScalar
+	one.SetInt(1)
+	minusOne.SetInt(1).Negate()
+
+	scalars := []secp256k1.ModNScalar{one, minusOne}
+	for i := 0; i < 100; i++ {
+		var buffer [32]byte
+		if _, err := rand.Read(buffer[:]); err != nil {
+			t.Fatal(err)
+		}
+
+		var k secp256k1.ModNScalar
+		k.SetBytes(&buffer)
+		scalars = append(scalars, k)
+	}
+
+	for _, k := range scalars {
+		var got, expect secp256k1.JacobianPoint
+		scalarBaseMult(&k, &got)
+		secp256k1.ScalarBaseMultNonConst(&k, &expect)
+		expect.ToAffine()
+
+		if !got.X.Equals(&expect.X) || !got.Y.Equals(&expect.Y) {
+			t.Fatalf("got (%v, %v) != expect (%v, %v)", got.X, got.Y, expect.X, expect.Y)
+		}
+	}
+}
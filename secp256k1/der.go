@@ -0,0 +1,164 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package secp256k1
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+)
+
+var (
+	// oidPublicKeyEC is the algorithm of ec public key in rfc 5480.
+	oidPublicKeyEC = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+
+	// oidNamedCurveSecp256k1 is the named curve of secp256k1 in sec 2.
+	oidNamedCurveSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+var errInvalidDER = errors.New("cryptox/secp256k1: invalid der")
+
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+type publicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+func algorithmIdentifier() (pkix.AlgorithmIdentifier, error) {
+	params, err := asn1.Marshal(oidNamedCurveSecp256k1)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, err
+	}
+
+	algo := pkix.AlgorithmIdentifier{
+		Algorithm:  oidPublicKeyEC,
+		Parameters: asn1.RawValue{FullBytes: params},
+	}
+
+	return algo, nil
+}
+
+func checkAlgorithmIdentifier(algo pkix.AlgorithmIdentifier) error {
+	if !algo.Algorithm.Equal(oidPublicKeyEC) {
+		return errInvalidDER
+	}
+
+	var namedCurve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(algo.Parameters.FullBytes, &namedCurve); err != nil {
+		return errInvalidDER
+	}
+
+	if !namedCurve.Equal(oidNamedCurveSecp256k1) {
+		return errInvalidDER
+	}
+
+	return nil
+}
+
+// MarshalPKCS8PrivateKey marshals the private key to pkcs8 der.
+// The algorithm is ec public key with secp256k1 named curve which is the same as openssl.
+func (pk PrivateKey) MarshalPKCS8PrivateKey() ([]byte, error) {
+	algo, err := algorithmIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := pk.PublicKey().Bytes()
+
+	key := ecPrivateKey{
+		Version:    1,
+		PrivateKey: pk.Bytes(),
+		PublicKey:  asn1.BitString{Bytes: publicKey, BitLength: 8 * len(publicKey)},
+	}
+
+	keyBytes, err := asn1.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(pkcs8{Version: 0, Algo: algo, PrivateKey: keyBytes})
+}
+
+// UnmarshalPKCS8PrivateKey unmarshals the private key from pkcs8 der.
+func (pk *PrivateKey) UnmarshalPKCS8PrivateKey(der []byte) error {
+	var info pkcs8
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	if err := checkAlgorithmIdentifier(info.Algo); err != nil {
+		return err
+	}
+
+	var key ecPrivateKey
+	if _, err := asn1.Unmarshal(info.PrivateKey, &key); err != nil {
+		return errInvalidDER
+	}
+
+	if key.Version != 1 || len(key.PrivateKey) > PrivateKeySize {
+		return errInvalidDER
+	}
+
+	data := make([]byte, PrivateKeySize)
+	copy(data[PrivateKeySize-len(key.PrivateKey):], key.PrivateKey)
+
+	privateKey, err := NewPrivateKey(data)
+	if err != nil {
+		return err
+	}
+
+	*pk = privateKey
+	return nil
+}
+
+// MarshalPKIXPublicKey marshals the public key to pkix der.
+// The algorithm is ec public key with secp256k1 named curve which is the same as openssl.
+func (pk PublicKey) MarshalPKIXPublicKey() ([]byte, error) {
+	algo, err := algorithmIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := pk.Bytes()
+
+	info := publicKeyInfo{
+		Algorithm: algo,
+		PublicKey: asn1.BitString{Bytes: publicKey, BitLength: 8 * len(publicKey)},
+	}
+
+	return asn1.Marshal(info)
+}
+
+// UnmarshalPKIXPublicKey unmarshals the public key from pkix der.
+func (pk *PublicKey) UnmarshalPKIXPublicKey(der []byte) error {
+	var info publicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	if err := checkAlgorithmIdentifier(info.Algorithm); err != nil {
+		return err
+	}
+
+	publicKey, err := NewPublicKey(info.PublicKey.RightAlign())
+	if err != nil {
+		return err
+	}
+
+	*pk = publicKey
+	return nil
+}
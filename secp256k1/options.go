@@ -0,0 +1,160 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package secp256k1
+
+import (
+	"crypto/rand"
+	"io"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+	"github.com/FishGoddess/cryptox/x509"
+)
+
+type KeyConfig struct {
+	random           io.Reader
+	encodePrivateKey func(key PrivateKey) ([]byte, error)
+	encodePublicKey  func(key PublicKey) ([]byte, error)
+	decodePrivateKey func(data []byte) (PrivateKey, error)
+	decodePublicKey  func(data []byte) (PublicKey, error)
+}
+
+func newKeyConfig() *KeyConfig {
+	conf := &KeyConfig{
+		random:           rand.Reader,
+		encodePrivateKey: x509.EncodePrivateKeyPKCS8[PrivateKey],
+		encodePublicKey:  x509.EncodePublicKeyPKIX[PublicKey],
+		decodePrivateKey: x509.DecodePrivateKeyPKCS8[PrivateKey],
+		decodePublicKey:  x509.DecodePublicKeyPKIX[PublicKey],
+	}
+
+	return conf
+}
+
+func (kc *KeyConfig) Apply(opts ...KeyOption) *KeyConfig {
+	for _, opt := range opts {
+		opt(kc)
+	}
+
+	return kc
+}
+
+type KeyOption func(conf *KeyConfig)
+
+// WithKeyRandom sets random to key config.
+func WithKeyRandom(random io.Reader) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.random = random
+	}
+}
+
+// WithKeyEncodePrivate sets encode to key config.
+func WithKeyEncodePrivate(encode func(key PrivateKey) ([]byte, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.encodePrivateKey = encode
+	}
+}
+
+// WithKeyEncodePublic sets encode to key config.
+func WithKeyEncodePublic(encode func(key PublicKey) ([]byte, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.encodePublicKey = encode
+	}
+}
+
+// WithKeyDecodePrivate sets decode to key config.
+func WithKeyDecodePrivate(decode func(data []byte) (PrivateKey, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.decodePrivateKey = decode
+	}
+}
+
+// WithKeyDecodePublic sets decode to key config.
+func WithKeyDecodePublic(decode func(data []byte) (PublicKey, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.decodePublicKey = decode
+	}
+}
+
+// signFormat is the format of signature.
+type signFormat int
+
+const (
+	formatASN1 signFormat = iota
+	formatP1363
+)
+
+type Config struct {
+	encoding       encoding.Encoding
+	random         io.Reader
+	format         signFormat
+	recoveryOffset byte
+}
+
+func newConfig() *Config {
+	conf := &Config{
+		encoding:       encoding.None{},
+		random:         rand.Reader,
+		format:         formatASN1,
+		recoveryOffset: 0,
+	}
+
+	return conf
+}
+
+func (c *Config) Apply(opts ...Option) *Config {
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+type Option func(conf *Config)
+
+// WithHex sets hex encoding to config.
+func WithHex() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Hex{}
+	}
+}
+
+// WithBase64 sets base64 encoding to config.
+func WithBase64() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Base64{}
+	}
+}
+
+// WithRandom sets random to config.
+// It's used to read the auxiliary random data of schnorr signature.
+func WithRandom(random io.Reader) Option {
+	return func(conf *Config) {
+		conf.random = random
+	}
+}
+
+// WithASN1 sets asn.1 der format of signature to config.
+// It's the format used by bitcoin and it's the default format.
+func WithASN1() Option {
+	return func(conf *Config) {
+		conf.format = formatASN1
+	}
+}
+
+// WithP1363 sets ieee p1363 format of signature to config.
+// The signature is r||s in 64 bytes.
+func WithP1363() Option {
+	return func(conf *Config) {
+		conf.format = formatP1363
+	}
+}
+
+// WithRecoveryOffset sets the offset of v in recoverable signature to config.
+// The v is the recovery id in [0, 3] by default, and it's 27 + recovery id in ethereum personal sign.
+func WithRecoveryOffset(offset byte) Option {
+	return func(conf *Config) {
+		conf.recoveryOffset = offset
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+const (
+	// XOnlyPublicKeySize is the size of x-only public key in bip-340.
+	XOnlyPublicKeySize = 32
+
+	// SchnorrSignatureSize is the size of schnorr signature in bip-340.
+	SchnorrSignatureSize = 64
+
+	// auxSize is the size of auxiliary random data in bip-340.
+	auxSize = 32
+)
+
+var errInvalidPublicKey = errors.New("cryptox/secp256k1: invalid public key")
+
+var (
+	tagAux       = taggedHashPrefix("BIP0340/aux")
+	tagNonce     = taggedHashPrefix("BIP0340/nonce")
+	tagChallenge = taggedHashPrefix("BIP0340/challenge")
+)
+
+// taggedHashPrefix returns sha256(tag) || sha256(tag) which is the prefix of tagged hash.
+func taggedHashPrefix(tag string) []byte {
+	sum := sha256.Sum256([]byte(tag))
+	return append(sum[:], sum[:]...)
+}
+
+// taggedHash returns sha256(sha256(tag) || sha256(tag) || data...) in bip-340.
+func taggedHash(prefix []byte, data ...[]byte) [32]byte {
+	h := sha256.New()
+	h.Write(prefix)
+
+	for _, d := range data {
+		h.Write(d)
+	}
+
+	var sum [32]byte
+	h.Sum(sum[:0])
+	return sum
+}
+
+// liftX returns the public key of x with even y in bip-340.
+func liftX(data []byte) (PublicKey, error) {
+	var x, y secp256k1.FieldVal
+	if x.SetByteSlice(data) {
+		return PublicKey{}, errInvalidPublicKey
+	}
+
+	if !secp256k1.DecompressY(&x, false, &y) {
+		return PublicKey{}, errInvalidPublicKey
+	}
+
+	publicKey := PublicKey{key: secp256k1.NewPublicKey(&x, &y)}
+	return publicKey, nil
+}
+
+// BytesXOnly returns the public key in 32 bytes x-only form in bip-340.
+func (pk PublicKey) BytesXOnly() []byte {
+	return pk.key.SerializeCompressed()[1:]
+}
+
+// SignSchnorr signs data with schnorr in bip-340.
+// The auxiliary random data is read from the random set by WithRandom.
+func (pk PrivateKey) SignSchnorr(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	var aux [auxSize]byte
+	if _, err := io.ReadFull(conf.random, aux[:]); err != nil {
+		return nil, err
+	}
+
+	// Use d or n-d so the public key has even y.
+	d := pk.key.Key
+
+	var p secp256k1.JacobianPoint
+	scalarBaseMult(&d, &p)
+
+	if p.Y.IsOdd() {
+		d.Negate()
+	}
+
+	publicKey := p.X.Bytes()
+	dBytes := d.Bytes()
+
+	// The t is d xor hash(aux) and the nonce k is hash(t || p || m).
+	t := taggedHash(tagAux, aux[:])
+	for i := range t {
+		t[i] ^= dBytes[i]
+	}
+
+	nonce := taggedHash(tagNonce, t[:], publicKey[:], data)
+
+	var k secp256k1.ModNScalar
+	k.SetBytes(&nonce)
+
+	if k.IsZero() {
+		return nil, errors.New("cryptox/secp256k1: nonce is zero")
+	}
+
+	// Use k or n-k so r has even y.
+	var r secp256k1.JacobianPoint
+	scalarBaseMult(&k, &r)
+
+	if r.Y.IsOdd() {
+		k.Negate()
+	}
+
+	rBytes := r.X.Bytes()
+	challenge := taggedHash(tagChallenge, rBytes[:], publicKey[:], data)
+
+	var e secp256k1.ModNScalar
+	e.SetBytes(&challenge)
+
+	// The s is k + ed.
+	s := new(secp256k1.ModNScalar).Mul2(&e, &d).Add(&k)
+
+	sign := make([]byte, SchnorrSignatureSize)
+	copy(sign[:32], rBytes[:])
+	s.PutBytesUnchecked(sign[32:])
+
+	k.Zero()
+	d.Zero()
+
+	// Verify the signature so the fault attacks can't leak the private key.
+	if err := verifySchnorr(publicKey[:], data, sign); err != nil {
+		return nil, err
+	}
+
+	sign = conf.encoding.Encode(sign)
+	return sign, nil
+}
+
+// VerifySchnorr verifies the schnorr signature of data in bip-340.
+// The public key is used as the x-only key so the y of it is ignored.
+func (pk PublicKey) VerifySchnorr(data []byte, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	sign, err := conf.encoding.Decode(sign)
+	if err != nil {
+		return err
+	}
+
+	return verifySchnorr(pk.BytesXOnly(), data, sign)
+}
+
+func verifySchnorr(publicKey []byte, data []byte, sign []byte) error {
+	if len(sign) != SchnorrSignatureSize {
+		return errInvalidSignature
+	}
+
+	key, err := liftX(publicKey)
+	if err != nil {
+		return err
+	}
+
+	var r secp256k1.FieldVal
+	if r.SetByteSlice(sign[:32]) {
+		return errInvalidSignature
+	}
+
+	var s secp256k1.ModNScalar
+	if s.SetByteSlice(sign[32:]) {
+		return errInvalidSignature
+	}
+
+	challenge := taggedHash(tagChallenge, sign[:32], publicKey, data)
+
+	var e secp256k1.ModNScalar
+	e.SetBytes(&challenge)
+	e.Negate()
+
+	// The point R is sG - eP which must have even y and the same x as r.
+	var p, sg, ep, point secp256k1.JacobianPoint
+	key.key.AsJacobian(&p)
+	secp256k1.ScalarBaseMultNonConst(&s, &sg)
+	secp256k1.ScalarMultNonConst(&e, &p, &ep)
+	secp256k1.AddNonConst(&sg, &ep, &point)
+
+	if point.Z.IsZero() || (point.X.IsZero() && point.Y.IsZero()) {
+		return errVerifyFailed
+	}
+
+	point.ToAffine()
+
+	if point.Y.IsOdd() || !r.Equals(&point.X) {
+		return errVerifyFailed
+	}
+
+	return nil
+}
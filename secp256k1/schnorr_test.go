@@ -0,0 +1,242 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package secp256k1
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// go test -v -cover -run=^TestSignSchnorrBIP340$
+func TestSignSchnorrBIP340(t *testing.T) {
+	// The test vectors of signing in bip-340.
+	testCases := []struct {
+		privateKey string
+		publicKey  string
+		aux        string
+		data       string
+		sign       string
+	}{
+		{
+			privateKey: "0000000000000000000000000000000000000000000000000000000000000003",
+			publicKey:  "F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+			aux:        "0000000000000000000000000000000000000000000000000000000000000000",
+			data:       "0000000000000000000000000000000000000000000000000000000000000000",
+			sign:       "E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA821525F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0",
+		},
+		{
+			privateKey: "B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF",
+			publicKey:  "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			aux:        "0000000000000000000000000000000000000000000000000000000000000001",
+			data:       "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			sign:       "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+		},
+		{
+			privateKey: "C90FDAA22168C234C4C6628B80DC1CD129024E088A67CC74020BBEA63B14E5C9",
+			publicKey:  "DD308AFEC5777E13121FA72B9CC1B7CC0139715309B086C960E18FD969774EB8",
+			aux:        "C87AA53824B4D7AE2EB035A2B5BBBCCC080E76CDC6D1692C4B0B62D798E6D906",
+			data:       "7E2D58D8B3BCDF1ABADEC7829054F90DDA9805AAB56C77333024B9D0A508B75C",
+			sign:       "5831AAEED7B44BB74E5EAB94BA9D4294C49BCF2A60728D8B4C200F50DD313C1BAB745879A5AD954A72C45A91C3A51D3C7ADEA98D82F8481E0E1E03674A6F3FB7",
+		},
+		{
+			privateKey: "0B432B2677937381AEF05BB02A66ECD012773062CF3FA2549E44F58ED2401710",
+			publicKey:  "25D1DFF95105F5253C4022F628A996AD3A0D95FBF21D468A1B33F8C160D8F517",
+			aux:        "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF",
+			data:       "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF",
+			sign:       "7EB0509757E246F19449885651611CB965ECC1A187DD51B64FDA1EDC9637D5EC97582B9CB13DB3933705B32BA982AF5AF25FD78881EBB32771FC5922EFC66EA3",
+		},
+		{
+			privateKey: "0340034003400340034003400340034003400340034003400340034003400340",
+			publicKey:  "778CAA53B4393AC467774D09497A87224BF9FAB6F6E68B23086497324D6FD117",
+			aux:        "0000000000000000000000000000000000000000000000000000000000000000",
+			data:       "",
+			sign:       "71535DB165ECD9FBBC046E5FFAEA61186BB6AD436732FCCC25291A55895464CF6069CE26BF03466228F19A3A62DB8A649F2D560FAC652827D1AF0574E427AB63",
+		},
+		{
+			privateKey: "0340034003400340034003400340034003400340034003400340034003400340",
+			publicKey:  "778CAA53B4393AC467774D09497A87224BF9FAB6F6E68B23086497324D6FD117",
+			aux:        "0000000000000000000000000000000000000000000000000000000000000000",
+			data:       "0102030405060708090A0B0C0D0E0F1011",
+			sign:       "5130F39A4059B43BC7CAC09A19ECE52B5D8699D1A71E3C52DA9AFDB6B50AC370C4A482B77BF960F8681540E25B6771ECE1E5A37FD80E5A51897C5566A97EA5A5",
+		},
+		{
+			privateKey: "0340034003400340034003400340034003400340034003400340034003400340",
+			publicKey:  "778CAA53B4393AC467774D09497A87224BF9FAB6F6E68B23086497324D6FD117",
+			aux:        "0000000000000000000000000000000000000000000000000000000000000000",
+			data:       strings.Repeat("99", 100),
+			sign:       "403B12B0D8555A344175EA7EC746566303321E5DBFA8BE6F091635163ECA79A8585ED3E3170807E7C03B720FC54C7B23897FCBA0E9D0B4A06894CFD249F22367",
+		},
+	}
+
+	for _, testCase := range testCases {
+		privateKey, err := NewPrivateKey(decodeHex(t, testCase.privateKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		publicKey := privateKey.PublicKey()
+
+		got := strings.ToUpper(hex.EncodeToString(publicKey.BytesXOnly()))
+		if got != testCase.publicKey {
+			t.Fatalf("got %s != expect %s", got, testCase.publicKey)
+		}
+
+		data := decodeHex(t, testCase.data)
+		random := bytes.NewReader(decodeHex(t, testCase.aux))
+
+		sign, err := privateKey.SignSchnorr(data, WithRandom(random))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got = strings.ToUpper(hex.EncodeToString(sign))
+		if got != testCase.sign {
+			t.Fatalf("got %s != expect %s", got, testCase.sign)
+		}
+
+		err = publicKey.VerifySchnorr(data, sign)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestVerifySchnorrBIP340$
+func TestVerifySchnorrBIP340(t *testing.T) {
+	// The test vectors of verifying in bip-340.
+	testCases := []struct {
+		publicKey string
+		data      string
+		sign      string
+		valid     bool
+	}{
+		{
+			publicKey: "D69C3509BB99E412E68B0FE8544E72837DFA30746D8BE2AA65975F29D22DC7B9",
+			data:      "4DF3C3F68FCC83B27E9D42C90431A72499F17875C81A599B566C9889B9696703",
+			sign:      "00000000000000000000003B78CE563F89A0ED9414F5AA28AD0D96D6795F9C6376AFB1548AF603B3EB45C9F8207DEE1060CB71C04E80F593060B07D28308D7F4",
+			valid:     true,
+		},
+		{
+			publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			data:      "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			sign:      "FFF97BD5755EEEA420453A14355235D382F6472F8568A18B2F057A14602975563CC27944640AC607CD107AE10923D9EF7A73C643E166BE5EBEAFA34B1AC553E2",
+			valid:     false,
+		},
+		{
+			publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			data:      "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			sign:      "1FA62E331EDBC21C394792D2AB1100A7B432B013DF3F6FF4F99FCB33E0E1515F28890B3EDB6E7189B630448B515CE4F8622A954CFE545735AAEA5134FCCDB2BD",
+			valid:     false,
+		},
+		{
+			publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			data:      "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			sign:      "6CFF5C3BA86C69EA4B7376F31A9BCB4F74C1976089B2D9963DA2E5543E177769961764B3AA9B2FFCB6EF947B6887A226E8D7C93E00C5ED0C1834FF0D0C2E6DA6",
+			valid:     false,
+		},
+		{
+			publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			data:      "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			sign:      "0000000000000000000000000000000000000000000000000000000000000000123DDA8328AF9C23A94C1FEECFD123BA4FB73476F0D594DCB65C6425BD186051",
+			valid:     false,
+		},
+		{
+			publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			data:      "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			sign:      "00000000000000000000000000000000000000000000000000000000000000017615FBAF5AE28864013C099742DEADB4DBA87F11AC6754F93780D5A1837CF197",
+			valid:     false,
+		},
+		{
+			publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			data:      "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			sign:      "4A298DACAE57395A15D0795DDBFD1DCB564DA82B0F269BC70A74F8220429BA1D69E89B4C5564D00349106B8497785DD7D1D713A8AE82B32FA79D5F7FC407D39B",
+			valid:     false,
+		},
+		{
+			publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			data:      "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			sign:      "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F69E89B4C5564D00349106B8497785DD7D1D713A8AE82B32FA79D5F7FC407D39B",
+			valid:     false,
+		},
+		{
+			publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			data:      "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			sign:      "6CFF5C3BA86C69EA4B7376F31A9BCB4F74C1976089B2D9963DA2E5543E177769FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141",
+			valid:     false,
+		},
+	}
+
+	for i, testCase := range testCases {
+		publicKey, err := NewPublicKey(decodeHex(t, testCase.publicKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = publicKey.VerifySchnorr(decodeHex(t, testCase.data), decodeHex(t, testCase.sign))
+		if testCase.valid && err != nil {
+			t.Fatalf("case %d: got %+v != expect nil", i, err)
+		}
+
+		if !testCase.valid && err == nil {
+			t.Fatalf("case %d: verify should be failed", i)
+		}
+	}
+
+	// The public key isn't on the curve or exceeds the field size.
+	invalidKeys := []string{
+		"EEFDEA4CDB677750A420FEE807EACF21EB9898AE79B9768766E4FAA04A2D4A34",
+		"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC30",
+	}
+
+	for _, invalidKey := range invalidKeys {
+		_, err := NewPublicKey(decodeHex(t, invalidKey))
+		if err != errInvalidPublicKey {
+			t.Fatalf("got %+v != expect %+v", err, errInvalidPublicKey)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSignSchnorr$
+func TestSignSchnorr(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("TestSignSchnorr")
+
+	sign, err := privateKey.SignSchnorr(data, WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.VerifySchnorr(data, sign, WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = publicKey.VerifySchnorr([]byte("wrong"), sign, WithHex())
+	if err != errVerifyFailed {
+		t.Fatalf("got %+v != expect %+v", err, errVerifyFailed)
+	}
+
+	// The x-only key is lifted to even y so it verifies the same as the full key.
+	xOnlyKey, err := NewPublicKey(publicKey.BytesXOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = xOnlyKey.VerifySchnorr(data, sign, WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = privateKey.SignSchnorr(data, WithRandom(bytes.NewReader(nil)))
+	if err == nil {
+		t.Fatal("sign schnorr with empty random should be failed")
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package secp256k1
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+const (
+	// PrivateKeySize is the size of private key.
+	PrivateKeySize = secp256k1.PrivKeyBytesLen
+
+	// DigestSize is the size of digest to be signed, such as sha256 in bitcoin and keccak256 in ethereum.
+	DigestSize = 32
+
+	// SignatureSize is the size of signature in r||s.
+	SignatureSize = 64
+
+	// RecoverableSignatureSize is the size of recoverable signature in r||s||v.
+	RecoverableSignatureSize = 65
+
+	// compactSigMagicOffset and compactSigCompPubKey are the offsets of recovery code in the bitcoin compact signature.
+	compactSigMagicOffset = 27
+	compactSigCompPubKey  = 4
+)
+
+var (
+	errVerifyFailed     = errors.New("cryptox/secp256k1: verify failed")
+	errInvalidSignature = errors.New("cryptox/secp256k1: invalid signature")
+	errHighS            = errors.New("cryptox/secp256k1: s of signature is not low")
+)
+
+func checkDigest(digest []byte) error {
+	if len(digest) != DigestSize {
+		return fmt.Errorf("cryptox/secp256k1: invalid digest size %d", len(digest))
+	}
+
+	return nil
+}
+
+// parseSignature parses r||s to a signature.
+// The r and s must be in [1, n-1].
+func parseSignature(sign []byte) (*ecdsa.Signature, error) {
+	if len(sign) != SignatureSize {
+		return nil, errInvalidSignature
+	}
+
+	var r, s secp256k1.ModNScalar
+	if r.SetByteSlice(sign[:32]) || r.IsZero() {
+		return nil, errInvalidSignature
+	}
+
+	if s.SetByteSlice(sign[32:]) || s.IsZero() {
+		return nil, errInvalidSignature
+	}
+
+	return ecdsa.NewSignature(&r, &s), nil
+}
+
+// serializeSignature serializes the signature to r||s.
+func serializeSignature(signature *ecdsa.Signature) []byte {
+	r := signature.R()
+	s := signature.S()
+
+	sign := make([]byte, SignatureSize)
+	r.PutBytesUnchecked(sign[:32])
+	s.PutBytesUnchecked(sign[32:])
+	return sign
+}
+
+type PrivateKey struct {
+	key *secp256k1.PrivateKey
+}
+
+// NewPrivateKey returns a private key of the 32 bytes.
+// The private key must be in [1, n-1].
+func NewPrivateKey(data []byte) (PrivateKey, error) {
+	if len(data) != PrivateKeySize {
+		return PrivateKey{}, fmt.Errorf("cryptox/secp256k1: invalid private key size %d", len(data))
+	}
+
+	var scalar secp256k1.ModNScalar
+	if scalar.SetByteSlice(data) || scalar.IsZero() {
+		return PrivateKey{}, errors.New("cryptox/secp256k1: invalid private key")
+	}
+
+	privateKey := PrivateKey{key: secp256k1.NewPrivateKey(&scalar)}
+	return privateKey, nil
+}
+
+// Bytes returns the private key in 32 bytes.
+func (pk PrivateKey) Bytes() []byte {
+	return pk.key.Serialize()
+}
+
+// PublicKey returns the public key of the private key.
+func (pk PrivateKey) PublicKey() PublicKey {
+	return PublicKey{key: pk.key.PubKey()}
+}
+
+// Equal returns if the private key equals to the key.
+func (pk PrivateKey) Equal(key PrivateKey) bool {
+	return pk.key != nil && key.key != nil && pk.key.Key.Equals(&key.key.Key)
+}
+
+// Sign signs the 32 bytes digest with the deterministic nonce in rfc 6979.
+// The s of signature is always normalized to the low half of order as bitcoin and ethereum require.
+// The signature is asn.1 der by default and it's r||s in 64 bytes if WithP1363 is set.
+func (pk PrivateKey) Sign(digest []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	if err := checkDigest(digest); err != nil {
+		return nil, err
+	}
+
+	signature := ecdsa.Sign(pk.key, digest)
+
+	var sign []byte
+	if conf.format == formatP1363 {
+		sign = serializeSignature(signature)
+	} else {
+		sign = signature.Serialize()
+	}
+
+	sign = conf.encoding.Encode(sign)
+	return sign, nil
+}
+
+// SignRecoverable signs the 32 bytes digest and returns the recoverable signature in r||s||v.
+// The v is the recovery id in [0, 3] plus the offset set by WithRecoveryOffset, such as 27 in ethereum personal sign.
+func (pk PrivateKey) SignRecoverable(digest []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	if err := checkDigest(digest); err != nil {
+		return nil, err
+	}
+
+	// The compact signature is code||r||s and code is 27 + 4 + recovery id for compressed keys.
+	compact := ecdsa.SignCompact(pk.key, digest, true)
+	recoveryID := compact[0] - compactSigMagicOffset - compactSigCompPubKey
+
+	sign := make([]byte, 0, RecoverableSignatureSize)
+	sign = append(sign, compact[1:]...)
+	sign = append(sign, recoveryID+conf.recoveryOffset)
+
+	sign = conf.encoding.Encode(sign)
+	return sign, nil
+}
+
+type PublicKey struct {
+	key *secp256k1.PublicKey
+}
+
+// NewPublicKey returns a public key of the data.
+// The data can be a 65 bytes uncompressed point, a 33 bytes compressed point or a 32 bytes x-only key in bip-340.
+func NewPublicKey(data []byte) (PublicKey, error) {
+	if len(data) == XOnlyPublicKeySize {
+		return liftX(data)
+	}
+
+	key, err := secp256k1.ParsePubKey(data)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	publicKey := PublicKey{key: key}
+	return publicKey, nil
+}
+
+// Bytes returns the public key in 65 bytes uncompressed point.
+func (pk PublicKey) Bytes() []byte {
+	return pk.key.SerializeUncompressed()
+}
+
+// BytesCompressed returns the public key in 33 bytes compressed point.
+func (pk PublicKey) BytesCompressed() []byte {
+	return pk.key.SerializeCompressed()
+}
+
+// Equal returns if the public key equals to the key.
+func (pk PublicKey) Equal(key PublicKey) bool {
+	return pk.key != nil && key.key != nil && pk.key.IsEqual(key.key)
+}
+
+// Verify verifies the signature of the 32 bytes digest.
+// The signature with high s is rejected because it's malleable.
+func (pk PublicKey) Verify(digest []byte, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	if err := checkDigest(digest); err != nil {
+		return err
+	}
+
+	sign, err := conf.encoding.Decode(sign)
+	if err != nil {
+		return err
+	}
+
+	var signature *ecdsa.Signature
+	if conf.format == formatP1363 {
+		signature, err = parseSignature(sign)
+	} else {
+		signature, err = ecdsa.ParseDERSignature(sign)
+	}
+
+	if err != nil {
+		return errInvalidSignature
+	}
+
+	s := signature.S()
+	if s.IsOverHalfOrder() {
+		return errHighS
+	}
+
+	if !signature.Verify(digest, pk.key) {
+		return errVerifyFailed
+	}
+
+	return nil
+}
+
+// RecoverPublicKey recovers the public key from the 32 bytes digest and the recoverable signature in r||s||v.
+// The offset set by WithRecoveryOffset will be subtracted from v, so it should be the same as signing.
+func RecoverPublicKey(digest []byte, sign []byte, opts ...Option) (PublicKey, error) {
+	conf := newConfig().Apply(opts...)
+
+	if err := checkDigest(digest); err != nil {
+		return PublicKey{}, err
+	}
+
+	sign, err := conf.encoding.Decode(sign)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	if len(sign) != RecoverableSignatureSize {
+		return PublicKey{}, errInvalidSignature
+	}
+
+	signature, err := parseSignature(sign[:SignatureSize])
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	s := signature.S()
+	if s.IsOverHalfOrder() {
+		return PublicKey{}, errHighS
+	}
+
+	recoveryID := sign[SignatureSize] - conf.recoveryOffset
+	if recoveryID > 3 {
+		return PublicKey{}, fmt.Errorf("cryptox/secp256k1: invalid recovery id %d", sign[SignatureSize])
+	}
+
+	compact := make([]byte, 0, RecoverableSignatureSize)
+	compact = append(compact, compactSigMagicOffset+compactSigCompPubKey+recoveryID)
+	compact = append(compact, sign[:SignatureSize]...)
+
+	key, _, err := ecdsa.RecoverCompact(compact, digest)
+	if err != nil {
+		return PublicKey{}, errVerifyFailed
+	}
+
+	publicKey := PublicKey{key: key}
+	return publicKey, nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/hash"
+)
+
+func decodeHex(t *testing.T, str string) []byte {
+	data, err := hex.DecodeString(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+// go test -v -cover -run=^TestSignRFC6979$
+func TestSignRFC6979(t *testing.T) {
+	// The widely used rfc 6979 vectors of secp256k1 with sha256.
+	testCases := []struct {
+		privateKey string
+		data       string
+		sign       string
+	}{
+		{
+			privateKey: "0000000000000000000000000000000000000000000000000000000000000001",
+			data:       "Satoshi Nakamoto",
+			sign:       "934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d82442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5",
+		},
+		{
+			privateKey: "0000000000000000000000000000000000000000000000000000000000000001",
+			data:       "All those moments will be lost in time, like tears in rain. Time to die...",
+			sign:       "8600dbd41e348fe5c9465ab92d23e3db8b98b873beecd930736488696438cb6b547fe64427496db33bf66019dacbf0039c04199abb0122918601db38a72cfc21",
+		},
+	}
+
+	for _, testCase := range testCases {
+		privateKey, err := NewPrivateKey(decodeHex(t, testCase.privateKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		digest := sha256.Sum256([]byte(testCase.data))
+
+		sign, err := privateKey.Sign(digest[:], WithP1363(), WithHex())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(sign) != testCase.sign {
+			t.Fatalf("got %s != expect %s", sign, testCase.sign)
+		}
+
+		err = privateKey.PublicKey().Verify(digest[:], sign, WithP1363(), WithHex())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSignVerify$
+func TestSignVerify(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte("TestSignVerify"))
+
+	formats := []Option{WithASN1(), WithP1363()}
+	for _, format := range formats {
+		sign, err := privateKey.Sign(digest[:], format, WithBase64())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = publicKey.Verify(digest[:], sign, format, WithBase64())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wrongDigest := sha256.Sum256([]byte("wrong"))
+
+		err = publicKey.Verify(wrongDigest[:], sign, format, WithBase64())
+		if err != errVerifyFailed {
+			t.Fatalf("got %+v != expect %+v", err, errVerifyFailed)
+		}
+	}
+
+	_, err = privateKey.Sign(digest[:31])
+	if err == nil {
+		t.Fatal("sign with wrong digest size should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestVerifyOpenSSL$
+func TestVerifyOpenSSL(t *testing.T) {
+	publicKey, err := ReadPublicKey(strings.NewReader(testPublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := sha256.Sum256([]byte("hello secp256k1"))
+
+	// openssl dgst -sha256 -sign private.pem
+	sign := []byte("304402202281c62305abe10df391f3e09295001530f7916f0a1c6322fa5a27716d97b39602202013c22fca422aed0ce6e9bae2deaf6c6747f2a84e737f55a1b1dece3941854f")
+
+	err = publicKey.Verify(digest[:], sign, WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// OpenSSL doesn't normalize s so this signature has high s.
+	sign = []byte("3046022100bf315913befab6c0c42d2cf276ae2c29072da29f03caaf96dfaf9e06c7d81367022100e0ccb8ecb3bafb6de6207e2ce1a2a1ecee850fcfc069ca14e67b64f1367053f2")
+
+	err = publicKey.Verify(digest[:], sign, WithHex())
+	if err != errHighS {
+		t.Fatalf("got %+v != expect %+v", err, errHighS)
+	}
+}
+
+// go test -v -cover -run=^TestSignRecoverable$
+func TestSignRecoverable(t *testing.T) {
+	for i := 0; i < 16; i++ {
+		privateKey, publicKey, err := GenerateKeys()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		digest := hash.Keccak256([]byte("TestSignRecoverable"))
+
+		sign, err := privateKey.SignRecoverable(digest)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(sign) != RecoverableSignatureSize || sign[64] > 1 {
+			t.Fatalf("got %d %d != expect %d [0, 1]", len(sign), sign[64], RecoverableSignatureSize)
+		}
+
+		recoverKey, err := RecoverPublicKey(digest, sign)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !recoverKey.Equal(publicKey) {
+			t.Fatalf("got %x != expect %x", recoverKey.Bytes(), publicKey.Bytes())
+		}
+
+		// The r||s of recoverable signature is also a valid p1363 signature.
+		err = publicKey.Verify(digest, sign[:SignatureSize], WithP1363())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sign[64] ^= 1
+
+		recoverKey, err = RecoverPublicKey(digest, sign)
+		if err == nil && recoverKey.Equal(publicKey) {
+			t.Fatal("recover with wrong v should be failed")
+		}
+	}
+}
+
+// go test -v -cover -run=^TestRecoverPublicKeyEthereum$
+func TestRecoverPublicKeyEthereum(t *testing.T) {
+	privateKey, err := NewPrivateKey(decodeHex(t, "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The ethereum personal sign hashes "\x19Ethereum Signed Message:\n" + len(message) + message.
+	data := []byte("\x19Ethereum Signed Message:\n9Some data")
+	digest := hash.Keccak256(data)
+
+	sign, err := privateKey.SignRecoverable(digest, WithRecoveryOffset(27), WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The signature of web3.eth.accounts.sign("Some data", privateKey).
+	expect := "b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"
+	if string(sign) != expect {
+		t.Fatalf("got %s != expect %s", sign, expect)
+	}
+
+	publicKey, err := RecoverPublicKey(digest, sign, WithRecoveryOffset(27), WithHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address := "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+	if publicKey.Address() != address {
+		t.Fatalf("got %s != expect %s", publicKey.Address(), address)
+	}
+
+	_, err = RecoverPublicKey(digest, sign, WithHex())
+	if err == nil {
+		t.Fatal("recover with wrong offset should be failed")
+	}
+
+	_, err = RecoverPublicKey(digest, sign[:len(sign)-2], WithHex())
+	if err != errInvalidSignature {
+		t.Fatalf("got %+v != expect %+v", err, errInvalidSignature)
+	}
+}
+
+// go test -v -cover -run=^TestNewKeys$
+func TestNewKeys(t *testing.T) {
+	invalidKeys := []string{
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141",
+		"01",
+	}
+
+	for _, invalidKey := range invalidKeys {
+		_, err := NewPrivateKey(decodeHex(t, invalidKey))
+		if err == nil {
+			t.Fatalf("new private key %s should be failed", invalidKey)
+		}
+	}
+
+	_, publicKey, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	datas := [][]byte{publicKey.Bytes(), publicKey.BytesCompressed()}
+	for _, data := range datas {
+		newKey, err := NewPublicKey(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !newKey.Equal(publicKey) {
+			t.Fatalf("got %x != expect %x", newKey.Bytes(), publicKey.Bytes())
+		}
+	}
+
+	_, err = NewPublicKey(publicKey.Bytes()[:40])
+	if err == nil {
+		t.Fatal("new public key with wrong size should be failed")
+	}
+}
@@ -0,0 +1,471 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm2
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+const (
+	// coordinateSize is the byte size of a coordinate or a scalar.
+	coordinateSize = 32
+
+	// pointSize is the byte size of an uncompressed point.
+	pointSize = 1 + 2*coordinateSize
+)
+
+// The recommended curve parameters of sm2 in gb/t 32918.5.
+var (
+	curveP, _  = new(big.Int).SetString("FFFFFFFEFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF00000000FFFFFFFFFFFFFFFF", 16)
+	curveA, _  = new(big.Int).SetString("FFFFFFFEFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF00000000FFFFFFFFFFFFFFFC", 16)
+	curveB, _  = new(big.Int).SetString("28E9FA9E9D9F5E344D5A9E4BCF6509A7F39789F515AB8F92DDBCBD414D940E93", 16)
+	curveN, _  = new(big.Int).SetString("FFFFFFFEFFFFFFFFFFFFFFFFFFFFFFFF7203DF6B21C6052B53BBF40939D54123", 16)
+	curveGx, _ = new(big.Int).SetString("32C4AE2C1F1981195F9904466A39C9948FE30BBFF2660BE1715A4589334C74C7", 16)
+	curveGy, _ = new(big.Int).SetString("BC3736A2F4F6779C59BDCEE36B692153D0A9877CC62A474002DF32E52139F0A0", 16)
+)
+
+var errInvalidPoint = errors.New("cryptox/sm2: invalid point")
+
+// fieldElement is an element of the field of p in montgomery form with little endian limbs.
+type fieldElement [4]uint64
+
+var (
+	fieldP = fieldElement{0xFFFFFFFFFFFFFFFF, 0xFFFFFFFF00000000, 0xFFFFFFFFFFFFFFFF, 0xFFFFFFFEFFFFFFFF}
+
+	// fieldRR is 2^512 mod p which is used to convert an element to montgomery form.
+	fieldRR = limbsOf(new(big.Int).Mod(new(big.Int).Lsh(big.NewInt(1), 512), curveP))
+
+	// fieldPMinus2 is the exponent of inverting an element.
+	fieldPMinus2 = new(big.Int).Sub(curveP, big.NewInt(2)).FillBytes(make([]byte, coordinateSize))
+
+	fieldOne = mustFieldElement(big.NewInt(1))
+	fieldB   = mustFieldElement(curveB)
+	fieldGx  = mustFieldElement(curveGx)
+	fieldGy  = mustFieldElement(curveGy)
+)
+
+func limbsOf(x *big.Int) fieldElement {
+	var buffer [coordinateSize]byte
+	x.FillBytes(buffer[:])
+
+	var limbs fieldElement
+	for i := 0; i < 4; i++ {
+		limbs[i] = binary.BigEndian.Uint64(buffer[coordinateSize-8*(i+1):])
+	}
+
+	return limbs
+}
+
+func mustFieldElement(x *big.Int) *fieldElement {
+	var buffer [coordinateSize]byte
+	x.FillBytes(buffer[:])
+
+	fe, err := new(fieldElement).setBytes(buffer[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return fe
+}
+
+// reduce subtracts p from t if t with carry is not less than p.
+func (z *fieldElement) reduce(t *fieldElement, carry uint64) *fieldElement {
+	var d fieldElement
+	var borrow uint64
+
+	d[0], borrow = bits.Sub64(t[0], fieldP[0], 0)
+	d[1], borrow = bits.Sub64(t[1], fieldP[1], borrow)
+	d[2], borrow = bits.Sub64(t[2], fieldP[2], borrow)
+	d[3], borrow = bits.Sub64(t[3], fieldP[3], borrow)
+	_, borrow = bits.Sub64(carry, 0, borrow)
+
+	// Keep t if the subtraction borrows.
+	mask := -borrow
+	for i := range z {
+		z[i] = (t[i] & mask) | (d[i] &^ mask)
+	}
+
+	return z
+}
+
+func (z *fieldElement) add(x *fieldElement, y *fieldElement) *fieldElement {
+	var t fieldElement
+	var carry uint64
+
+	t[0], carry = bits.Add64(x[0], y[0], 0)
+	t[1], carry = bits.Add64(x[1], y[1], carry)
+	t[2], carry = bits.Add64(x[2], y[2], carry)
+	t[3], carry = bits.Add64(x[3], y[3], carry)
+	return z.reduce(&t, carry)
+}
+
+func (z *fieldElement) sub(x *fieldElement, y *fieldElement) *fieldElement {
+	var t fieldElement
+	var borrow uint64
+
+	t[0], borrow = bits.Sub64(x[0], y[0], 0)
+	t[1], borrow = bits.Sub64(x[1], y[1], borrow)
+	t[2], borrow = bits.Sub64(x[2], y[2], borrow)
+	t[3], borrow = bits.Sub64(x[3], y[3], borrow)
+
+	// Add p back if the subtraction borrows.
+	mask := -borrow
+
+	var carry uint64
+	z[0], carry = bits.Add64(t[0], fieldP[0]&mask, 0)
+	z[1], carry = bits.Add64(t[1], fieldP[1]&mask, carry)
+	z[2], carry = bits.Add64(t[2], fieldP[2]&mask, carry)
+	z[3], _ = bits.Add64(t[3], fieldP[3]&mask, carry)
+	return z
+}
+
+// madd returns a * b + c + d as hi and lo which never overflows.
+func madd(a uint64, b uint64, c uint64, d uint64) (hi uint64, lo uint64) {
+	hi, lo = bits.Mul64(a, b)
+
+	var carry uint64
+	lo, carry = bits.Add64(lo, c, 0)
+	hi += carry
+	lo, carry = bits.Add64(lo, d, 0)
+	hi += carry
+	return hi, lo
+}
+
+// mulStep adds x * yi to t and then reduces t by one limb in montgomery way.
+// The factor -p^-1 mod 2^64 of p is 1 so the reducing multiplier m is t0 itself.
+// Also, t0 + m * p[0] is m * 2^64 because p[0] is 2^64 - 1, so its carry is m.
+func mulStep(x *fieldElement, yi uint64, t0, t1, t2, t3, t4 uint64) (uint64, uint64, uint64, uint64, uint64) {
+	var c, t5 uint64
+	c, t0 = madd(x[0], yi, t0, 0)
+	c, t1 = madd(x[1], yi, t1, c)
+	c, t2 = madd(x[2], yi, t2, c)
+	c, t3 = madd(x[3], yi, t3, c)
+	t4, t5 = bits.Add64(t4, c, 0)
+
+	m := t0
+	c, t0 = madd(m, fieldP[1], t1, m)
+	c, t1 = madd(m, fieldP[2], t2, c)
+	c, t2 = madd(m, fieldP[3], t3, c)
+	t3, c = bits.Add64(t4, c, 0)
+	t4 = t5 + c
+	return t0, t1, t2, t3, t4
+}
+
+// mul sets z to x * y / 2^256 mod p in montgomery way.
+func (z *fieldElement) mul(x *fieldElement, y *fieldElement) *fieldElement {
+	var t0, t1, t2, t3, t4 uint64
+	t0, t1, t2, t3, t4 = mulStep(x, y[0], t0, t1, t2, t3, t4)
+	t0, t1, t2, t3, t4 = mulStep(x, y[1], t0, t1, t2, t3, t4)
+	t0, t1, t2, t3, t4 = mulStep(x, y[2], t0, t1, t2, t3, t4)
+	t0, t1, t2, t3, t4 = mulStep(x, y[3], t0, t1, t2, t3, t4)
+
+	t := fieldElement{t0, t1, t2, t3}
+	return z.reduce(&t, t4)
+}
+
+func (z *fieldElement) square(x *fieldElement) *fieldElement {
+	return z.mul(x, x)
+}
+
+// invert sets z to x^(p-2) which is x^-1 or zero if x is zero.
+func (z *fieldElement) invert(x *fieldElement) *fieldElement {
+	result := *fieldOne
+	base := *x
+
+	for _, b := range fieldPMinus2 {
+		for i := 7; i >= 0; i-- {
+			result.square(&result)
+
+			if (b>>i)&1 == 1 {
+				result.mul(&result, &base)
+			}
+		}
+	}
+
+	*z = result
+	return z
+}
+
+// selectFrom sets z to x if cond is 1 or keeps z if cond is 0.
+func (z *fieldElement) selectFrom(x *fieldElement, cond uint64) *fieldElement {
+	mask := -cond
+	for i := range z {
+		z[i] = (x[i] & mask) | (z[i] &^ mask)
+	}
+
+	return z
+}
+
+func (z *fieldElement) equal(x *fieldElement) int {
+	var diff uint64
+	for i := range z {
+		diff |= z[i] ^ x[i]
+	}
+
+	folded := uint32(diff>>32) | uint32(diff)
+	return subtle.ConstantTimeEq(int32(folded), 0)
+}
+
+func (z *fieldElement) isZero() int {
+	return z.equal(new(fieldElement))
+}
+
+// setBytes sets z to the big endian bytes which must be less than p.
+func (z *fieldElement) setBytes(data []byte) (*fieldElement, error) {
+	if len(data) != coordinateSize {
+		return nil, errInvalidPoint
+	}
+
+	var t fieldElement
+	for i := 0; i < 4; i++ {
+		t[i] = binary.BigEndian.Uint64(data[coordinateSize-8*(i+1):])
+	}
+
+	var borrow uint64
+	_, borrow = bits.Sub64(t[0], fieldP[0], 0)
+	_, borrow = bits.Sub64(t[1], fieldP[1], borrow)
+	_, borrow = bits.Sub64(t[2], fieldP[2], borrow)
+	_, borrow = bits.Sub64(t[3], fieldP[3], borrow)
+
+	if borrow == 0 {
+		return nil, errInvalidPoint
+	}
+
+	return z.mul(&t, &fieldRR), nil
+}
+
+// bytes returns the big endian bytes of z which is converted from montgomery form.
+func (z *fieldElement) bytes() []byte {
+	var t fieldElement
+	t.mul(z, &fieldElement{1})
+
+	data := make([]byte, coordinateSize)
+	for i := 0; i < 4; i++ {
+		binary.BigEndian.PutUint64(data[coordinateSize-8*(i+1):], t[i])
+	}
+
+	return data
+}
+
+// point is a point on the curve in projective coordinates.
+// The point at infinity is (0:1:0).
+type point struct {
+	x fieldElement
+	y fieldElement
+	z fieldElement
+}
+
+func newIdentityPoint() *point {
+	return &point{y: *fieldOne}
+}
+
+func newGeneratorPoint() *point {
+	return &point{x: *fieldGx, y: *fieldGy, z: *fieldOne}
+}
+
+// isOnCurve checks if y^2 = x^3 - 3x + b.
+func isOnCurve(x *fieldElement, y *fieldElement) bool {
+	left := new(fieldElement).square(y)
+
+	right := new(fieldElement).square(x)
+	right.mul(right, x)
+
+	threeX := new(fieldElement).add(x, x)
+	threeX.add(threeX, x)
+
+	right.sub(right, threeX)
+	right.add(right, fieldB)
+	return left.equal(right) == 1
+}
+
+// newPoint parses an uncompressed point which must be on the curve.
+func newPoint(data []byte) (*point, error) {
+	if len(data) != pointSize || data[0] != 4 {
+		return nil, errInvalidPoint
+	}
+
+	x, err := new(fieldElement).setBytes(data[1 : 1+coordinateSize])
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := new(fieldElement).setBytes(data[1+coordinateSize:])
+	if err != nil {
+		return nil, err
+	}
+
+	if !isOnCurve(x, y) {
+		return nil, errInvalidPoint
+	}
+
+	p := &point{x: *x, y: *y, z: *fieldOne}
+	return p, nil
+}
+
+// affine returns the affine coordinates of p in big endian bytes.
+func (p *point) affine() (x []byte, y []byte) {
+	zInv := new(fieldElement).invert(&p.z)
+	ax := new(fieldElement).mul(&p.x, zInv)
+	ay := new(fieldElement).mul(&p.y, zInv)
+	return ax.bytes(), ay.bytes()
+}
+
+// bytes returns the uncompressed encoding of p.
+func (p *point) bytes() []byte {
+	x, y := p.affine()
+
+	data := make([]byte, 0, pointSize)
+	data = append(data, 4)
+	data = append(data, x...)
+	data = append(data, y...)
+	return data
+}
+
+func (p *point) isIdentity() bool {
+	return p.z.isZero() == 1
+}
+
+// add sets q to p1 + p2 using the complete formula for a = -3 in renes-costello-batina 2015.
+func (q *point) add(p1 *point, p2 *point) *point {
+	t0 := new(fieldElement).mul(&p1.x, &p2.x)
+	t1 := new(fieldElement).mul(&p1.y, &p2.y)
+	t2 := new(fieldElement).mul(&p1.z, &p2.z)
+	t3 := new(fieldElement).add(&p1.x, &p1.y)
+	t4 := new(fieldElement).add(&p2.x, &p2.y)
+	t3.mul(t3, t4)
+	t4.add(t0, t1)
+	t3.sub(t3, t4)
+	t4.add(&p1.y, &p1.z)
+	x3 := new(fieldElement).add(&p2.y, &p2.z)
+	t4.mul(t4, x3)
+	x3.add(t1, t2)
+	t4.sub(t4, x3)
+	x3.add(&p1.x, &p1.z)
+	y3 := new(fieldElement).add(&p2.x, &p2.z)
+	x3.mul(x3, y3)
+	y3.add(t0, t2)
+	y3.sub(x3, y3)
+	z3 := new(fieldElement).mul(fieldB, t2)
+	x3.sub(y3, z3)
+	z3.add(x3, x3)
+	x3.add(x3, z3)
+	z3.sub(t1, x3)
+	x3.add(t1, x3)
+	y3.mul(fieldB, y3)
+	t1.add(t2, t2)
+	t2.add(t1, t2)
+	y3.sub(y3, t2)
+	y3.sub(y3, t0)
+	t1.add(y3, y3)
+	y3.add(t1, y3)
+	t1.add(t0, t0)
+	t0.add(t1, t0)
+	t0.sub(t0, t2)
+	t1.mul(t4, y3)
+	t2.mul(t0, y3)
+	y3.mul(x3, z3)
+	y3.add(y3, t2)
+	x3.mul(t3, x3)
+	x3.sub(x3, t1)
+	z3.mul(t4, z3)
+	t1.mul(t3, t0)
+	z3.add(z3, t1)
+
+	q.x, q.y, q.z = *x3, *y3, *z3
+	return q
+}
+
+// double sets q to 2p using the complete formula for a = -3 in renes-costello-batina 2015.
+func (q *point) double(p *point) *point {
+	t0 := new(fieldElement).square(&p.x)
+	t1 := new(fieldElement).square(&p.y)
+	t2 := new(fieldElement).square(&p.z)
+	t3 := new(fieldElement).mul(&p.x, &p.y)
+	t3.add(t3, t3)
+	z3 := new(fieldElement).mul(&p.x, &p.z)
+	z3.add(z3, z3)
+	y3 := new(fieldElement).mul(fieldB, t2)
+	y3.sub(y3, z3)
+	x3 := new(fieldElement).add(y3, y3)
+	y3.add(x3, y3)
+	x3.sub(t1, y3)
+	y3.add(t1, y3)
+	y3.mul(x3, y3)
+	x3.mul(x3, t3)
+	t3.add(t2, t2)
+	t2.add(t2, t3)
+	z3.mul(fieldB, z3)
+	z3.sub(z3, t2)
+	z3.sub(z3, t0)
+	t3.add(z3, z3)
+	z3.add(z3, t3)
+	t3.add(t0, t0)
+	t0.add(t3, t0)
+	t0.sub(t0, t2)
+	t0.mul(t0, z3)
+	y3.add(y3, t0)
+	t0.mul(&p.y, &p.z)
+	t0.add(t0, t0)
+	z3.mul(t0, z3)
+	x3.sub(x3, z3)
+	z3.mul(t0, t1)
+	z3.add(z3, z3)
+	z3.add(z3, z3)
+
+	q.x, q.y, q.z = *x3, *y3, *z3
+	return q
+}
+
+// selectFrom sets q to p if cond is 1 or keeps q if cond is 0.
+func (q *point) selectFrom(p *point, cond uint64) *point {
+	q.x.selectFrom(&p.x, cond)
+	q.y.selectFrom(&p.y, cond)
+	q.z.selectFrom(&p.z, cond)
+	return q
+}
+
+// scalarMult sets q to scalar * p where scalar is big endian bytes.
+// It uses a fixed 4-bit window and selects from the table in constant time.
+func (q *point) scalarMult(p *point, scalar []byte) *point {
+	var table [16]point
+	table[0] = *newIdentityPoint()
+	table[1] = *p
+
+	for i := 2; i < 16; i++ {
+		table[i].add(&table[i-1], p)
+	}
+
+	result := newIdentityPoint()
+	selected := new(point)
+
+	for _, b := range scalar {
+		for _, window := range [2]byte{b >> 4, b & 0x0F} {
+			result.double(result)
+			result.double(result)
+			result.double(result)
+			result.double(result)
+
+			*selected = *newIdentityPoint()
+			for i := range table {
+				cond := uint64(subtle.ConstantTimeByteEq(window, byte(i)))
+				selected.selectFrom(&table[i], cond)
+			}
+
+			result.add(result, selected)
+		}
+	}
+
+	*q = *result
+	return q
+}
+
+// scalarBaseMult sets q to scalar * g.
+func (q *point) scalarBaseMult(scalar []byte) *point {
+	return q.scalarMult(newGeneratorPoint(), scalar)
+}
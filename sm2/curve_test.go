@@ -0,0 +1,140 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm2
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+// testCurve is the generic curve of the standard library which is used to check our arithmetic.
+var testCurve = &elliptic.CurveParams{
+	P:       curveP,
+	N:       curveN,
+	B:       curveB,
+	Gx:      curveGx,
+	Gy:      curveGy,
+	BitSize: 256,
+	Name:    "SM2",
+}
+
+// go test -v -cover -run=^TestFieldElement$
+func TestFieldElement(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		x, err := rand.Int(rand.Reader, curveP)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		y, err := rand.Int(rand.Reader, curveP)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fx := mustFieldElement(x)
+		fy := mustFieldElement(y)
+
+		testCases := []struct {
+			name   string
+			got    *fieldElement
+			expect *big.Int
+		}{
+			{name: "add", got: new(fieldElement).add(fx, fy), expect: new(big.Int).Add(x, y)},
+			{name: "sub", got: new(fieldElement).sub(fx, fy), expect: new(big.Int).Sub(x, y)},
+			{name: "mul", got: new(fieldElement).mul(fx, fy), expect: new(big.Int).Mul(x, y)},
+			{name: "invert", got: new(fieldElement).invert(fx), expect: new(big.Int).ModInverse(x, curveP)},
+		}
+
+		for _, testCase := range testCases {
+			expect := testCase.expect.Mod(testCase.expect, curveP)
+
+			got := new(big.Int).SetBytes(testCase.got.bytes())
+			if got.Cmp(expect) != 0 {
+				t.Fatalf("%s: got %x != expect %x", testCase.name, got, expect)
+			}
+		}
+	}
+
+	if _, err := new(fieldElement).setBytes(curveP.Bytes()); err == nil {
+		t.Fatal("set p should fail")
+	}
+}
+
+// go test -v -cover -run=^TestScalarMult$
+func TestScalarMult(t *testing.T) {
+	for i := 0; i < 32; i++ {
+		k := make([]byte, coordinateSize)
+		if _, err := rand.Read(k); err != nil {
+			t.Fatal(err)
+		}
+
+		x, y := testCurve.ScalarBaseMult(k)
+		expect := elliptic.Marshal(testCurve, x, y)
+
+		got := new(point).scalarBaseMult(k).bytes()
+		if !bytes.Equal(got, expect) {
+			t.Fatalf("got %x != expect %x", got, expect)
+		}
+
+		p, err := newPoint(expect)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		x2, y2 := testCurve.Double(x, y)
+		expect = elliptic.Marshal(testCurve, x2, y2)
+
+		got = new(point).add(p, p).bytes()
+		if !bytes.Equal(got, expect) {
+			t.Fatalf("got %x != expect %x", got, expect)
+		}
+
+		got = new(point).double(p).bytes()
+		if !bytes.Equal(got, expect) {
+			t.Fatalf("got %x != expect %x", got, expect)
+		}
+
+		x3, y3 := testCurve.ScalarMult(x, y, k)
+		expect = elliptic.Marshal(testCurve, x3, y3)
+
+		got = new(point).scalarMult(p, k).bytes()
+		if !bytes.Equal(got, expect) {
+			t.Fatalf("got %x != expect %x", got, expect)
+		}
+	}
+
+	n := curveN.FillBytes(make([]byte, coordinateSize))
+	if p := new(point).scalarBaseMult(n); !p.isIdentity() {
+		t.Fatalf("n * g %x is not identity", p.bytes())
+	}
+}
+
+// go test -v -cover -run=^TestNewPoint$
+func TestNewPoint(t *testing.T) {
+	g := newGeneratorPoint().bytes()
+
+	p, err := newPoint(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(p.bytes(), g) {
+		t.Fatalf("got %x != expect %x", p.bytes(), g)
+	}
+
+	offCurve := bytes.Clone(g)
+	offCurve[pointSize-1] ^= 1
+
+	compressed := append([]byte{2}, g[1:1+coordinateSize]...)
+
+	for _, data := range [][]byte{nil, g[:pointSize-1], offCurve, compressed, make([]byte, pointSize)} {
+		if _, err = newPoint(data); err != errInvalidPoint {
+			t.Fatalf("data %x: got %v != expect %v", data, err, errInvalidPoint)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm2
+
+import (
+	"crypto/subtle"
+	"errors"
+	"math/big"
+)
+
+var (
+	errExchangeNotDone   = errors.New("cryptox/sm2: key exchange is not done")
+	errConfirmFailed     = errors.New("cryptox/sm2: key exchange confirmation failed")
+	errInvalidKeyLength  = errors.New("cryptox/sm2: invalid key length")
+	errSharedKeyInfinity = errors.New("cryptox/sm2: shared point is infinity")
+)
+
+// KeyExchange is the key exchange protocol in gb/t 32918.3.
+// Both sides send their ephemeral keys to each other and then compute the same shared key.
+// The confirmations are optional, and they prove that both sides have the same shared key.
+type KeyExchange struct {
+	privateKey    PrivateKey
+	peerPublicKey PublicKey
+	initiator     bool
+	za            []byte
+	peerZA        []byte
+
+	ephemeral    *scalar
+	ephemeralKey []byte
+
+	// These fields are computed in SharedKey.
+	confirmation     []byte
+	peerConfirmation []byte
+}
+
+// NewKeyExchange creates a key exchange with the private key and the peer's public key.
+// The initiator is the side which sends its ephemeral key first, known as A in gb/t 32918.3.
+func NewKeyExchange(privateKey PrivateKey, peerPublicKey PublicKey, initiator bool, opts ...Option) (*KeyExchange, error) {
+	conf := newConfig().Apply(opts...)
+
+	za, err := privateKey.publicKey.za(conf.id)
+	if err != nil {
+		return nil, err
+	}
+
+	peerZA, err := peerPublicKey.za(conf.peerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ephemeral, ephemeralBytes, err := randScalar(conf.random)
+	if err != nil {
+		return nil, err
+	}
+
+	ke := &KeyExchange{
+		privateKey:    privateKey,
+		peerPublicKey: peerPublicKey,
+		initiator:     initiator,
+		za:            za,
+		peerZA:        peerZA,
+		ephemeral:     ephemeral,
+		ephemeralKey:  new(point).scalarBaseMult(ephemeralBytes).bytes(),
+	}
+
+	return ke, nil
+}
+
+// EphemeralKey returns the ephemeral key which should be sent to the peer.
+func (ke *KeyExchange) EphemeralKey() []byte {
+	return append([]byte(nil), ke.ephemeralKey...)
+}
+
+// reduceX returns 2^w + (x & (2^w - 1)) where w is 127.
+func reduceX(x []byte) *big.Int {
+	w := uint(127)
+	mask := new(big.Int).Lsh(big.NewInt(1), w)
+
+	reduced := new(big.Int).SetBytes(x)
+	reduced.And(reduced, new(big.Int).Sub(mask, big.NewInt(1)))
+	reduced.Add(reduced, mask)
+	return reduced
+}
+
+// SharedKey computes the shared key of length with the peer's ephemeral key.
+// It also computes the confirmations which can be used after it returns.
+func (ke *KeyExchange) SharedKey(peerEphemeralKey []byte, length int) ([]byte, error) {
+	if length <= 0 {
+		return nil, errInvalidKeyLength
+	}
+
+	peerEphemeral, err := newPoint(peerEphemeralKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// t = (d + x * r) mod n
+	// The x is public but d and r are secrets so t is computed in constant time.
+	ownX := reduceX(ke.ephemeralKey[1 : 1+coordinateSize])
+
+	t, err := new(scalar).setBytes(ownX.FillBytes(make([]byte, coordinateSize)))
+	if err != nil {
+		return nil, err
+	}
+
+	t.mul(t, ke.ephemeral)
+	t.add(t, ke.privateKey.d)
+
+	// v = t * (p + x * R) where the cofactor is 1
+	x := reduceX(peerEphemeralKey[1 : 1+coordinateSize])
+
+	v := new(point).scalarMult(peerEphemeral, x.FillBytes(make([]byte, coordinateSize)))
+	v.add(v, ke.peerPublicKey.point)
+	v.scalarMult(v, t.bytes())
+
+	if v.isIdentity() {
+		return nil, errSharedKeyInfinity
+	}
+
+	xv, yv := v.affine()
+
+	za, zb := ke.za, ke.peerZA
+	ra, rb := ke.ephemeralKey, peerEphemeralKey
+
+	if !ke.initiator {
+		za, zb = zb, za
+		ra, rb = rb, ra
+	}
+
+	key, sa, sb := deriveKey(length, xv, yv, za, zb, ra, rb)
+
+	if ke.initiator {
+		ke.confirmation, ke.peerConfirmation = sa, sb
+	} else {
+		ke.confirmation, ke.peerConfirmation = sb, sa
+	}
+
+	return key, nil
+}
+
+// deriveKey derives the shared key of length and the confirmations from the shared point v.
+// The za and ra are the initiator's and the zb and rb are the responder's.
+// It's independent of the curve so it can be checked with the example in gb/t 32918.3.
+func deriveKey(length int, xv []byte, yv []byte, za []byte, zb []byte, ra []byte, rb []byte) (key []byte, sa []byte, sb []byte) {
+	key = kdf(length, xv, yv, za, zb)
+
+	// The responder sends sb with 0x02 and the initiator sends sa with 0x03.
+	digest := sm3Sum(xv, za, zb, ra[1:], rb[1:])
+	sb = sm3Sum([]byte{0x02}, yv, digest)
+	sa = sm3Sum([]byte{0x03}, yv, digest)
+	return key, sa, sb
+}
+
+// Confirmation returns the confirmation which should be sent to the peer.
+// It returns nil if the shared key hasn't been computed.
+func (ke *KeyExchange) Confirmation() []byte {
+	return append([]byte(nil), ke.confirmation...)
+}
+
+// VerifyConfirmation verifies the confirmation sent by the peer.
+func (ke *KeyExchange) VerifyConfirmation(confirmation []byte) error {
+	if len(ke.peerConfirmation) == 0 {
+		return errExchangeNotDone
+	}
+
+	if subtle.ConstantTimeCompare(ke.peerConfirmation, confirmation) != 1 {
+		return errConfirmFailed
+	}
+
+	return nil
+}
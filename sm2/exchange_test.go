@@ -0,0 +1,214 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm2
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func decodeHex(t *testing.T, str string) []byte {
+	data, err := hex.DecodeString(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+// go test -v -cover -run=^TestKeyExchange$
+func TestKeyExchange(t *testing.T) {
+	privateKeyA, publicKeyA, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKeyB, publicKeyB, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	idA := []byte("ALICE123@YAHOO.COM")
+	idB := []byte("BILL456@YAHOO.COM")
+
+	exchangeA, err := NewKeyExchange(privateKeyA, publicKeyB, true, WithID(idA), WithPeerID(idB))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exchangeB, err := NewKeyExchange(privateKeyB, publicKeyA, false, WithID(idB), WithPeerID(idA))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = exchangeB.VerifyConfirmation(nil); err != errExchangeNotDone {
+		t.Fatalf("got %v != expect %v", err, errExchangeNotDone)
+	}
+
+	// B receives the ephemeral key from A and sends its ephemeral key and confirmation back.
+	keyB, err := exchangeB.SharedKey(exchangeA.EphemeralKey(), 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyA, err := exchangeA.SharedKey(exchangeB.EphemeralKey(), 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(keyA) != 16 || !bytes.Equal(keyA, keyB) {
+		t.Fatalf("got %x != expect %x", keyA, keyB)
+	}
+
+	if err = exchangeA.VerifyConfirmation(exchangeB.Confirmation()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = exchangeB.VerifyConfirmation(exchangeA.Confirmation()); err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Equal(exchangeA.Confirmation(), exchangeB.Confirmation()) {
+		t.Fatal("confirmations of a and b should be different")
+	}
+
+	if err = exchangeA.VerifyConfirmation(exchangeA.Confirmation()); err != errConfirmFailed {
+		t.Fatalf("got %v != expect %v", err, errConfirmFailed)
+	}
+
+	// The shared keys are different if the ids are mismatched.
+	exchangeC, err := NewKeyExchange(privateKeyB, publicKeyA, false, WithID(idB))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyC, err := exchangeC.SharedKey(exchangeA.EphemeralKey(), 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Equal(keyA, keyC) {
+		t.Fatalf("got %x == %x", keyA, keyC)
+	}
+
+	if _, err = exchangeA.SharedKey(exchangeB.EphemeralKey(), 0); err != errInvalidKeyLength {
+		t.Fatalf("got %v != expect %v", err, errInvalidKeyLength)
+	}
+
+	if _, err = exchangeA.SharedKey(make([]byte, pointSize), 16); err != errInvalidPoint {
+		t.Fatalf("got %v != expect %v", err, errInvalidPoint)
+	}
+}
+
+// go test -v -cover -run=^TestKeyExchangeGBT329183$
+func TestKeyExchangeGBT329183(t *testing.T) {
+	// The example in gb/t 32918.3 appendix a.2 which uses its own example curve instead of the recommended one.
+	// So only the curve independent steps are checked here.
+	za := decodeHex(t, "E4D1D0C3CA4C7F11BC8FF8CB3F4C02A78F108FA098E51A668487240F75E20F31")
+	zb := decodeHex(t, "6B4B6D0E276691BD4A11BF72F4FB501AE309FDACB72FA6CC336E6656119ABD67")
+	ra := decodeHex(t, "04"+
+		"6CB5633816F4DD560B1DEC458310CBCC6856C09505324A6D23150C408F162BF0"+
+		"0D6FCF62F1036C0A1B6DACCF57399223A65F7D7BF2D9637E5BBBEB857961BF1A")
+	rb := decodeHex(t, "04"+
+		"1799B2A2C778295300D9A2325C686129B8F2B5337B3DCF4514E8BBC19D900EE5"+
+		"54C9288C82733EFDF7808AE7F27D0E732F7C73A7D9AC98B7D8740A91D0DB3CF4")
+	xv := decodeHex(t, "47C826534DC2F6F1FBF28728DD658F21E174F48179ACEF2900F8B7F566E40905")
+	yv := decodeHex(t, "2AF86EFE732CF12AD0E09A1F2556CC650D9CCCE3E249866BBB5C6846A4C4A295")
+
+	x1 := reduceX(ra[1 : 1+coordinateSize])
+	if expect := decodeHex(t, "E856C09505324A6D23150C408F162BF0"); !bytes.Equal(x1.Bytes(), expect) {
+		t.Fatalf("got %x != expect %x", x1.Bytes(), expect)
+	}
+
+	x2 := reduceX(rb[1 : 1+coordinateSize])
+	if expect := decodeHex(t, "B8F2B5337B3DCF4514E8BBC19D900EE5"); !bytes.Equal(x2.Bytes(), expect) {
+		t.Fatalf("got %x != expect %x", x2.Bytes(), expect)
+	}
+
+	key, sa, sb := deriveKey(16, xv, yv, za, zb, ra, rb)
+
+	if expect := decodeHex(t, "55B0AC62A6B927BA23703832C853DED4"); !bytes.Equal(key, expect) {
+		t.Fatalf("got %x != expect %x", key, expect)
+	}
+
+	if expect := decodeHex(t, "23444DAF8ED7534366CB901C84B3BDBB63504F4065C1116C91A4C00697E6CF7A"); !bytes.Equal(sa, expect) {
+		t.Fatalf("got %x != expect %x", sa, expect)
+	}
+
+	if expect := decodeHex(t, "284C8F198F141B502E81250F1581C7E9EEB4CA6990F9E02DF388B45471F5BC5C"); !bytes.Equal(sb, expect) {
+		t.Fatalf("got %x != expect %x", sb, expect)
+	}
+}
+
+// go test -v -cover -run=^TestKeyExchangeKnownAnswer$
+func TestKeyExchangeKnownAnswer(t *testing.T) {
+	// The keys of gb/t 32918.3 appendix a.2 on the recommended curve.
+	// The expected values are checked with another independent implementation.
+	privateKeyA, err := NewPrivateKey(decodeHex(t, "6FCBA2EF9AE0AB902BC3BDE3FF915D44BA4CC78F88E2F8E7F8996D3B8CCEEDEE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKeyB, err := NewPrivateKey(decodeHex(t, "5E35D7D3F3C54DBAC72E61819E730B019A84208CA3A35E4C2E353DFCCB2A3B53"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	idA := []byte("ALICE123@YAHOO.COM")
+	idB := []byte("BILL456@YAHOO.COM")
+
+	randomA := bytes.NewReader(decodeHex(t, "83A2C9C8B96E5AF70BD480B472409A9A327257F1EBB73F5B073354B248668563"))
+	randomB := bytes.NewReader(decodeHex(t, "33FE21940342161C55619C4A0C060293D543C80AF19748CE176D83477DE71C80"))
+
+	exchangeA, err := NewKeyExchange(privateKeyA, privateKeyB.PublicKey(), true, WithID(idA), WithPeerID(idB), WithRandom(randomA))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exchangeB, err := NewKeyExchange(privateKeyB, privateKeyA.PublicKey(), false, WithID(idB), WithPeerID(idA), WithRandom(randomB))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectRA := decodeHex(t, "04"+
+		"698C93C85618D221A6DE87CA8F091A89CFAECC9FFF2DD978F92769A40AF3B740"+
+		"B058698F05ED5AADEC7D50616E7A05E9AA151C9B693FDCADA01F16CFACC994B4")
+	if !bytes.Equal(exchangeA.EphemeralKey(), expectRA) {
+		t.Fatalf("got %x != expect %x", exchangeA.EphemeralKey(), expectRA)
+	}
+
+	expectRB := decodeHex(t, "04"+
+		"26891AFEC73A32FA5BF2CBE91ACDED37CAC48621D85D5965A5044A84DBDA5988"+
+		"C2F8F5DDD6190244D5AA85FEB8E9CBC583C75401E37D8811D47A800F0D96796F")
+	if !bytes.Equal(exchangeB.EphemeralKey(), expectRB) {
+		t.Fatalf("got %x != expect %x", exchangeB.EphemeralKey(), expectRB)
+	}
+
+	keyA, err := exchangeA.SharedKey(exchangeB.EphemeralKey(), 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyB, err := exchangeB.SharedKey(exchangeA.EphemeralKey(), 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectKey := decodeHex(t, "F02F9068AD13E14F2B2602E0DFB2504F")
+	if !bytes.Equal(keyA, expectKey) || !bytes.Equal(keyB, expectKey) {
+		t.Fatalf("got %x, %x != expect %x", keyA, keyB, expectKey)
+	}
+
+	expectSA := decodeHex(t, "DAEFCA6C32F53C48444D4EF35F98471E5D1CD1E3B5B8E3322DEDE310306689F6")
+	if !bytes.Equal(exchangeA.Confirmation(), expectSA) {
+		t.Fatalf("got %x != expect %x", exchangeA.Confirmation(), expectSA)
+	}
+
+	expectSB := decodeHex(t, "B93374ADE30A74E12DDB40E4C03D0C6FCF61BADBF2C2C5CC39A91201D9228E2E")
+	if !bytes.Equal(exchangeB.Confirmation(), expectSB) {
+		t.Fatalf("got %x != expect %x", exchangeB.Confirmation(), expectSB)
+	}
+}
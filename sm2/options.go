@@ -0,0 +1,172 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm2
+
+import (
+	"crypto/rand"
+	"io"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+	"github.com/FishGoddess/cryptox/x509"
+)
+
+// defaultID is the default user id in gb/t 32918 which is widely used.
+var defaultID = []byte("1234567812345678")
+
+type KeyConfig struct {
+	random           io.Reader
+	encodePrivateKey func(key PrivateKey) ([]byte, error)
+	encodePublicKey  func(key PublicKey) ([]byte, error)
+	decodePrivateKey func(data []byte) (PrivateKey, error)
+	decodePublicKey  func(data []byte) (PublicKey, error)
+}
+
+func newKeyConfig() *KeyConfig {
+	conf := &KeyConfig{
+		random:           rand.Reader,
+		encodePrivateKey: x509.EncodePrivateKeyPKCS8[PrivateKey],
+		encodePublicKey:  x509.EncodePublicKeyPKIX[PublicKey],
+		decodePrivateKey: x509.DecodePrivateKeyPKCS8[PrivateKey],
+		decodePublicKey:  x509.DecodePublicKeyPKIX[PublicKey],
+	}
+
+	return conf
+}
+
+func (kc *KeyConfig) Apply(opts ...KeyOption) *KeyConfig {
+	for _, opt := range opts {
+		opt(kc)
+	}
+
+	return kc
+}
+
+type KeyOption func(conf *KeyConfig)
+
+// WithKeyRandom sets random to key config.
+func WithKeyRandom(random io.Reader) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.random = random
+	}
+}
+
+// WithKeyEncodePrivate sets encode to key config.
+func WithKeyEncodePrivate(encode func(key PrivateKey) ([]byte, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.encodePrivateKey = encode
+	}
+}
+
+// WithKeyEncodePublic sets encode to key config.
+func WithKeyEncodePublic(encode func(key PublicKey) ([]byte, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.encodePublicKey = encode
+	}
+}
+
+// WithKeyDecodePrivate sets decode to key config.
+func WithKeyDecodePrivate(decode func(data []byte) (PrivateKey, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.decodePrivateKey = decode
+	}
+}
+
+// WithKeyDecodePublic sets decode to key config.
+func WithKeyDecodePublic(decode func(data []byte) (PublicKey, error)) KeyOption {
+	return func(conf *KeyConfig) {
+		conf.decodePublicKey = decode
+	}
+}
+
+// cipherOrder is the order of c1, c2 and c3 in ciphertext.
+type cipherOrder int
+
+const (
+	orderC1C3C2 cipherOrder = iota
+	orderC1C2C3
+)
+
+type Config struct {
+	encoding encoding.Encoding
+	random   io.Reader
+	id       []byte
+	peerID   []byte
+	order    cipherOrder
+}
+
+func newConfig() *Config {
+	conf := &Config{
+		encoding: encoding.None{},
+		random:   rand.Reader,
+		id:       defaultID,
+		peerID:   defaultID,
+		order:    orderC1C3C2,
+	}
+
+	return conf
+}
+
+func (c *Config) Apply(opts ...Option) *Config {
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+type Option func(conf *Config)
+
+// WithHex sets hex encoding to config.
+func WithHex() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Hex{}
+	}
+}
+
+// WithBase64 sets base64 encoding to config.
+func WithBase64() Option {
+	return func(conf *Config) {
+		conf.encoding = encoding.Base64{}
+	}
+}
+
+// WithRandom sets random to config.
+func WithRandom(random io.Reader) Option {
+	return func(conf *Config) {
+		conf.random = random
+	}
+}
+
+// WithID sets user id to config.
+// It's used to compute the za digest in signing and key exchange.
+func WithID(id []byte) Option {
+	return func(conf *Config) {
+		conf.id = id
+	}
+}
+
+// WithPeerID sets the peer's user id to config.
+// It's used to compute the peer's za digest in key exchange.
+func WithPeerID(id []byte) Option {
+	return func(conf *Config) {
+		conf.peerID = id
+	}
+}
+
+// WithC1C3C2 sets c1c3c2 order of ciphertext to config.
+// It's the order in gb/t 32918 and it's the default order.
+func WithC1C3C2() Option {
+	return func(conf *Config) {
+		conf.order = orderC1C3C2
+	}
+}
+
+// WithC1C2C3 sets c1c2c3 order of ciphertext to config.
+// It's the order in the old draft and some old libraries still use it.
+func WithC1C2C3() Option {
+	return func(conf *Config) {
+		conf.order = orderC1C2C3
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm2
+
+import (
+	"crypto/rand"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/FishGoddess/cryptox/bytes/encoding"
+	"github.com/FishGoddess/cryptox/x509"
+)
+
+// go test -v -cover -run=^TestKeyConfig$
+func TestKeyConfig(t *testing.T) {
+	opts := []KeyOption{
+		WithKeyRandom(rand.Reader),
+		WithKeyEncodePrivate(x509.EncodePrivateKeyPKCS8),
+		WithKeyEncodePublic(x509.EncodePublicKeyPKIX),
+		WithKeyDecodePrivate(x509.DecodePrivateKeyPKCS8),
+		WithKeyDecodePublic(x509.DecodePublicKeyPKIX),
+	}
+
+	conf := newKeyConfig().Apply(opts...)
+
+	got := fmt.Sprintf("%p", conf.random)
+	expect := fmt.Sprintf("%p", rand.Reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.encodePrivateKey)
+	expect = fmt.Sprintf("%p", x509.EncodePrivateKeyPKCS8[PrivateKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.encodePublicKey)
+	expect = fmt.Sprintf("%p", x509.EncodePublicKeyPKIX[PublicKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.decodePrivateKey)
+	expect = fmt.Sprintf("%p", x509.DecodePrivateKeyPKCS8[PrivateKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.decodePublicKey)
+	expect = fmt.Sprintf("%p", x509.DecodePublicKeyPKIX[PublicKey])
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestConfig$
+func TestConfig(t *testing.T) {
+	id := []byte("alice")
+	peerID := []byte("bob")
+
+	opts := []Option{
+		WithHex(),
+		WithRandom(rand.Reader),
+		WithID(id),
+		WithPeerID(peerID),
+		WithC1C2C3(),
+	}
+
+	conf := newConfig().Apply(opts...)
+
+	got := fmt.Sprintf("%T", conf.encoding)
+	expect := fmt.Sprintf("%T", encoding.Hex{})
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	conf.Apply(WithBase64())
+
+	got = fmt.Sprintf("%T", conf.encoding)
+	expect = fmt.Sprintf("%T", encoding.Base64{})
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	got = fmt.Sprintf("%p", conf.random)
+	expect = fmt.Sprintf("%p", rand.Reader)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if !slices.Equal(conf.id, id) {
+		t.Fatalf("got %s != expect %s", conf.id, id)
+	}
+
+	if !slices.Equal(conf.peerID, peerID) {
+		t.Fatalf("got %s != expect %s", conf.peerID, peerID)
+	}
+
+	if conf.order != orderC1C2C3 {
+		t.Fatalf("got %d != expect %d", conf.order, orderC1C2C3)
+	}
+
+	conf.Apply(WithC1C3C2())
+
+	if conf.order != orderC1C3C2 {
+		t.Fatalf("got %d != expect %d", conf.order, orderC1C3C2)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm2
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+var errInvalidScalar = errors.New("cryptox/sm2: invalid scalar")
+
+// scalar is an element of the field of n in montgomery form with little endian limbs.
+// It's used in signing and key exchange so the secrets are computed in constant time.
+type scalar [4]uint64
+
+var (
+	scalarN = scalar(limbsOf(curveN))
+
+	// scalarNInv is -n^-1 mod 2^64 which is the reducing factor in montgomery way.
+	scalarNInv = scalarNInvOf(curveN)
+
+	// scalarRR is 2^512 mod n which is used to convert a scalar to montgomery form.
+	scalarRR = scalar(limbsOf(new(big.Int).Mod(new(big.Int).Lsh(big.NewInt(1), 512), curveN)))
+
+	// scalarNMinus2 is the exponent of inverting a scalar.
+	scalarNMinus2 = new(big.Int).Sub(curveN, big.NewInt(2)).FillBytes(make([]byte, coordinateSize))
+
+	scalarOne = mustScalar(big.NewInt(1))
+)
+
+func scalarNInvOf(n *big.Int) uint64 {
+	r := new(big.Int).Lsh(big.NewInt(1), 64)
+	inv := new(big.Int).ModInverse(new(big.Int).Mod(n, r), r)
+	return new(big.Int).Sub(r, inv).Uint64()
+}
+
+func mustScalar(x *big.Int) *scalar {
+	var buffer [coordinateSize]byte
+	x.FillBytes(buffer[:])
+
+	s, err := new(scalar).setBytes(buffer[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// reduce subtracts n from t if t with carry is not less than n.
+func (z *scalar) reduce(t *scalar, carry uint64) *scalar {
+	var d scalar
+	var borrow uint64
+
+	d[0], borrow = bits.Sub64(t[0], scalarN[0], 0)
+	d[1], borrow = bits.Sub64(t[1], scalarN[1], borrow)
+	d[2], borrow = bits.Sub64(t[2], scalarN[2], borrow)
+	d[3], borrow = bits.Sub64(t[3], scalarN[3], borrow)
+	_, borrow = bits.Sub64(carry, 0, borrow)
+
+	// Keep t if the subtraction borrows.
+	mask := -borrow
+	for i := range z {
+		z[i] = (t[i] & mask) | (d[i] &^ mask)
+	}
+
+	return z
+}
+
+func (z *scalar) add(x *scalar, y *scalar) *scalar {
+	var t scalar
+	var carry uint64
+
+	t[0], carry = bits.Add64(x[0], y[0], 0)
+	t[1], carry = bits.Add64(x[1], y[1], carry)
+	t[2], carry = bits.Add64(x[2], y[2], carry)
+	t[3], carry = bits.Add64(x[3], y[3], carry)
+	return z.reduce(&t, carry)
+}
+
+func (z *scalar) sub(x *scalar, y *scalar) *scalar {
+	var t scalar
+	var borrow uint64
+
+	t[0], borrow = bits.Sub64(x[0], y[0], 0)
+	t[1], borrow = bits.Sub64(x[1], y[1], borrow)
+	t[2], borrow = bits.Sub64(x[2], y[2], borrow)
+	t[3], borrow = bits.Sub64(x[3], y[3], borrow)
+
+	// Add n back if the subtraction borrows.
+	mask := -borrow
+
+	var carry uint64
+	z[0], carry = bits.Add64(t[0], scalarN[0]&mask, 0)
+	z[1], carry = bits.Add64(t[1], scalarN[1]&mask, carry)
+	z[2], carry = bits.Add64(t[2], scalarN[2]&mask, carry)
+	z[3], _ = bits.Add64(t[3], scalarN[3]&mask, carry)
+	return z
+}
+
+// scalarMulStep adds x * yi to t and then reduces t by one limb in montgomery way.
+// Unlike p, the reducing multiplier m of n is t0 * (-n^-1 mod 2^64).
+func scalarMulStep(x *scalar, yi uint64, t0, t1, t2, t3, t4 uint64) (uint64, uint64, uint64, uint64, uint64) {
+	var c, t5 uint64
+	c, t0 = madd(x[0], yi, t0, 0)
+	c, t1 = madd(x[1], yi, t1, c)
+	c, t2 = madd(x[2], yi, t2, c)
+	c, t3 = madd(x[3], yi, t3, c)
+	t4, t5 = bits.Add64(t4, c, 0)
+
+	m := t0 * scalarNInv
+	c, _ = madd(m, scalarN[0], t0, 0)
+	c, t0 = madd(m, scalarN[1], t1, c)
+	c, t1 = madd(m, scalarN[2], t2, c)
+	c, t2 = madd(m, scalarN[3], t3, c)
+	t3, c = bits.Add64(t4, c, 0)
+	t4 = t5 + c
+	return t0, t1, t2, t3, t4
+}
+
+// mul sets z to x * y / 2^256 mod n in montgomery way.
+func (z *scalar) mul(x *scalar, y *scalar) *scalar {
+	var t0, t1, t2, t3, t4 uint64
+	t0, t1, t2, t3, t4 = scalarMulStep(x, y[0], t0, t1, t2, t3, t4)
+	t0, t1, t2, t3, t4 = scalarMulStep(x, y[1], t0, t1, t2, t3, t4)
+	t0, t1, t2, t3, t4 = scalarMulStep(x, y[2], t0, t1, t2, t3, t4)
+	t0, t1, t2, t3, t4 = scalarMulStep(x, y[3], t0, t1, t2, t3, t4)
+
+	t := scalar{t0, t1, t2, t3}
+	return z.reduce(&t, t4)
+}
+
+// invert sets z to x^(n-2) which is x^-1 or zero if x is zero.
+// The exponent is public so only x is computed in constant time.
+func (z *scalar) invert(x *scalar) *scalar {
+	result := *scalarOne
+	base := *x
+
+	for _, b := range scalarNMinus2 {
+		for i := 7; i >= 0; i-- {
+			result.mul(&result, &result)
+
+			if (b>>i)&1 == 1 {
+				result.mul(&result, &base)
+			}
+		}
+	}
+
+	*z = result
+	return z
+}
+
+func (z *scalar) equal(x *scalar) int {
+	var diff uint64
+	for i := range z {
+		diff |= z[i] ^ x[i]
+	}
+
+	folded := uint32(diff>>32) | uint32(diff)
+	return subtle.ConstantTimeEq(int32(folded), 0)
+}
+
+func (z *scalar) isZero() int {
+	return z.equal(new(scalar))
+}
+
+// scalarLimbs returns the little endian limbs of the big endian bytes.
+func scalarLimbs(data []byte) scalar {
+	var t scalar
+	for i := 0; i < 4; i++ {
+		t[i] = binary.BigEndian.Uint64(data[coordinateSize-8*(i+1):])
+	}
+
+	return t
+}
+
+// setBytes sets z to the big endian bytes which must be less than n.
+func (z *scalar) setBytes(data []byte) (*scalar, error) {
+	if len(data) != coordinateSize {
+		return nil, errInvalidScalar
+	}
+
+	t := scalarLimbs(data)
+
+	var borrow uint64
+	_, borrow = bits.Sub64(t[0], scalarN[0], 0)
+	_, borrow = bits.Sub64(t[1], scalarN[1], borrow)
+	_, borrow = bits.Sub64(t[2], scalarN[2], borrow)
+	_, borrow = bits.Sub64(t[3], scalarN[3], borrow)
+
+	if borrow == 0 {
+		return nil, errInvalidScalar
+	}
+
+	return z.mul(&t, &scalarRR), nil
+}
+
+// setBytesReduced sets z to the big endian bytes mod n.
+// The bytes are less than 2^256 which is less than 2n, so subtracting n once is enough.
+func (z *scalar) setBytesReduced(data []byte) (*scalar, error) {
+	if len(data) != coordinateSize {
+		return nil, errInvalidScalar
+	}
+
+	t := scalarLimbs(data)
+	t.reduce(&t, 0)
+	return z.mul(&t, &scalarRR), nil
+}
+
+// bytes returns the big endian bytes of z which is converted from montgomery form.
+func (z *scalar) bytes() []byte {
+	var t scalar
+	t.mul(z, &scalar{1})
+
+	data := make([]byte, coordinateSize)
+	for i := 0; i < 4; i++ {
+		binary.BigEndian.PutUint64(data[coordinateSize-8*(i+1):], t[i])
+	}
+
+	return data
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm2
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+// go test -v -cover -run=^TestScalar$
+func TestScalar(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		x, err := rand.Int(rand.Reader, curveN)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		y, err := rand.Int(rand.Reader, curveN)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sx := mustScalar(x)
+		sy := mustScalar(y)
+
+		testCases := []struct {
+			name   string
+			got    *scalar
+			expect *big.Int
+		}{
+			{name: "add", got: new(scalar).add(sx, sy), expect: new(big.Int).Add(x, y)},
+			{name: "sub", got: new(scalar).sub(sx, sy), expect: new(big.Int).Sub(x, y)},
+			{name: "mul", got: new(scalar).mul(sx, sy), expect: new(big.Int).Mul(x, y)},
+			{name: "invert", got: new(scalar).invert(sx), expect: new(big.Int).ModInverse(x, curveN)},
+		}
+
+		for _, testCase := range testCases {
+			expect := testCase.expect.Mod(testCase.expect, curveN)
+
+			got := new(big.Int).SetBytes(testCase.got.bytes())
+			if got.Cmp(expect) != 0 {
+				t.Fatalf("%s: got %x != expect %x", testCase.name, got, expect)
+			}
+		}
+	}
+
+	n := curveN.FillBytes(make([]byte, coordinateSize))
+	if _, err := new(scalar).setBytes(n); err == nil {
+		t.Fatal("set n should fail")
+	}
+
+	// The bytes not less than n are reduced.
+	max := bytes.Repeat([]byte{0xFF}, coordinateSize)
+
+	reduced, err := new(scalar).setBytesReduced(max)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := new(big.Int).SetBytes(max)
+	expect.Mod(expect, curveN)
+
+	got := new(big.Int).SetBytes(reduced.bytes())
+	if got.Cmp(expect) != 0 {
+		t.Fatalf("got %x != expect %x", got, expect)
+	}
+}
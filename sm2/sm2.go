@@ -0,0 +1,375 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm2
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+
+	cxhash "github.com/FishGoddess/cryptox/hash"
+)
+
+// digestSize is the size of sm3 digest which is c3 in ciphertext.
+const digestSize = 32
+
+var (
+	errVerifyFailed  = errors.New("cryptox/sm2: verify failed")
+	errDecryptFailed = errors.New("cryptox/sm2: decrypt failed")
+)
+
+type PrivateKey struct {
+	d         *scalar
+	publicKey PublicKey
+}
+
+// NewPrivateKey returns a private key of the big endian bytes.
+// The bytes must be 32 bytes and in [1, n-2].
+func NewPrivateKey(data []byte) (PrivateKey, error) {
+	if len(data) != coordinateSize {
+		return PrivateKey{}, fmt.Errorf("cryptox/sm2: invalid private key size %d", len(data))
+	}
+
+	d, err := new(scalar).setBytes(data)
+	if err != nil {
+		return PrivateKey{}, errors.New("cryptox/sm2: invalid private key")
+	}
+
+	// The d is n-1 if 1 + d is zero.
+	dPlus1 := new(scalar).add(d, scalarOne)
+	if d.isZero()|dPlus1.isZero() == 1 {
+		return PrivateKey{}, errors.New("cryptox/sm2: invalid private key")
+	}
+
+	p := new(point).scalarBaseMult(data)
+
+	privateKey := PrivateKey{d: d, publicKey: PublicKey{data: p.bytes(), point: p}}
+	return privateKey, nil
+}
+
+// Bytes returns the private key in 32 big endian bytes.
+func (pk PrivateKey) Bytes() []byte {
+	return pk.d.bytes()
+}
+
+// PublicKey returns the public key of the private key.
+func (pk PrivateKey) PublicKey() PublicKey {
+	return pk.publicKey
+}
+
+// Equal returns if the private key equals to the key.
+func (pk PrivateKey) Equal(key PrivateKey) bool {
+	return pk.d != nil && key.d != nil && pk.d.equal(key.d) == 1
+}
+
+// Sign signs data with the za digest of id.
+// The sign is the asn.1 der of r and s.
+func (pk PrivateKey) Sign(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	za, err := pk.publicKey.za(conf.id)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := new(scalar).setBytesReduced(sm3Sum(za, data))
+	if err != nil {
+		return nil, err
+	}
+
+	// s = (1 + d)^-1 * (k - r * d) mod n
+	// All scalars are computed in constant time because d and k are secrets.
+	dInv := new(scalar).add(pk.d, scalarOne)
+	dInv.invert(dInv)
+
+	r, s := new(scalar), new(scalar)
+	for {
+		k, kBytes, err := randScalar(conf.random)
+		if err != nil {
+			return nil, err
+		}
+
+		x1, _ := new(point).scalarBaseMult(kBytes).affine()
+
+		if _, err = r.setBytesReduced(x1); err != nil {
+			return nil, err
+		}
+
+		r.add(r, e)
+
+		if r.isZero()|new(scalar).add(r, k).isZero() == 1 {
+			continue
+		}
+
+		s.mul(r, pk.d)
+		s.sub(k, s)
+		s.mul(s, dInv)
+
+		if s.isZero() == 0 {
+			break
+		}
+	}
+
+	sig := signature{R: new(big.Int).SetBytes(r.bytes()), S: new(big.Int).SetBytes(s.bytes())}
+
+	sign, err := asn1.Marshal(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	sign = conf.encoding.Encode(sign)
+	return sign, nil
+}
+
+// Decrypt decrypts data which is encrypted by the public key.
+// The order of ciphertext is c1c3c2 by default.
+func (pk PrivateKey) Decrypt(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	data, err := conf.encoding.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < pointSize+digestSize {
+		return nil, errDecryptFailed
+	}
+
+	c1 := data[:pointSize]
+	c2 := data[pointSize+digestSize:]
+	c3 := data[pointSize : pointSize+digestSize]
+
+	if conf.order == orderC1C2C3 {
+		c2 = data[pointSize : len(data)-digestSize]
+		c3 = data[len(data)-digestSize:]
+	}
+
+	p, err := newPoint(c1)
+	if err != nil {
+		return nil, err
+	}
+
+	x2, y2 := new(point).scalarMult(p, pk.Bytes()).affine()
+
+	plain := kdf(len(c2), x2, y2)
+	if len(c2) > 0 && isAllZero(plain) {
+		return nil, errDecryptFailed
+	}
+
+	subtle.XORBytes(plain, plain, c2)
+
+	digest := sm3Sum(x2, plain, y2)
+	if subtle.ConstantTimeCompare(digest, c3) != 1 {
+		return nil, errDecryptFailed
+	}
+
+	return plain, nil
+}
+
+type PublicKey struct {
+	data  []byte
+	point *point
+}
+
+// NewPublicKey returns a public key of the uncompressed point bytes.
+func NewPublicKey(data []byte) (PublicKey, error) {
+	p, err := newPoint(data)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	publicKey := PublicKey{data: bytes.Clone(data), point: p}
+	return publicKey, nil
+}
+
+// Bytes returns the public key in uncompressed point bytes.
+func (pk PublicKey) Bytes() []byte {
+	return bytes.Clone(pk.data)
+}
+
+// Equal returns if the public key equals to the key.
+func (pk PublicKey) Equal(key PublicKey) bool {
+	return len(pk.data) > 0 && bytes.Equal(pk.data, key.data)
+}
+
+// za returns the digest of id, curve parameters and the public key.
+func (pk PublicKey) za(id []byte) ([]byte, error) {
+	if len(id)*8 > math.MaxUint16 {
+		return nil, fmt.Errorf("cryptox/sm2: id length %d is too long", len(id))
+	}
+
+	h := cxhash.NewSM3()
+
+	var entl [2]byte
+	binary.BigEndian.PutUint16(entl[:], uint16(len(id)*8))
+
+	h.Write(entl[:])
+	h.Write(id)
+
+	for _, param := range []*big.Int{curveA, curveB, curveGx, curveGy} {
+		h.Write(param.FillBytes(make([]byte, coordinateSize)))
+	}
+
+	h.Write(pk.data[1:])
+	return h.Sum(nil), nil
+}
+
+// Verify verifies data with sign and the za digest of id.
+func (pk PublicKey) Verify(data []byte, sign []byte, opts ...Option) error {
+	conf := newConfig().Apply(opts...)
+
+	sign, err := conf.encoding.Decode(sign)
+	if err != nil {
+		return err
+	}
+
+	var sig signature
+	rest, err := asn1.Unmarshal(sign, &sig)
+	if err != nil || len(rest) > 0 {
+		return errVerifyFailed
+	}
+
+	r, s := sig.R, sig.S
+	if r.Sign() <= 0 || r.Cmp(curveN) >= 0 || s.Sign() <= 0 || s.Cmp(curveN) >= 0 {
+		return errVerifyFailed
+	}
+
+	t := new(big.Int).Add(r, s)
+	t.Mod(t, curveN)
+
+	if t.Sign() == 0 {
+		return errVerifyFailed
+	}
+
+	za, err := pk.za(conf.id)
+	if err != nil {
+		return err
+	}
+
+	e := hashToInt(za, data)
+
+	sg := new(point).scalarBaseMult(s.FillBytes(make([]byte, coordinateSize)))
+	tp := new(point).scalarMult(pk.point, t.FillBytes(make([]byte, coordinateSize)))
+	x1, _ := sg.add(sg, tp).affine()
+
+	expect := new(big.Int).SetBytes(x1)
+	expect.Add(expect, e)
+	expect.Mod(expect, curveN)
+
+	if expect.Cmp(r) != 0 {
+		return errVerifyFailed
+	}
+
+	return nil
+}
+
+// Encrypt encrypts data with the public key.
+// The order of ciphertext is c1c3c2 by default.
+func (pk PublicKey) Encrypt(data []byte, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	for {
+		_, kBytes, err := randScalar(conf.random)
+		if err != nil {
+			return nil, err
+		}
+
+		c1 := new(point).scalarBaseMult(kBytes).bytes()
+		x2, y2 := new(point).scalarMult(pk.point, kBytes).affine()
+
+		c2 := kdf(len(data), x2, y2)
+		if len(data) > 0 && isAllZero(c2) {
+			continue
+		}
+
+		subtle.XORBytes(c2, c2, data)
+		c3 := sm3Sum(x2, data, y2)
+
+		encrypted := make([]byte, 0, len(c1)+len(c2)+len(c3))
+		encrypted = append(encrypted, c1...)
+
+		if conf.order == orderC1C2C3 {
+			encrypted = append(encrypted, c2...)
+			encrypted = append(encrypted, c3...)
+		} else {
+			encrypted = append(encrypted, c3...)
+			encrypted = append(encrypted, c2...)
+		}
+
+		encrypted = conf.encoding.Encode(encrypted)
+		return encrypted, nil
+	}
+}
+
+type signature struct {
+	R *big.Int
+	S *big.Int
+}
+
+// randScalar reads a random scalar in [1, n-1] from random.
+func randScalar(random io.Reader) (*scalar, []byte, error) {
+	buffer := make([]byte, coordinateSize)
+
+	for {
+		if _, err := io.ReadFull(random, buffer); err != nil {
+			return nil, nil, err
+		}
+
+		k, err := new(scalar).setBytes(buffer)
+		if err == nil && k.isZero() == 0 {
+			return k, buffer, nil
+		}
+	}
+}
+
+// hashToInt returns the sm3 digest of za and data as an integer.
+func hashToInt(za []byte, data []byte) *big.Int {
+	digest := sm3Sum(za, data)
+	return new(big.Int).SetBytes(digest)
+}
+
+func sm3Sum(data ...[]byte) []byte {
+	h := cxhash.NewSM3()
+	for _, d := range data {
+		h.Write(d)
+	}
+
+	return h.Sum(nil)
+}
+
+// kdf derives a key of length from data using sm3 in gb/t 32918.
+func kdf(length int, data ...[]byte) []byte {
+	key := make([]byte, 0, length+digestSize)
+
+	var counter [4]byte
+	for ct := uint32(1); len(key) < length; ct++ {
+		binary.BigEndian.PutUint32(counter[:], ct)
+
+		h := cxhash.NewSM3()
+		for _, d := range data {
+			h.Write(d)
+		}
+
+		h.Write(counter[:])
+		key = h.Sum(key)
+	}
+
+	return key[:length]
+}
+
+func isAllZero(data []byte) bool {
+	var b byte
+	for _, d := range data {
+		b |= d
+	}
+
+	return b == 0
+}
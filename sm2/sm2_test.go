@@ -0,0 +1,249 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm2
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+// The example in gm/t 0003.5 which uses the recommended curve.
+var (
+	testPrivateKey = "3945208F7B2144B13F36E38AC6D39F95889393692860B51A42FB81EF4DF7C5B8"
+	testPublicKey  = "0409F9DF311E5421A150DD7D161E4BC5C672179FAD1833FC076BB08FF356F35020CCEA490CE26775A52DC6EA718CC1AA600AED05FBF35E084A6632F6072DA9AD13"
+	testK          = "59276E27D506861A16680F3AD9C02DCCEF3CC1FA3CDBE4CE6D54B80DEAC1BC21"
+)
+
+func newTestKeys(t *testing.T) (PrivateKey, PublicKey) {
+	data, err := hex.DecodeString(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := NewPrivateKey(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey := privateKey.PublicKey()
+
+	got := fmt.Sprintf("%X", publicKey.Bytes())
+	if got != testPublicKey {
+		t.Fatalf("got %s != expect %s", got, testPublicKey)
+	}
+
+	return privateKey, publicKey
+}
+
+func newTestRandom(t *testing.T) *bytes.Reader {
+	k, err := hex.DecodeString(testK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bytes.NewReader(k)
+}
+
+// go test -v -cover -run=^TestNewPrivateKey$
+func TestNewPrivateKey(t *testing.T) {
+	newTestKeys(t)
+
+	n := curveN.FillBytes(make([]byte, coordinateSize))
+	nMinus1 := new(big.Int).Sub(curveN, big.NewInt(1)).FillBytes(make([]byte, coordinateSize))
+
+	for _, data := range [][]byte{nil, make([]byte, 31), make([]byte, coordinateSize), n, nMinus1} {
+		if _, err := NewPrivateKey(data); err == nil {
+			t.Fatalf("data %x: err == nil", data)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSign$
+func TestSign(t *testing.T) {
+	privateKey, publicKey := newTestKeys(t)
+	data := []byte("message digest")
+
+	sign, err := privateKey.Sign(data, WithRandom(newTestRandom(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sig signature
+	if _, err = asn1.Unmarshal(sign, &sig); err != nil {
+		t.Fatal(err)
+	}
+
+	got := fmt.Sprintf("%X%X", sig.R, sig.S)
+	expect := "F5A03B0648D2C4630EEAC513E1BB81A15944DA3827D5B74143AC7EACEEE720B3B1B6AA29DF212FD8763182BC0D421CA1BB9038FD1F7F42D4840B69C485BBC1AA"
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	if err = publicKey.Verify(data, sign); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = publicKey.Verify([]byte("message digesT"), sign); err != errVerifyFailed {
+		t.Fatalf("got %v != expect %v", err, errVerifyFailed)
+	}
+
+	if err = publicKey.Verify(data, sign, WithID([]byte("ALICE123@YAHOO.COM"))); err != errVerifyFailed {
+		t.Fatalf("got %v != expect %v", err, errVerifyFailed)
+	}
+
+	if err = publicKey.Verify(data, sign[:len(sign)-1]); err != errVerifyFailed {
+		t.Fatalf("got %v != expect %v", err, errVerifyFailed)
+	}
+}
+
+// go test -v -cover -run=^TestSignWithOptions$
+func TestSignWithOptions(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("你好，世界")
+	id := []byte("ALICE123@YAHOO.COM")
+
+	for _, opt := range []Option{WithHex(), WithBase64()} {
+		sign, err := privateKey.Sign(data, opt, WithID(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = publicKey.Verify(data, sign, opt, WithID(id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The openssl signature of "message digest" with the default id.
+	sign, _ := hex.DecodeString("3045022052861bfe7b9e7687f49cd5d30cda9c13cf6367cbcdc7b6ff55d70a298ec6b685022100ebac8a654d649edb27fe76ffe8960edde1c88b3e3fbaca4bbbac245047fbd1b9")
+
+	openSSLKey, err := ReadPublicKey(bytes.NewBufferString(testOpenSSLPublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = openSSLKey.Verify([]byte("message digest"), sign); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestEncrypt$
+func TestEncrypt(t *testing.T) {
+	privateKey, publicKey := newTestKeys(t)
+	data := []byte("encryption standard")
+
+	c1 := "0404EBFC718E8D1798620432268E77FEB6415E2EDE0E073C0F4F640ECD2E149A73E858F9D81E5430A57B36DAAB8F950A3C64E6EE6A63094D99283AFF767E124DF0"
+	c2 := "21886CA989CA9C7D58087307CA93092D651EFA"
+	c3 := "59983C18F809E262923C53AEC295D30383B54E39D609D160AFCB1908D0BD8766"
+
+	testCases := []struct {
+		opt    Option
+		expect string
+	}{
+		{opt: WithC1C3C2(), expect: c1 + c3 + c2},
+		{opt: WithC1C2C3(), expect: c1 + c2 + c3},
+	}
+
+	for _, testCase := range testCases {
+		encrypted, err := publicKey.Encrypt(data, testCase.opt, WithRandom(newTestRandom(t)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := fmt.Sprintf("%X", encrypted)
+		if got != testCase.expect {
+			t.Fatalf("got %s != expect %s", got, testCase.expect)
+		}
+
+		decrypted, err := privateKey.Decrypt(encrypted, testCase.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(decrypted, data) {
+			t.Fatalf("got %s != expect %s", decrypted, data)
+		}
+
+		encrypted[len(encrypted)-1] ^= 1
+
+		if _, err = privateKey.Decrypt(encrypted, testCase.opt); err != errDecryptFailed {
+			t.Fatalf("got %v != expect %v", err, errDecryptFailed)
+		}
+	}
+
+	if _, err := privateKey.Decrypt(make([]byte, pointSize)); err != errDecryptFailed {
+		t.Fatalf("got %v != expect %v", err, errDecryptFailed)
+	}
+}
+
+// go test -v -cover -run=^TestEncryptWithOptions$
+func TestEncryptWithOptions(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, data := range [][]byte{nil, []byte("123"), bytes.Repeat([]byte("你好，世界"), 100)} {
+		for _, opt := range []Option{WithHex(), WithBase64()} {
+			encrypted, err := publicKey.Encrypt(data, opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			decrypted, err := privateKey.Decrypt(encrypted, opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(decrypted, data) {
+				t.Fatalf("got %s != expect %s", decrypted, data)
+			}
+		}
+	}
+
+	// The openssl ciphertext of "message digest" which is converted from asn.1 to c1c3c2.
+	encrypted, _ := hex.DecodeString("04E7A6E45CF80350BF358F81E8FC587096CCF2A37F65042EC6FD8E08E7FCEE7F30BB4493852498566C75D25803658B1B7FDC0692DB2F6779772532CD5BC469C4A10C0AA3328CE53381A0491124D2CB9B14F02B8507D6811DDD30E54472DB04937D53187EEDA0CC4B2B922E4E5CB899")
+
+	openSSLKey, err := ReadPrivateKey(bytes.NewBufferString(testOpenSSLPrivateKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decrypted, err := openSSLKey.Decrypt(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(decrypted) != "message digest" {
+		t.Fatalf("got %s != expect %s", decrypted, "message digest")
+	}
+}
+
+// go test -v -cover -run=^TestKDF$
+func TestKDF(t *testing.T) {
+	data := []byte("kdf")
+
+	key := kdf(100, data)
+	if len(key) != 100 {
+		t.Fatalf("len(key) %d != 100", len(key))
+	}
+
+	short := kdf(10, data)
+	if !bytes.Equal(short, key[:10]) {
+		t.Fatalf("got %x != expect %x", short, key[:10])
+	}
+
+	expect := sm3Sum(data, []byte{0, 0, 0, 1})
+	if !bytes.Equal(key[:digestSize], expect) {
+		t.Fatalf("got %x != expect %x", key[:digestSize], expect)
+	}
+}
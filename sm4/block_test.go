@@ -0,0 +1,85 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm4
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// go test -v -cover -run=^TestNewCipher$
+func TestNewCipher(t *testing.T) {
+	for _, size := range []int{0, 8, 15, 17, 24, 32} {
+		if _, err := NewCipher(make([]byte, size)); err == nil {
+			t.Fatalf("key size %d: err == nil", size)
+		}
+	}
+
+	block, err := NewCipher(make([]byte, KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if block.BlockSize() != BlockSize {
+		t.Fatalf("got %d != expect %d", block.BlockSize(), BlockSize)
+	}
+}
+
+// go test -v -cover -run=^TestSBox$
+func TestSBox(t *testing.T) {
+	var seen [256]bool
+	for _, b := range sm4SBox {
+		if seen[b] {
+			t.Fatalf("sbox value %#x is duplicated", b)
+		}
+
+		seen[b] = true
+	}
+}
+
+// go test -v -cover -run=^TestGBT32907$
+func TestGBT32907(t *testing.T) {
+	key, _ := hex.DecodeString("0123456789abcdeffedcba9876543210")
+
+	block, err := NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		rounds int
+		expect string
+	}{
+		{rounds: 1, expect: "681edf34d206965e86b3e94f536e4246"},
+		{rounds: 1000000, expect: "595298c7c6fd271f0402f804c33d3f66"},
+	}
+
+	for _, testCase := range testCases {
+		if testing.Short() && testCase.rounds > 1 {
+			continue
+		}
+
+		data := make([]byte, BlockSize)
+		copy(data, key)
+
+		for i := 0; i < testCase.rounds; i++ {
+			block.Encrypt(data, data)
+		}
+
+		got := hex.EncodeToString(data)
+		if got != testCase.expect {
+			t.Fatalf("rounds %d: got %s != expect %s", testCase.rounds, got, testCase.expect)
+		}
+
+		for i := 0; i < testCase.rounds; i++ {
+			block.Decrypt(data, data)
+		}
+
+		got = hex.EncodeToString(data)
+		if got != hex.EncodeToString(key) {
+			t.Fatalf("rounds %d: got %s != expect %x", testCase.rounds, got, key)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm4
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ccmTagSize is the tag size used by the sm4 ccm suites in tls 1.3.
+const ccmTagSize = 16
+
+var errCCMOpen = errors.New("cryptox/sm4: ccm message authentication failed")
+
+// ccm implements the counter with cbc-mac mode described in nist sp 800-38c and rfc 3610.
+type ccm struct {
+	block     cipher.Block
+	nonceSize int
+	tagSize   int
+}
+
+// newCCM returns a ccm aead of block which must have a 16 bytes block size.
+// The nonce size must be 7 to 13 and the tag size must be an even number in 4 to 16.
+func newCCM(block cipher.Block, nonceSize int, tagSize int) (cipher.AEAD, error) {
+	if block.BlockSize() != BlockSize {
+		return nil, fmt.Errorf("cryptox/sm4: ccm block size %d != %d", block.BlockSize(), BlockSize)
+	}
+
+	if nonceSize < 7 || nonceSize > 13 {
+		return nil, fmt.Errorf("cryptox/sm4: ccm invalid nonce size %d", nonceSize)
+	}
+
+	if tagSize < 4 || tagSize > 16 || tagSize%2 != 0 {
+		return nil, fmt.Errorf("cryptox/sm4: ccm invalid tag size %d", tagSize)
+	}
+
+	c := &ccm{
+		block:     block,
+		nonceSize: nonceSize,
+		tagSize:   tagSize,
+	}
+
+	return c, nil
+}
+
+func (c *ccm) NonceSize() int {
+	return c.nonceSize
+}
+
+func (c *ccm) Overhead() int {
+	return c.tagSize
+}
+
+// maxLength returns the max length of plaintext which can be encoded in the length field.
+func (c *ccm) maxLength() uint64 {
+	lengthSize := 15 - c.nonceSize
+	if lengthSize >= 8 {
+		return math.MaxUint64
+	}
+
+	return 1<<(8*lengthSize) - 1
+}
+
+// counter returns the counter block with index 0.
+func (c *ccm) counter(nonce []byte) []byte {
+	counter := make([]byte, BlockSize)
+	counter[0] = byte(14 - c.nonceSize)
+	copy(counter[1:], nonce)
+	return counter
+}
+
+// mac computes the cbc-mac of nonce, additional and plaintext.
+func (c *ccm) mac(nonce []byte, plaintext []byte, additional []byte) []byte {
+	lengthSize := 15 - c.nonceSize
+
+	var b0 [BlockSize]byte
+	b0[0] = byte((c.tagSize-2)/2<<3 | (lengthSize - 1))
+	if len(additional) > 0 {
+		b0[0] |= 1 << 6
+	}
+
+	copy(b0[1:], nonce)
+
+	var length [8]byte
+	binary.BigEndian.PutUint64(length[:], uint64(len(plaintext)))
+	copy(b0[1+c.nonceSize:], length[8-lengthSize:])
+
+	mac := make([]byte, BlockSize)
+	c.block.Encrypt(mac, b0[:])
+
+	if len(additional) > 0 {
+		var header []byte
+
+		n := uint64(len(additional))
+		switch {
+		case n < 0xff00:
+			header = binary.BigEndian.AppendUint16(header, uint16(n))
+		case n <= math.MaxUint32:
+			header = append(header, 0xff, 0xfe)
+			header = binary.BigEndian.AppendUint32(header, uint32(n))
+		default:
+			header = append(header, 0xff, 0xff)
+			header = binary.BigEndian.AppendUint64(header, n)
+		}
+
+		header = append(header, additional...)
+		c.macBlocks(mac, header)
+	}
+
+	c.macBlocks(mac, plaintext)
+	return mac
+}
+
+// macBlocks xors data padded with zeros into mac block by block.
+func (c *ccm) macBlocks(mac []byte, data []byte) {
+	for len(data) > 0 {
+		n := min(len(data), BlockSize)
+		subtle.XORBytes(mac[:n], mac[:n], data[:n])
+		c.block.Encrypt(mac, mac)
+
+		data = data[n:]
+	}
+}
+
+// crypt xors the key stream starting at counter 1 into src and returns the tag mask of counter 0.
+func (c *ccm) crypt(dst []byte, src []byte, nonce []byte) []byte {
+	counter := c.counter(nonce)
+
+	mask := make([]byte, BlockSize)
+	c.block.Encrypt(mask, counter)
+
+	counter[BlockSize-1] = 1
+	cipher.NewCTR(c.block, counter).XORKeyStream(dst, src)
+	return mask
+}
+
+func (c *ccm) Seal(dst []byte, nonce []byte, plaintext []byte, additional []byte) []byte {
+	if len(nonce) != c.nonceSize {
+		panic("cryptox/sm4: ccm incorrect nonce length")
+	}
+
+	if uint64(len(plaintext)) > c.maxLength() {
+		panic("cryptox/sm4: ccm plaintext too large")
+	}
+
+	tag := c.mac(nonce, plaintext, additional)
+
+	ret, out := sliceForAppend(dst, len(plaintext)+c.tagSize)
+	mask := c.crypt(out, plaintext, nonce)
+
+	subtle.XORBytes(out[len(plaintext):], tag[:c.tagSize], mask[:c.tagSize])
+	return ret
+}
+
+func (c *ccm) Open(dst []byte, nonce []byte, ciphertext []byte, additional []byte) ([]byte, error) {
+	if len(nonce) != c.nonceSize {
+		panic("cryptox/sm4: ccm incorrect nonce length")
+	}
+
+	if len(ciphertext) < c.tagSize {
+		return nil, errCCMOpen
+	}
+
+	if uint64(len(ciphertext)-c.tagSize) > c.maxLength() {
+		return nil, errCCMOpen
+	}
+
+	tag := ciphertext[len(ciphertext)-c.tagSize:]
+	ciphertext = ciphertext[:len(ciphertext)-c.tagSize]
+
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	mask := c.crypt(out, ciphertext, nonce)
+
+	expectTag := c.mac(nonce, out, additional)
+	subtle.XORBytes(expectTag[:c.tagSize], expectTag[:c.tagSize], mask[:c.tagSize])
+
+	if subtle.ConstantTimeCompare(expectTag[:c.tagSize], tag) != 1 {
+		clear(out)
+		return nil, errCCMOpen
+	}
+
+	return ret, nil
+}
+
+// sliceForAppend extends in by n bytes and returns the whole slice and the extended part.
+func sliceForAppend(in []byte, n int) (head []byte, tail []byte) {
+	if total := len(in) + n; cap(in) >= total {
+		head = in[:total]
+	} else {
+		head = make([]byte, total)
+		copy(head, in)
+	}
+
+	tail = head[len(in):]
+	return head, tail
+}
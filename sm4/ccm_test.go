@@ -0,0 +1,101 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm4
+
+import (
+	"crypto/aes"
+	"encoding/hex"
+	"testing"
+)
+
+// go test -v -cover -run=^TestCCMRFC3610$
+func TestCCMRFC3610(t *testing.T) {
+	key, _ := hex.DecodeString("c0c1c2c3c4c5c6c7c8c9cacbcccdcecf")
+	nonce, _ := hex.DecodeString("00000003020100a0a1a2a3a4a5")
+	additional, _ := hex.DecodeString("0001020304050607")
+	data, _ := hex.DecodeString("08090a0b0c0d0e0f101112131415161718191a1b1c1d1e")
+	expect := "588c979a61c663d2f066d0c2c0f989806d5f6b61dac38417e8d12cfdf926e0"
+
+	// The mode is independent of the block cipher so we check it with aes vectors.
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ccm, err := newCCM(block, len(nonce), 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypted := ccm.Seal(nil, nonce, data, additional)
+
+	got := hex.EncodeToString(encrypted)
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+
+	decrypted, err := ccm.Open(nil, nonce, encrypted, additional)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hex.EncodeToString(decrypted) != hex.EncodeToString(data) {
+		t.Fatalf("got %x != expect %x", decrypted, data)
+	}
+
+	for i := range encrypted {
+		tampered := append([]byte(nil), encrypted...)
+		tampered[i] ^= 1
+
+		if _, err = ccm.Open(nil, nonce, tampered, additional); err != errCCMOpen {
+			t.Fatalf("tampered %d: got %v != expect %v", i, err, errCCMOpen)
+		}
+	}
+
+	if _, err = ccm.Open(nil, nonce, encrypted[:7], additional); err != errCCMOpen {
+		t.Fatalf("got %v != expect %v", err, errCCMOpen)
+	}
+}
+
+// go test -v -cover -run=^TestNewCCM$
+func TestNewCCM(t *testing.T) {
+	block, err := NewCipher(make([]byte, KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		nonceSize int
+		tagSize   int
+		valid     bool
+	}{
+		{nonceSize: 12, tagSize: 16, valid: true},
+		{nonceSize: 7, tagSize: 4, valid: true},
+		{nonceSize: 13, tagSize: 8, valid: true},
+		{nonceSize: 6, tagSize: 16, valid: false},
+		{nonceSize: 14, tagSize: 16, valid: false},
+		{nonceSize: 12, tagSize: 5, valid: false},
+		{nonceSize: 12, tagSize: 18, valid: false},
+	}
+
+	for _, testCase := range testCases {
+		ccm, err := newCCM(block, testCase.nonceSize, testCase.tagSize)
+		if (err == nil) != testCase.valid {
+			t.Fatalf("nonce size %d tag size %d: got err %v", testCase.nonceSize, testCase.tagSize, err)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		if ccm.NonceSize() != testCase.nonceSize {
+			t.Fatalf("got %d != expect %d", ccm.NonceSize(), testCase.nonceSize)
+		}
+
+		if ccm.Overhead() != testCase.tagSize {
+			t.Fatalf("got %d != expect %d", ccm.Overhead(), testCase.tagSize)
+		}
+	}
+}
@@ -0,0 +1,427 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package sm4
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+var (
+	testKey = []byte("1234567887654321")
+	testIV  = []byte("8765432112345678")
+)
+
+type testCase struct {
+	Data              []byte
+	EncryptData       []byte
+	EncryptDataHex    []byte
+	EncryptDataBase64 []byte
+}
+
+type testEncryptFunc func(data []byte, opts ...Option) ([]byte, error)
+
+type testDecryptFunc func(data []byte, opts ...Option) ([]byte, error)
+
+func testEncryptAndDecrypt(name string, encrypt testEncryptFunc, decrypt testDecryptFunc, testCases []testCase) error {
+	for _, testCase := range testCases {
+		// None
+		encrypted, err := encrypt(testCase.Data)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(encrypted, testCase.EncryptData) {
+			return fmt.Errorf("%s data %q: got %+v != expect %+v", name, testCase.Data, encrypted, testCase.EncryptData)
+		}
+
+		decrypted, err := decrypt(encrypted)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(decrypted, testCase.Data) {
+			return fmt.Errorf("%s encrypted %q: got %+v != expect %+v", name, encrypted, decrypted, testCase.Data)
+		}
+
+		// Hex
+		encrypted, err = encrypt(testCase.Data, WithHex())
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(encrypted, testCase.EncryptDataHex) {
+			return fmt.Errorf("%s data hex %q: got %s != expect %s", name, testCase.Data, encrypted, testCase.EncryptDataHex)
+		}
+
+		decrypted, err = decrypt(encrypted, WithHex())
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(decrypted, testCase.Data) {
+			return fmt.Errorf("%s encrypted hex %q: got %s != expect %s", name, encrypted, decrypted, testCase.Data)
+		}
+
+		// Base64
+		encrypted, err = encrypt(testCase.Data, WithBase64())
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(encrypted, testCase.EncryptDataBase64) {
+			return fmt.Errorf("%s data base64 %q: got %s != expect %s", name, testCase.Data, encrypted, testCase.EncryptDataBase64)
+		}
+
+		decrypted, err = decrypt(encrypted, WithBase64())
+		if err != nil {
+			return err
+		}
+
+		if !slices.Equal(decrypted, testCase.Data) {
+			return fmt.Errorf("%s encrypted base64 %q: got %s != expect %s", name, encrypted, decrypted, testCase.Data)
+		}
+	}
+
+	return nil
+}
+
+// go test -v -cover -run=^TestNewBlock$
+func TestNewBlock(t *testing.T) {
+	block, blockSize, err := newBlock(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if block == nil {
+		t.Fatal("block == nil")
+	}
+
+	if blockSize != block.BlockSize() {
+		t.Fatalf("blockSize %d != block.BlockSize() %d", blockSize, block.BlockSize())
+	}
+
+	wantBlock, err := NewCipher(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if blockSize != wantBlock.BlockSize() {
+		t.Fatalf("blockSize %d != wantBlock.BlockSize() %d", blockSize, wantBlock.BlockSize())
+	}
+}
+
+// go test -v -cover -run=^TestECB$
+func TestECB(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{124, 58, 143, 248, 88, 253, 173, 202, 14, 68, 124, 175, 31, 95, 203, 147},
+			EncryptDataHex:    []byte("7c3a8ff858fdadca0e447caf1f5fcb93"),
+			EncryptDataBase64: []byte("fDqP+Fj9rcoORHyvH1/Lkw=="),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{149, 153, 156, 43, 108, 169, 164, 105, 230, 32, 60, 237, 235, 162, 222, 175},
+			EncryptDataHex:    []byte("95999c2b6ca9a469e6203cedeba2deaf"),
+			EncryptDataBase64: []byte("lZmcK2yppGnmIDzt66Lerw=="),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{150, 235, 54, 249, 37, 10, 71, 27, 169, 76, 43, 223, 248, 182, 92, 80},
+			EncryptDataHex:    []byte("96eb36f9250a471ba94c2bdff8b65c50"),
+			EncryptDataBase64: []byte("lus2+SUKRxupTCvf+LZcUA=="),
+		},
+	}
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithPKCS7())
+		return EncryptECB(data, testKey, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithPKCS7())
+		return DecryptECB(data, testKey, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestCBC$
+func TestCBC(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{31, 117, 181, 217, 153, 192, 202, 70, 109, 25, 220, 235, 53, 56, 115, 29},
+			EncryptDataHex:    []byte("1f75b5d999c0ca466d19dceb3538731d"),
+			EncryptDataBase64: []byte("H3W12ZnAykZtGdzrNThzHQ=="),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{19, 86, 22, 181, 124, 229, 79, 218, 241, 76, 169, 101, 225, 163, 150, 122},
+			EncryptDataHex:    []byte("135616b57ce54fdaf14ca965e1a3967a"),
+			EncryptDataBase64: []byte("E1YWtXzlT9rxTKll4aOWeg=="),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{89, 98, 87, 200, 162, 184, 59, 72, 44, 67, 95, 105, 203, 161, 30, 236},
+			EncryptDataHex:    []byte("596257c8a2b83b482c435f69cba11eec"),
+			EncryptDataBase64: []byte("WWJXyKK4O0gsQ19py6Ee7A=="),
+		},
+	}
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithPKCS7())
+		return EncryptCBC(data, testKey, testIV, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithPKCS7())
+		return DecryptCBC(data, testKey, testIV, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestCFB$
+func TestCFB(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{},
+			EncryptDataHex:    []byte(""),
+			EncryptDataBase64: []byte(""),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{42, 4, 150},
+			EncryptDataHex:    []byte("2a0496"),
+			EncryptDataBase64: []byte("KgSW"),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{255, 139, 5, 120, 157, 213, 34, 97, 138, 140, 233, 220, 206, 195, 222},
+			EncryptDataHex:    []byte("ff8b05789dd522618a8ce9dccec3de"),
+			EncryptDataBase64: []byte("/4sFeJ3VImGKjOnczsPe"),
+		},
+	}
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		return EncryptCFB(data, testKey, testIV, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptCFB(data, testKey, testIV, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestOFB$
+func TestOFB(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{},
+			EncryptDataHex:    []byte(""),
+			EncryptDataBase64: []byte(""),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{42, 4, 150},
+			EncryptDataHex:    []byte("2a0496"),
+			EncryptDataBase64: []byte("KgSW"),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{255, 139, 5, 120, 157, 213, 34, 97, 138, 140, 233, 220, 206, 195, 222},
+			EncryptDataHex:    []byte("ff8b05789dd522618a8ce9dccec3de"),
+			EncryptDataBase64: []byte("/4sFeJ3VImGKjOnczsPe"),
+		},
+	}
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		return EncryptOFB(data, testKey, testIV, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptOFB(data, testKey, testIV, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestCTR$
+func TestCTR(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{},
+			EncryptDataHex:    []byte(""),
+			EncryptDataBase64: []byte(""),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{42, 4, 150},
+			EncryptDataHex:    []byte("2a0496"),
+			EncryptDataBase64: []byte("KgSW"),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{255, 139, 5, 120, 157, 213, 34, 97, 138, 140, 233, 220, 206, 195, 222},
+			EncryptDataHex:    []byte("ff8b05789dd522618a8ce9dccec3de"),
+			EncryptDataBase64: []byte("/4sFeJ3VImGKjOnczsPe"),
+		},
+	}
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		return EncryptCTR(data, testKey, testIV, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		return DecryptCTR(data, testKey, testIV, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestGCM$
+func TestGCM(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{143, 149, 229, 201, 208, 18, 25, 160, 32, 176, 128, 100, 214, 29, 114, 84},
+			EncryptDataHex:    []byte("8f95e5c9d01219a020b08064d61d7254"),
+			EncryptDataBase64: []byte("j5XlydASGaAgsIBk1h1yVA=="),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{95, 8, 14, 254, 114, 200, 241, 17, 149, 189, 105, 160, 243, 17, 70, 149, 44, 241, 155},
+			EncryptDataHex:    []byte("5f080efe72c8f11195bd69a0f31146952cf19b"),
+			EncryptDataBase64: []byte("XwgO/nLI8RGVvWmg8xFGlSzxmw=="),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{138, 135, 157, 221, 250, 174, 10, 116, 183, 223, 220, 16, 53, 255, 199, 55, 111, 194, 1, 149, 76, 93, 43, 22, 187, 83, 148, 54, 186, 249, 183},
+			EncryptDataHex:    []byte("8a879dddfaae0a74b7dfdc1035ffc7376fc201954c5d2b16bb539436baf9b7"),
+			EncryptDataBase64: []byte("ioed3fquCnS339wQNf/HN2/CAZVMXSsWu1OUNrr5tw=="),
+		},
+	}
+
+	nonce := []byte("123456abcdef")
+	additional := []byte("8765432112345678")
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithAdditional(additional))
+		return EncryptGCM(data, testKey, nonce, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithAdditional(additional))
+		return DecryptGCM(data, testKey, nonce, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestCCM$
+func TestCCM(t *testing.T) {
+	testCases := []testCase{
+		{
+			Data:              []byte(""),
+			EncryptData:       []byte{119, 38, 41, 195, 188, 85, 238, 209, 102, 181, 32, 101, 47, 46, 151, 88},
+			EncryptDataHex:    []byte("772629c3bc55eed166b520652f2e9758"),
+			EncryptDataBase64: []byte("dyYpw7xV7tFmtSBlLy6XWA=="),
+		},
+		{
+			Data:              []byte("123"),
+			EncryptData:       []byte{84, 166, 195, 211, 253, 175, 155, 103, 104, 34, 65, 233, 127, 24, 119, 111, 31, 254, 21},
+			EncryptDataHex:    []byte("54a6c3d3fdaf9b67682241e97f18776f1ffe15"),
+			EncryptDataBase64: []byte("VKbD0/2vm2doIkHpfxh3bx/+FQ=="),
+		},
+		{
+			Data:              []byte("你好，世界"),
+			EncryptData:       []byte{129, 41, 80, 119, 113, 147, 6, 6, 95, 13, 132, 78, 149, 156, 108, 41, 4, 76, 184, 186, 65, 130, 42, 112, 243, 202, 196, 171, 150, 146, 57},
+			EncryptDataHex:    []byte("81295077719306065f0d844e959c6c29044cb8ba41822a70f3cac4ab969239"),
+			EncryptDataBase64: []byte("gSlQd3GTBgZfDYROlZxsKQRMuLpBgipw88rEq5aSOQ=="),
+		},
+	}
+
+	nonce := []byte("123456abcdef")
+	additional := []byte("8765432112345678")
+
+	encrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithAdditional(additional))
+		return EncryptCCM(data, testKey, nonce, opts...)
+	}
+
+	decrypt := func(data []byte, opts ...Option) ([]byte, error) {
+		opts = append(opts, WithAdditional(additional))
+		return DecryptCCM(data, testKey, nonce, opts...)
+	}
+
+	if err := testEncryptAndDecrypt(t.Name(), encrypt, decrypt, testCases); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// go test -v -cover -run=^TestRFC8998$
+func TestRFC8998(t *testing.T) {
+	key, _ := hex.DecodeString("0123456789ABCDEFFEDCBA9876543210")
+	nonce, _ := hex.DecodeString("00001234567800000000ABCD")
+	additional, _ := hex.DecodeString("FEEDFACEDEADBEEFFEEDFACEDEADBEEFABADDAD2")
+	data, _ := hex.DecodeString("AAAAAAAAAAAAAAAABBBBBBBBBBBBBBBBCCCCCCCCCCCCCCCCDDDDDDDDDDDDDDDDEEEEEEEEEEEEEEEEFFFFFFFFFFFFFFFFEEEEEEEEEEEEEEEEAAAAAAAAAAAAAAAA")
+
+	testCases := map[string]struct {
+		encrypt func(data []byte, key []byte, nonce []byte, opts ...Option) ([]byte, error)
+		decrypt func(data []byte, key []byte, nonce []byte, opts ...Option) ([]byte, error)
+		expect  string
+	}{
+		"gcm": {
+			encrypt: EncryptGCM,
+			decrypt: DecryptGCM,
+			expect:  "17f399f08c67d5ee19d0dc9969c4bb7d5fd46fd3756489069157b282bb200735d82710ca5c22f0ccfa7cbf93d496ac15a56834cbcf98c397b4024a2691233b8d83de3541e4c2b58177e065a9bf7b62ec",
+		},
+		"ccm": {
+			encrypt: EncryptCCM,
+			decrypt: DecryptCCM,
+			expect:  "48af93501fa62adbcd414cce6034d895dda1bf8f132f042098661572e7483094fd12e518ce062c98acee28d95df4416bed31a2f04476c18bb40c84a74b97dc5b16842d4fa186f56ab33256971fa110f4",
+		},
+	}
+
+	for name, testCase := range testCases {
+		encrypted, err := testCase.encrypt(data, key, nonce, WithHex(), WithAdditional(additional))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(encrypted) != testCase.expect {
+			t.Fatalf("%s: got %s != expect %s", name, encrypted, testCase.expect)
+		}
+
+		decrypted, err := testCase.decrypt(encrypted, key, nonce, WithHex(), WithAdditional(additional))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !slices.Equal(decrypted, data) {
+			t.Fatalf("%s: got %x != expect %x", name, decrypted, data)
+		}
+	}
+}
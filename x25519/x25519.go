@@ -0,0 +1,137 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package x25519
+
+import (
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/subtle"
+	"errors"
+)
+
+// KeySize is the size of x25519 private key, public key and shared secret.
+const KeySize = 32
+
+var (
+	errNotX25519        = errors.New("cryptox/x25519: key is not x25519")
+	errLowOrderPoint    = errors.New("cryptox/x25519: low order point")
+	errInvalidPublicKey = errors.New("cryptox/x25519: invalid public key")
+)
+
+// lowOrderPoints are the encodings of points whose order divides 8 and their non-canonical forms.
+// A shared secret with these points is predictable by anyone so they must be rejected.
+var lowOrderPoints = [][KeySize]byte{
+	{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	{0xe0, 0xeb, 0x7a, 0x7c, 0x3b, 0x41, 0xb8, 0xae, 0x16, 0x56, 0xe3, 0xfa, 0xf1, 0x9f, 0xc4, 0x6a, 0xda, 0x09, 0x8d, 0xeb, 0x9c, 0x32, 0xb1, 0xfd, 0x86, 0x62, 0x05, 0x16, 0x5f, 0x49, 0xb8, 0x00},
+	{0x5f, 0x9c, 0x95, 0xbc, 0xa3, 0x50, 0x8c, 0x24, 0xb1, 0xd0, 0xb1, 0x55, 0x9c, 0x83, 0xef, 0x5b, 0x04, 0x44, 0x5c, 0xc4, 0x58, 0x1c, 0x8e, 0x86, 0xd8, 0x22, 0x4e, 0xdd, 0xd0, 0x9f, 0x11, 0x57},
+	{0xec, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+	{0xed, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+	{0xee, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f},
+}
+
+// isLowOrderPoint returns if the public key is a low order point.
+// The highest bit is ignored just like x25519 does.
+func isLowOrderPoint(publicKey []byte) bool {
+	var masked [KeySize]byte
+	copy(masked[:], publicKey)
+	masked[KeySize-1] &= 0x7f
+
+	found := 0
+	for _, point := range lowOrderPoints {
+		found |= subtle.ConstantTimeCompare(masked[:], point[:])
+	}
+
+	return found == 1
+}
+
+type PrivateKey struct {
+	key *ecdh.PrivateKey
+}
+
+// NewPrivateKey returns a private key of the 32 bytes.
+func NewPrivateKey(data []byte) (PrivateKey, error) {
+	key, err := ecdh.X25519().NewPrivateKey(data)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	privateKey := PrivateKey{key: key}
+	return privateKey, nil
+}
+
+// Bytes returns the private key in 32 bytes.
+func (pk PrivateKey) Bytes() []byte {
+	return pk.key.Bytes()
+}
+
+// PublicKey returns the public key of the private key.
+func (pk PrivateKey) PublicKey() PublicKey {
+	return PublicKey{key: pk.key.PublicKey()}
+}
+
+// Equal returns if the private key equals to the key.
+func (pk PrivateKey) Equal(key PrivateKey) bool {
+	return pk.key != nil && key.key != nil && pk.key.Equal(key.key)
+}
+
+// SharedSecret computes the shared secret with the peer's public key.
+// It rejects the low order points so the secret can't be forced to a known value.
+// The secret will be derived by hkdf if WithHKDF is set, so it can be used as an aes key directly.
+func (pk PrivateKey) SharedSecret(publicKey PublicKey, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	if publicKey.key == nil {
+		return nil, errInvalidPublicKey
+	}
+
+	if isLowOrderPoint(publicKey.key.Bytes()) {
+		return nil, errLowOrderPoint
+	}
+
+	secret, err := pk.key.ECDH(publicKey.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.hkdfHash != 0 {
+		if !conf.hkdfHash.Available() {
+			return nil, errors.New("cryptox/x25519: hkdf hash is unavailable")
+		}
+
+		secret, err = hkdf.Key(conf.hkdfHash.New, secret, conf.hkdfSalt, conf.hkdfInfo, conf.hkdfKeySize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	secret = conf.encoding.Encode(secret)
+	return secret, nil
+}
+
+type PublicKey struct {
+	key *ecdh.PublicKey
+}
+
+// NewPublicKey returns a public key of the 32 bytes.
+func NewPublicKey(data []byte) (PublicKey, error) {
+	key, err := ecdh.X25519().NewPublicKey(data)
+	if err != nil {
+		return PublicKey{}, err
+	}
+
+	publicKey := PublicKey{key: key}
+	return publicKey, nil
+}
+
+// Bytes returns the public key in 32 bytes.
+func (pk PublicKey) Bytes() []byte {
+	return pk.key.Bytes()
+}
+
+// Equal returns if the public key equals to the key.
+func (pk PublicKey) Equal(key PublicKey) bool {
+	return pk.key != nil && key.key != nil && pk.key.Equal(key.key)
+}
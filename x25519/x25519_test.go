@@ -0,0 +1,239 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package x25519
+
+import (
+	"crypto"
+	"encoding/hex"
+	"slices"
+	"testing"
+)
+
+// go test -v -cover -run=^TestSharedSecret$
+func TestSharedSecret(t *testing.T) {
+	privateKey1, publicKey1, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey2, publicKey2, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret1, err := privateKey1.SharedSecret(publicKey2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret2, err := privateKey2.SharedSecret(publicKey1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(secret1, secret2) {
+		t.Fatalf("got %s != expect %s", secret1, secret2)
+	}
+
+	if len(secret1) != KeySize {
+		t.Fatalf("got %d != expect %d", len(secret1), KeySize)
+	}
+
+	opts := []Option{
+		WithHex(),
+		WithHKDF(crypto.SHA256, 16),
+		WithHKDFSalt([]byte("salt")),
+		WithHKDFInfo("aes-gcm key"),
+	}
+
+	secret1, err = privateKey1.SharedSecret(publicKey2, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret2, err = privateKey2.SharedSecret(publicKey1, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(secret1, secret2) {
+		t.Fatalf("got %s != expect %s", secret1, secret2)
+	}
+
+	if len(secret1) != 32 {
+		t.Fatalf("got %d != expect %d", len(secret1), 32)
+	}
+}
+
+// go test -v -cover -run=^TestRFC7748$
+func TestRFC7748(t *testing.T) {
+	type testCase struct {
+		privateKey string
+		publicKey  string
+		secret     string
+	}
+
+	testCases := []testCase{
+		{
+			privateKey: "a546e36bf0527c9d3b16154b82465edd62144c0ac1fc5a18506a2244ba449ac4",
+			publicKey:  "e6db6867583030db3594c1a424b15f7c726624ec26b3353b10a903a6d0ab1c4c",
+			secret:     "c3da55379de9c6908e94ea4df28d084f32eccf03491c71f754b4075577a28552",
+		},
+		{
+			privateKey: "77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a",
+			publicKey:  "de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f",
+			secret:     "4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742",
+		},
+	}
+
+	for i, testCase := range testCases {
+		privateKeyBytes, _ := hex.DecodeString(testCase.privateKey)
+		publicKeyBytes, _ := hex.DecodeString(testCase.publicKey)
+
+		privateKey, err := NewPrivateKey(privateKeyBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		publicKey, err := NewPublicKey(publicKeyBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		secret, err := privateKey.SharedSecret(publicKey, WithHex())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(secret) != testCase.secret {
+			t.Fatalf("%d: got %s != expect %s", i, secret, testCase.secret)
+		}
+	}
+
+	// The public key of alice in section 6.1.
+	privateKeyBytes, _ := hex.DecodeString("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	expect := "8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a"
+
+	privateKey, err := NewPrivateKey(privateKeyBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := hex.EncodeToString(privateKey.PublicKey().Bytes())
+	if got != expect {
+		t.Fatalf("got %s != expect %s", got, expect)
+	}
+}
+
+// go test -v -cover -run=^TestSharedSecretHKDF$
+func TestSharedSecretHKDF(t *testing.T) {
+	privateKeyBytes, _ := hex.DecodeString("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	publicKeyBytes, _ := hex.DecodeString("de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f")
+
+	privateKey, err := NewPrivateKey(privateKeyBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey, err := NewPublicKey(publicKeyBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := privateKey.SharedSecret(publicKey, WithHex(), WithHKDF(crypto.SHA256, 16), WithHKDFSalt([]byte("salt")), WithHKDFInfo("info"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := "dfdf3ce5d8a064972e2c7033de0f2979"
+	if string(secret) != expect {
+		t.Fatalf("got %s != expect %s", secret, expect)
+	}
+
+	_, err = privateKey.SharedSecret(publicKey, WithHKDF(crypto.Hash(999), 16))
+	if err == nil {
+		t.Fatal("shared secret with unavailable hash should be failed")
+	}
+}
+
+// go test -v -cover -run=^TestLowOrderPoint$
+func TestLowOrderPoint(t *testing.T) {
+	privateKey, _, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, point := range lowOrderPoints {
+		publicKey, err := NewPublicKey(point[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = privateKey.SharedSecret(publicKey)
+		if err != errLowOrderPoint {
+			t.Fatalf("got %+v != expect %+v", err, errLowOrderPoint)
+		}
+
+		// The highest bit is ignored so it's still a low order point.
+		point[KeySize-1] |= 0x80
+
+		publicKey, err = NewPublicKey(point[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = privateKey.SharedSecret(publicKey)
+		if err != errLowOrderPoint {
+			t.Fatalf("got %+v != expect %+v", err, errLowOrderPoint)
+		}
+	}
+}
+
+// go test -v -cover -run=^TestSharedSecretInvalidPublicKey$
+func TestSharedSecretInvalidPublicKey(t *testing.T) {
+	privateKey, _, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = privateKey.SharedSecret(PublicKey{})
+	if err != errInvalidPublicKey {
+		t.Fatalf("got %+v != expect %+v", err, errInvalidPublicKey)
+	}
+}
+
+// go test -v -cover -run=^TestNewKey$
+func TestNewKey(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newPrivateKey, err := NewPrivateKey(privateKey.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !newPrivateKey.Equal(privateKey) {
+		t.Fatalf("newPrivateKey %+v != privateKey %+v", newPrivateKey, privateKey)
+	}
+
+	newPublicKey, err := NewPublicKey(publicKey.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !newPublicKey.Equal(publicKey) {
+		t.Fatalf("newPublicKey %+v != publicKey %+v", newPublicKey, publicKey)
+	}
+
+	if _, err = NewPrivateKey(make([]byte, KeySize-1)); err == nil {
+		t.Fatal("new private key with wrong size should be failed")
+	}
+
+	if _, err = NewPublicKey(make([]byte, KeySize+1)); err == nil {
+		t.Fatal("new public key with wrong size should be failed")
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package x448
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+)
+
+// oidPublicKeyX448 is the algorithm of x448 in rfc 8410.
+var oidPublicKeyX448 = asn1.ObjectIdentifier{1, 3, 101, 111}
+
+var errInvalidDER = errors.New("cryptox/x448: invalid der")
+
+type pkcs8 struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+type publicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// MarshalPKCS8PrivateKey marshals the private key to pkcs8 der.
+// The private key is wrapped in an octet string which is the same as openssl.
+func (pk PrivateKey) MarshalPKCS8PrivateKey() ([]byte, error) {
+	keyBytes, err := asn1.Marshal(pk.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	algo := pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyX448}
+	return asn1.Marshal(pkcs8{Version: 0, Algo: algo, PrivateKey: keyBytes})
+}
+
+// UnmarshalPKCS8PrivateKey unmarshals the private key from pkcs8 der.
+func (pk *PrivateKey) UnmarshalPKCS8PrivateKey(der []byte) error {
+	var info pkcs8
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	if !info.Algo.Algorithm.Equal(oidPublicKeyX448) || len(info.Algo.Parameters.FullBytes) > 0 {
+		return errInvalidDER
+	}
+
+	var key []byte
+	if rest, err := asn1.Unmarshal(info.PrivateKey, &key); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	privateKey, err := NewPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	*pk = privateKey
+	return nil
+}
+
+// MarshalPKIXPublicKey marshals the public key to pkix der.
+func (pk PublicKey) MarshalPKIXPublicKey() ([]byte, error) {
+	publicKey := pk.Bytes()
+
+	info := publicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyX448},
+		PublicKey: asn1.BitString{Bytes: publicKey, BitLength: 8 * len(publicKey)},
+	}
+
+	return asn1.Marshal(info)
+}
+
+// UnmarshalPKIXPublicKey unmarshals the public key from pkix der.
+func (pk *PublicKey) UnmarshalPKIXPublicKey(der []byte) error {
+	var info publicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) > 0 {
+		return errInvalidDER
+	}
+
+	if !info.Algorithm.Algorithm.Equal(oidPublicKeyX448) || len(info.Algorithm.Parameters.FullBytes) > 0 {
+		return errInvalidDER
+	}
+
+	publicKey, err := NewPublicKey(info.PublicKey.RightAlign())
+	if err != nil {
+		return err
+	}
+
+	*pk = publicKey
+	return nil
+}
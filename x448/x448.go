@@ -0,0 +1,106 @@
+// Copyright 2025 FishGoddess. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package x448
+
+import (
+	"crypto/hkdf"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/cloudflare/circl/dh/x448"
+)
+
+// KeySize is the size of x448 private key, public key and shared secret.
+const KeySize = x448.Size
+
+var errLowOrderPoint = errors.New("cryptox/x448: low order point")
+
+type PrivateKey struct {
+	key       x448.Key
+	publicKey PublicKey
+}
+
+// NewPrivateKey returns a private key of the 56 bytes.
+func NewPrivateKey(data []byte) (PrivateKey, error) {
+	if len(data) != KeySize {
+		return PrivateKey{}, fmt.Errorf("cryptox/x448: invalid private key size %d", len(data))
+	}
+
+	var privateKey PrivateKey
+	copy(privateKey.key[:], data)
+	x448.KeyGen(&privateKey.publicKey.key, &privateKey.key)
+
+	return privateKey, nil
+}
+
+// Bytes returns the private key in 56 bytes.
+func (pk PrivateKey) Bytes() []byte {
+	return pk.key[:]
+}
+
+// PublicKey returns the public key of the private key.
+func (pk PrivateKey) PublicKey() PublicKey {
+	return pk.publicKey
+}
+
+// Equal returns if the private key equals to the key.
+func (pk PrivateKey) Equal(key PrivateKey) bool {
+	return subtle.ConstantTimeCompare(pk.key[:], key.key[:]) == 1
+}
+
+// SharedSecret computes the shared secret with the peer's public key.
+// It rejects the low order points so the secret can't be forced to a known value.
+// The secret will be derived by hkdf if WithHKDF is set, so it can be used as an aes key directly.
+func (pk PrivateKey) SharedSecret(publicKey PublicKey, opts ...Option) ([]byte, error) {
+	conf := newConfig().Apply(opts...)
+
+	var shared x448.Key
+	if !x448.Shared(&shared, &pk.key, &publicKey.key) {
+		return nil, errLowOrderPoint
+	}
+
+	secret := shared[:]
+	if conf.hkdfHash != 0 {
+		if !conf.hkdfHash.Available() {
+			return nil, errors.New("cryptox/x448: hkdf hash is unavailable")
+		}
+
+		var err error
+		secret, err = hkdf.Key(conf.hkdfHash.New, secret, conf.hkdfSalt, conf.hkdfInfo, conf.hkdfKeySize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	secret = conf.encoding.Encode(secret)
+	return secret, nil
+}
+
+type PublicKey struct {
+	key x448.Key
+}
+
+// NewPublicKey returns a public key of the 56 bytes.
+func NewPublicKey(data []byte) (PublicKey, error) {
+	if len(data) != KeySize {
+		return PublicKey{}, fmt.Errorf("cryptox/x448: invalid public key size %d", len(data))
+	}
+
+	var publicKey PublicKey
+	copy(publicKey.key[:], data)
+
+	return publicKey, nil
+}
+
+// Bytes returns the public key in 56 bytes.
+func (pk PublicKey) Bytes() []byte {
+	return pk.key[:]
+}
+
+// Equal returns if the public key equals to the key.
+func (pk PublicKey) Equal(key PublicKey) bool {
+	return pk.key == key.key
+}
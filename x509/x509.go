@@ -5,6 +5,7 @@
 package x509
 
 import (
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/x509"
 	"fmt"
@@ -13,19 +14,59 @@ import (
 const (
 	blockTypePrivate    = "PRIVATE KEY"
 	blockTypePrivateRSA = "RSA PRIVATE KEY"
+	blockTypePrivateEC  = "EC PRIVATE KEY"
 	blockTypePublic     = "PUBLIC KEY"
 	blockTypePublicRSA  = "RSA PUBLIC KEY"
 )
 
+// PKCS8Marshaler marshals a private key which x509 doesn't support to pkcs8 der.
+type PKCS8Marshaler interface {
+	MarshalPKCS8PrivateKey() ([]byte, error)
+}
+
+// PKCS8Unmarshaler unmarshals a private key which x509 doesn't support from pkcs8 der.
+type PKCS8Unmarshaler interface {
+	UnmarshalPKCS8PrivateKey(der []byte) error
+}
+
+// PKIXMarshaler marshals a public key which x509 doesn't support to pkix der.
+type PKIXMarshaler interface {
+	MarshalPKIXPublicKey() ([]byte, error)
+}
+
+// PKIXUnmarshaler unmarshals a public key which x509 doesn't support from pkix der.
+type PKIXUnmarshaler interface {
+	UnmarshalPKIXPublicKey(der []byte) error
+}
+
 // EncodePrivateKeyPKCS1 uses pkcs1 to encode private key.
 func EncodePrivateKeyPKCS1(key *rsa.PrivateKey) ([]byte, error) {
 	blockBytes := x509.MarshalPKCS1PrivateKey(key)
 	return encode(blockTypePrivateRSA, blockBytes)
 }
 
+// EncodePrivateKeySEC1 uses sec1 to encode private key.
+func EncodePrivateKeySEC1(key *ecdsa.PrivateKey) ([]byte, error) {
+	blockBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return encode(blockTypePrivateEC, blockBytes)
+}
+
 // EncodePrivateKeyPKCS8 uses pkcs8 to encode private key.
+// The key will be marshaled by itself if it implements PKCS8Marshaler.
 func EncodePrivateKeyPKCS8[Key any](key Key) ([]byte, error) {
-	blockBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	var blockBytes []byte
+	var err error
+
+	if marshaler, ok := any(key).(PKCS8Marshaler); ok {
+		blockBytes, err = marshaler.MarshalPKCS8PrivateKey()
+	} else {
+		blockBytes, err = x509.MarshalPKCS8PrivateKey(key)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -40,8 +81,17 @@ func EncodePublicKeyPKCS1(key *rsa.PublicKey) ([]byte, error) {
 }
 
 // EncodePublicKeyPKIX uses pkix to encode public key.
+// The key will be marshaled by itself if it implements PKIXMarshaler.
 func EncodePublicKeyPKIX[Key any](key Key) ([]byte, error) {
-	publicKeyBytes, err := x509.MarshalPKIXPublicKey(key)
+	var publicKeyBytes []byte
+	var err error
+
+	if marshaler, ok := any(key).(PKIXMarshaler); ok {
+		publicKeyBytes, err = marshaler.MarshalPKIXPublicKey()
+	} else {
+		publicKeyBytes, err = x509.MarshalPKIXPublicKey(key)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +109,18 @@ func DecodePrivateKeyPKCS1(data []byte) (*rsa.PrivateKey, error) {
 	return x509.ParsePKCS1PrivateKey(blockBytes)
 }
 
+// DecodePrivateKeySEC1 uses sec1 to decode private key.
+func DecodePrivateKeySEC1(data []byte) (*ecdsa.PrivateKey, error) {
+	_, blockBytes, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParseECPrivateKey(blockBytes)
+}
+
 // DecodePrivateKeyPKCS8 uses pkcs8 to decode private key.
+// The key will be unmarshaled by itself if its pointer implements PKCS8Unmarshaler.
 func DecodePrivateKeyPKCS8[Key any](data []byte) (Key, error) {
 	_, blockBytes, err := decode(data)
 	if err != nil {
@@ -67,6 +128,16 @@ func DecodePrivateKeyPKCS8[Key any](data []byte) (Key, error) {
 		return empty, err
 	}
 
+	var key Key
+	if unmarshaler, ok := any(&key).(PKCS8Unmarshaler); ok {
+		if err = unmarshaler.UnmarshalPKCS8PrivateKey(blockBytes); err != nil {
+			var empty Key
+			return empty, err
+		}
+
+		return key, nil
+	}
+
 	pk, err := x509.ParsePKCS8PrivateKey(blockBytes)
 	if err != nil {
 		var empty Key
@@ -93,6 +164,7 @@ func DecodePublicKeyPKCS1(data []byte) (*rsa.PublicKey, error) {
 }
 
 // DecodePublicKeyPKIX uses pkix to decode public key.
+// The key will be unmarshaled by itself if its pointer implements PKIXUnmarshaler.
 func DecodePublicKeyPKIX[Key any](data []byte) (Key, error) {
 	_, blockBytes, err := decode(data)
 	if err != nil {
@@ -100,6 +172,16 @@ func DecodePublicKeyPKIX[Key any](data []byte) (Key, error) {
 		return empty, err
 	}
 
+	var key Key
+	if unmarshaler, ok := any(&key).(PKIXUnmarshaler); ok {
+		if err = unmarshaler.UnmarshalPKIXPublicKey(blockBytes); err != nil {
+			var empty Key
+			return empty, err
+		}
+
+		return key, nil
+	}
+
 	pk, err := x509.ParsePKIXPublicKey(blockBytes)
 	if err != nil {
 		var empty Key